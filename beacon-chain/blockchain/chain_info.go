@@ -9,6 +9,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -58,6 +59,12 @@ type ParticipationFetcher interface {
 	Participation(epoch uint64) *precompute.Balance
 }
 
+// ReorgHistoryFetcher defines a common interface for methods in blockchain service which
+// directly retrieves the history of chain reorgs.
+type ReorgHistoryFetcher interface {
+	ReorgHistory() []*statefeed.ReorgData
+}
+
 // FinalizedCheckpt returns the latest finalized checkpoint from head state.
 func (s *Service) FinalizedCheckpt() *ethpb.Checkpoint {
 	if s.headState == nil || s.headState.FinalizedCheckpoint() == nil {
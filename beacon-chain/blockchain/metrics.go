@@ -26,6 +26,11 @@ var (
 		Name: "competing_blocks",
 		Help: "The # of blocks received and processed from a competing chain",
 	})
+	reorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "beacon_reorg_depth",
+		Help:    "The # of blocks dropped from the canonical chain by a chain reorg",
+		Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+	})
 	processedBlkNoPubsub = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "processed_no_pubsub_block_counter",
 		Help: "The # of processed block without pubsub, this usually means the blocks from sync",
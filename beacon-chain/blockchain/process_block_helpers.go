@@ -141,6 +141,46 @@ func (s *Service) saveNewValidators(ctx context.Context, preStateValidatorCount
 	return nil
 }
 
+// migrateToColdState saves snapshots of the newly finalized chain to cold storage in the
+// background, if enabled, so that blocks are not held up by the replay of the states. Only
+// one migration runs at a time: checkpoints finalized while one is running are covered by
+// a single migration to the latest of them once it is done.
+func (s *Service) migrateToColdState(finalized *ethpb.Checkpoint) {
+	if s.stateGen == nil {
+		return
+	}
+	s.coldMigrationLock.Lock()
+	defer s.coldMigrationLock.Unlock()
+	s.nextColdMigration = finalized
+	if s.coldMigrationRunning {
+		return
+	}
+	s.coldMigrationRunning = true
+	go s.runColdMigrations()
+}
+
+// runColdMigrations migrates the finalized chain to cold storage up to the latest
+// finalized checkpoint, until no newer checkpoint is waiting to be migrated to.
+func (s *Service) runColdMigrations() {
+	for {
+		s.coldMigrationLock.Lock()
+		finalized := s.nextColdMigration
+		s.nextColdMigration = nil
+		if finalized == nil || s.ctx.Err() != nil {
+			s.coldMigrationRunning = false
+			s.coldMigrationLock.Unlock()
+			return
+		}
+		s.coldMigrationLock.Unlock()
+
+		// States pruned from the hot storage meanwhile are regenerated from the previous
+		// snapshot in cold storage.
+		if err := s.stateGen.MigrateToCold(s.ctx, bytesutil.ToBytes32(finalized.Root)); err != nil {
+			log.WithError(err).Error("Could not migrate finalized states to cold storage")
+		}
+	}
+}
+
 // rmStatesOlderThanLastFinalized deletes the states in db since last finalized check point.
 func (s *Service) rmStatesOlderThanLastFinalized(ctx context.Context, startSlot uint64, endSlot uint64) error {
 	ctx, span := trace.StartSpan(ctx, "forkchoice.rmStatesBySlots")
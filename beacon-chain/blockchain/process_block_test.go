@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -567,3 +568,18 @@ func blockTree1(db db.Database, genesisRoot []byte) ([][]byte, error) {
 	}
 	return [][]byte{r0[:], r1[:], nil, r3[:], r4[:], r5[:], r6[:], r7[:], r8[:]}, nil
 }
+
+func TestMigrateToColdState_OneMigrationAtATime(t *testing.T) {
+	service := &Service{stateGen: stategen.New(nil, 4)}
+	// While a migration runs, newer checkpoints replace the pending one rather than
+	// starting migrations of their own.
+	service.coldMigrationRunning = true
+	service.migrateToColdState(&ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}})
+	service.migrateToColdState(&ethpb.Checkpoint{Epoch: 2, Root: []byte{'b'}})
+	if !service.coldMigrationRunning {
+		t.Error("Wanted the running migration to be left running")
+	}
+	if service.nextColdMigration == nil || service.nextColdMigration.Epoch != 2 {
+		t.Errorf("Wanted the latest checkpoint to be migrated to next, got %v", service.nextColdMigration)
+	}
+}
@@ -98,6 +98,7 @@ func (s *Service) ReceiveBlockNoPubsub(ctx context.Context, block *ethpb.SignedB
 	s.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.BlockProcessed,
 		Data: &statefeed.BlockProcessedData{
+			Slot:      blockCopy.Block.Slot,
 			BlockRoot: root,
 			Verified:  true,
 		},
@@ -219,6 +220,7 @@ func (s *Service) ReceiveBlockNoPubsubForkchoice(ctx context.Context, block *eth
 	s.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.BlockProcessed,
 		Data: &statefeed.BlockProcessedData{
+			Slot:      blockCopy.Block.Slot,
 			BlockRoot: root,
 			Verified:  true,
 		},
@@ -294,6 +296,7 @@ func (s *Service) ReceiveBlockNoVerify(ctx context.Context, block *ethpb.SignedB
 	s.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.BlockProcessed,
 		Data: &statefeed.BlockProcessedData{
+			Slot:      blockCopy.Block.Slot,
 			BlockRoot: root,
 			Verified:  false,
 		},
@@ -0,0 +1,105 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// maxReorgHistory is the number of most recent chain reorgs kept by the service.
+const maxReorgHistory = 64
+
+// ReorgHistory returns the most recent chain reorgs of the node, oldest first.
+func (s *Service) ReorgHistory() []*statefeed.ReorgData {
+	s.reorgHistoryLock.RLock()
+	defer s.reorgHistoryLock.RUnlock()
+	history := make([]*statefeed.ReorgData, len(s.reorgHistory))
+	copy(history, s.reorgHistory)
+	return history
+}
+
+// detectReorg compares the current head with the new head block and returns the reorg
+// which moving to the new head causes, or nil if the new head descends from the current
+// head. The caller must hold the head lock.
+func (s *Service) detectReorg(ctx context.Context, newHead *ethpb.BeaconBlock, newRoot [32]byte) (*statefeed.ReorgData, error) {
+	if s.headBlock == nil || s.headBlock.Block == nil {
+		return nil, nil
+	}
+	oldHead := s.headBlock.Block
+	oldRoot := bytesutil.ToBytes32(s.canonicalRoots[s.headSlot])
+	if oldRoot == [32]byte{} {
+		var err error
+		oldRoot, err = ssz.HashTreeRoot(oldHead)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get head block root")
+		}
+	}
+	if oldRoot == newRoot || bytesutil.ToBytes32(newHead.ParentRoot) == oldRoot {
+		return nil, nil
+	}
+
+	// Walk both chains back to their common ancestor, collecting the blocks of the old
+	// chain along the way.
+	var dropped [][32]byte
+	oldCursor, oldCursorRoot := oldHead, oldRoot
+	newCursor, newCursorRoot := newHead, newRoot
+	for oldCursorRoot != newCursorRoot {
+		var err error
+		if oldCursor.Slot >= newCursor.Slot {
+			dropped = append(dropped, oldCursorRoot)
+			oldCursorRoot = bytesutil.ToBytes32(oldCursor.ParentRoot)
+			oldCursor, err = s.reorgBlock(ctx, oldCursorRoot)
+		} else {
+			newCursorRoot = bytesutil.ToBytes32(newCursor.ParentRoot)
+			newCursor, err = s.reorgBlock(ctx, newCursorRoot)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(dropped) == 0 {
+		return nil, nil
+	}
+	return &statefeed.ReorgData{
+		Slot:         newHead.Slot,
+		Depth:        uint64(len(dropped)),
+		OldHeadRoot:  oldRoot,
+		NewHeadRoot:  newRoot,
+		DroppedRoots: dropped,
+	}, nil
+}
+
+func (s *Service) reorgBlock(ctx context.Context, root [32]byte) (*ethpb.BeaconBlock, error) {
+	b, err := s.beaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve block")
+	}
+	if b == nil || b.Block == nil {
+		return nil, fmt.Errorf("could not find block %#x", root)
+	}
+	return b.Block, nil
+}
+
+// recordReorg adds the reorg to the bounded reorg history and reports it.
+func (s *Service) recordReorg(reorg *statefeed.ReorgData) {
+	s.reorgHistoryLock.Lock()
+	s.reorgHistory = append(s.reorgHistory, reorg)
+	if len(s.reorgHistory) > maxReorgHistory {
+		s.reorgHistory = s.reorgHistory[len(s.reorgHistory)-maxReorgHistory:]
+	}
+	s.reorgHistoryLock.Unlock()
+
+	reorgDepth.Observe(float64(reorg.Depth))
+	log.WithFields(logrus.Fields{
+		"slot":        reorg.Slot,
+		"depth":       reorg.Depth,
+		"oldHeadRoot": fmt.Sprintf("%#x", reorg.OldHeadRoot),
+		"newHeadRoot": fmt.Sprintf("%#x", reorg.NewHeadRoot),
+	}).Warn("Chain reorg occurred")
+}
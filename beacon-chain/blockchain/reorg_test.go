@@ -0,0 +1,107 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	beaconstate "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestSaveHead_DetectsReorg(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+	ctx := context.Background()
+	s := &Service{
+		beaconDB:       db,
+		canonicalRoots: make(map[uint64][]byte),
+		stateNotifier:  &mockBeaconNode{},
+	}
+
+	// Build the chains genesis <- a1 <- a2 <- a3 and a1 <- b2 <- b4 <- b5.
+	roots := make(map[string][32]byte)
+	blocks := make(map[string]*ethpb.SignedBeaconBlock)
+	addBlock := func(name string, slot uint64, parent string) {
+		b := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot}}
+		if parent != "" {
+			parentRoot := roots[parent]
+			b.Block.ParentRoot = parentRoot[:]
+		}
+		r, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+		st, err := beaconstate.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveState(ctx, st, r); err != nil {
+			t.Fatal(err)
+		}
+		roots[name] = r
+		blocks[name] = b
+	}
+	addBlock("genesis", 0, "")
+	addBlock("a1", 1, "genesis")
+	addBlock("a2", 2, "a1")
+	addBlock("a3", 3, "a2")
+	addBlock("b2", 2, "a1")
+	addBlock("b4", 4, "b2")
+	addBlock("b5", 5, "b4")
+
+	for _, name := range []string{"a1", "a2", "a3"} {
+		if err := s.saveHead(ctx, blocks[name], roots[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(s.ReorgHistory()) != 0 {
+		t.Fatalf("Wanted no reorgs, got %d", len(s.ReorgHistory()))
+	}
+
+	if err := s.saveHead(ctx, blocks["b4"], roots["b4"]); err != nil {
+		t.Fatal(err)
+	}
+	history := s.ReorgHistory()
+	if len(history) != 1 {
+		t.Fatalf("Wanted %d reorg, got %d", 1, len(history))
+	}
+	reorg := history[0]
+	if reorg.Depth != 2 {
+		t.Errorf("Wanted reorg depth %d, got %d", 2, reorg.Depth)
+	}
+	if reorg.OldHeadRoot != roots["a3"] || reorg.NewHeadRoot != roots["b4"] {
+		t.Errorf("Unexpected head roots in reorg %+v", reorg)
+	}
+	if len(reorg.DroppedRoots) != 2 || reorg.DroppedRoots[0] != roots["a3"] || reorg.DroppedRoots[1] != roots["a2"] {
+		t.Errorf("Unexpected dropped roots %#x", reorg.DroppedRoots)
+	}
+
+	// Extending the new head is not a reorg.
+	if err := s.saveHead(ctx, blocks["b5"], roots["b5"]); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.ReorgHistory()) != 1 {
+		t.Errorf("Wanted %d reorg, got %d", 1, len(s.ReorgHistory()))
+	}
+}
+
+func TestRecordReorg_BoundedHistory(t *testing.T) {
+	s := &Service{}
+	for i := uint64(0); i < maxReorgHistory+10; i++ {
+		s.recordReorg(&statefeed.ReorgData{Slot: i, Depth: 1})
+	}
+	history := s.ReorgHistory()
+	if len(history) != maxReorgHistory {
+		t.Fatalf("Wanted %d reorgs, got %d", maxReorgHistory, len(history))
+	}
+	if history[0].Slot != 10 {
+		t.Errorf("Wanted oldest reorg at slot %d, got %d", 10, history[0].Slot)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -73,6 +74,12 @@ type Service struct {
 	initSyncStateLock      sync.RWMutex
 	checkpointState        *cache.CheckpointStateCache
 	checkpointStateLock    sync.Mutex
+	stateGen               *stategen.State
+	coldMigrationLock      sync.Mutex
+	coldMigrationRunning   bool
+	nextColdMigration      *ethpb.Checkpoint
+	reorgHistory           []*statefeed.ReorgData
+	reorgHistoryLock       sync.RWMutex
 }
 
 // Config options for the service.
@@ -87,6 +94,7 @@ type Config struct {
 	MaxRoutines       int64
 	StateNotifier     statefeed.Notifier
 	ForkChoiceStore   f.ForkChoicer
+	StateGen          *stategen.State
 }
 
 // NewService instantiates a new block service instance that will
@@ -111,6 +119,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		forkChoiceStore:    cfg.ForkChoiceStore,
 		initSyncState:      make(map[[32]byte]*stateTrie.BeaconState),
 		checkpointState:    cache.NewCheckpointStateCache(),
+		stateGen:           cfg.StateGen,
 	}, nil
 }
 
@@ -283,6 +292,13 @@ func (s *Service) Status() error {
 
 // This gets called to update canonical root mapping.
 func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock, r [32]byte) error {
+	// Head events are sent once the head lock is released, as subscribers may read the head.
+	var events []*feed.Event
+	defer func() {
+		for _, e := range events {
+			s.stateNotifier.StateFeed().Send(e)
+		}
+	}()
 	s.headLock.Lock()
 	defer s.headLock.Unlock()
 
@@ -290,6 +306,12 @@ func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		return errors.New("cannot save nil head block")
 	}
 
+	// A failure to detect a reorg should not prevent the head from being updated.
+	reorg, err := s.detectReorg(ctx, signed.Block, r)
+	if err != nil {
+		log.WithError(err).Warn("Could not detect chain reorg")
+	}
+
 	s.headSlot = signed.Block.Slot
 
 	s.canonicalRoots[signed.Block.Slot] = r[:]
@@ -303,8 +325,19 @@ func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve head state in DB")
 	}
+	if headState != nil {
+		events = headEvents(s.headState, headState, signed.Block, r)
+	}
 	s.headState = headState
 
+	if reorg != nil {
+		s.recordReorg(reorg)
+		events = append(events, &feed.Event{
+			Type: statefeed.Reorg,
+			Data: reorg,
+		})
+	}
+
 	log.WithFields(logrus.Fields{
 		"slot":     signed.Block.Slot,
 		"headRoot": fmt.Sprintf("%#x", r),
@@ -312,6 +345,41 @@ func (s *Service) saveHead(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 	return nil
 }
 
+// headEvents returns the state feed events for a head change, including changes to
+// the justified and finalized checkpoints of the head state.
+func headEvents(oldState *stateTrie.BeaconState, newState *stateTrie.BeaconState, b *ethpb.BeaconBlock, r [32]byte) []*feed.Event {
+	events := []*feed.Event{{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:      b.Slot,
+			BlockRoot: r,
+			StateRoot: bytesutil.ToBytes32(b.StateRoot),
+		},
+	}}
+	if oldState == nil {
+		return events
+	}
+	if cpt := newState.CurrentJustifiedCheckpoint(); cpt != nil && cpt.Epoch > oldState.CurrentJustifiedCheckpoint().GetEpoch() {
+		events = append(events, &feed.Event{
+			Type: statefeed.JustifiedCheckpoint,
+			Data: &statefeed.CheckpointData{
+				Epoch: cpt.Epoch,
+				Root:  bytesutil.ToBytes32(cpt.Root),
+			},
+		})
+	}
+	if cpt := newState.FinalizedCheckpoint(); cpt != nil && cpt.Epoch > oldState.FinalizedCheckpoint().GetEpoch() {
+		events = append(events, &feed.Event{
+			Type: statefeed.FinalizedCheckpoint,
+			Data: &statefeed.CheckpointData{
+				Epoch: cpt.Epoch,
+				Root:  bytesutil.ToBytes32(cpt.Root),
+			},
+		})
+	}
+	return events
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of inital-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -424,14 +492,23 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not get genesis block from db")
 	}
-	if genesisBlock == nil {
-		return errors.New("no genesis block in db")
-	}
-	genesisBlkRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root of genesis block")
+	if genesisBlock != nil {
+		genesisBlkRoot, err := ssz.HashTreeRoot(genesisBlock.Block)
+		if err != nil {
+			return errors.Wrap(err, "could not get signing root of genesis block")
+		}
+		s.genesisRoot = genesisBlkRoot
+	} else {
+		// A node started from a finalized checkpoint does not hold the genesis block until
+		// backfill reaches it.
+		anchorRoot, err := s.beaconDB.AnchorBlockRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get anchor block root from db")
+		}
+		if anchorRoot == [32]byte{} {
+			return errors.New("no genesis block in db")
+		}
 	}
-	s.genesisRoot = genesisBlkRoot
 
 	finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
@@ -451,3 +451,46 @@ func TestChainService_PruneOldStates(t *testing.T) {
 		}
 	}
 }
+
+func TestHeadEvents_CheckpointChanges(t *testing.T) {
+	oldState, err := beaconstate.InitializeFromProto(&pb.BeaconState{
+		CurrentJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}},
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	newState, err := beaconstate.InitializeFromProto(&pb.BeaconState{
+		CurrentJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 2, Root: []byte{'b'}},
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.BeaconBlock{Slot: 64, StateRoot: []byte{'c'}}
+	root := [32]byte{'d'}
+
+	events := headEvents(oldState, newState, blk, root)
+	if len(events) != 2 {
+		t.Fatalf("Wanted %d events, got %d", 2, len(events))
+	}
+	if events[0].Type != statefeed.NewHead {
+		t.Errorf("Wanted new head event, got %d", events[0].Type)
+	}
+	head := events[0].Data.(*statefeed.NewHeadData)
+	if head.Slot != 64 || head.BlockRoot != root || head.StateRoot != bytesutil.ToBytes32([]byte{'c'}) {
+		t.Errorf("Unexpected new head data %+v", head)
+	}
+	if events[1].Type != statefeed.JustifiedCheckpoint {
+		t.Errorf("Wanted justified checkpoint event, got %d", events[1].Type)
+	}
+	cpt := events[1].Data.(*statefeed.CheckpointData)
+	if cpt.Epoch != 2 || cpt.Root != bytesutil.ToBytes32([]byte{'b'}) {
+		t.Errorf("Unexpected justified checkpoint data %+v", cpt)
+	}
+
+	// The first head has no previous head state to compare checkpoints with.
+	if events := headEvents(nil, newState, blk, root); len(events) != 1 {
+		t.Errorf("Wanted %d events, got %d", 1, len(events))
+	}
+}
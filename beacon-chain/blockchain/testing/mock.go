@@ -33,6 +33,7 @@ type ChainService struct {
 	Genesis                     time.Time
 	Fork                        *pb.Fork
 	DB                          db.Database
+	Reorgs                      []*statefeed.ReorgData
 	stateNotifier               statefeed.Notifier
 	opNotifier                  opfeed.Notifier
 }
@@ -202,3 +203,8 @@ func (ms *ChainService) CurrentSlot() uint64 {
 func (ms *ChainService) Participation(epoch uint64) *precompute.Balance {
 	return ms.Balance
 }
+
+// ReorgHistory mocks the same method in the chain service.
+func (ms *ChainService) ReorgHistory() []*statefeed.ReorgData {
+	return ms.Reorgs
+}
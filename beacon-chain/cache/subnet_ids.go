@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+type subnetIDs struct {
+	aggregator     *cache.Cache
+	aggregatorLock sync.RWMutex
+	persistent     *cache.Cache
+	persistentLock sync.RWMutex
+}
+
+// SubnetIDs for attestation aggregators and long lived random subnets of validators.
+var SubnetIDs = newSubnetIDs()
+
+func newSubnetIDs() *subnetIDs {
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	// Aggregator subnets are only needed until the aggregation slot has passed, so they
+	// expire after an epoch worth of slots.
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch) * secondsPerSlot
+	return &subnetIDs{
+		aggregator: cache.New(epochDuration, epochDuration),
+		persistent: cache.New(cache.NoExpiration, epochDuration),
+	}
+}
+
+// AddAggregatorSubnetID adds the subnet of an aggregation duty at the given slot.
+func (c *subnetIDs) AddAggregatorSubnetID(slot uint64, subnetID uint64) {
+	c.aggregatorLock.Lock()
+	defer c.aggregatorLock.Unlock()
+
+	key := slotKey(slot)
+	var ids []uint64
+	if v, ok := c.aggregator.Get(key); ok {
+		ids = v.([]uint64)
+	}
+	for _, id := range ids {
+		if id == subnetID {
+			return
+		}
+	}
+	c.aggregator.Set(key, append(ids, subnetID), cache.DefaultExpiration)
+}
+
+// GetAggregatorSubnetIDs returns the subnets of the aggregation duties at the given slot.
+func (c *subnetIDs) GetAggregatorSubnetIDs(slot uint64) []uint64 {
+	c.aggregatorLock.RLock()
+	defer c.aggregatorLock.RUnlock()
+
+	v, ok := c.aggregator.Get(slotKey(slot))
+	if !ok {
+		return []uint64{}
+	}
+	return v.([]uint64)
+}
+
+// AddPersistentCommittee adds the long lived random subnets of a validator, which expire
+// after the given duration.
+func (c *subnetIDs) AddPersistentCommittee(pubkey []byte, subnetIDs []uint64, duration time.Duration) {
+	c.persistentLock.Lock()
+	defer c.persistentLock.Unlock()
+
+	c.persistent.Set(string(pubkey), subnetIDs, duration)
+}
+
+// GetPersistentSubnets returns the long lived random subnets of a validator and whether
+// they are still assigned.
+func (c *subnetIDs) GetPersistentSubnets(pubkey []byte) ([]uint64, bool) {
+	c.persistentLock.RLock()
+	defer c.persistentLock.RUnlock()
+
+	v, ok := c.persistent.Get(string(pubkey))
+	if !ok {
+		return nil, false
+	}
+	return v.([]uint64), true
+}
+
+// GetAllSubnets returns the sorted union of the long lived random subnets of every
+// validator.
+func (c *subnetIDs) GetAllSubnets() []uint64 {
+	c.persistentLock.RLock()
+	defer c.persistentLock.RUnlock()
+
+	seen := make(map[uint64]bool)
+	ids := []uint64{}
+	for _, item := range c.persistent.Items() {
+		for _, id := range item.Object.([]uint64) {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
+
+func slotKey(slot uint64) string {
+	return string(bytesutil.Bytes8(slot))
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSubnetIDsCache_AggregatorSubnets(t *testing.T) {
+	c := newSubnetIDs()
+	slot := uint64(100)
+	if ids := c.GetAggregatorSubnetIDs(slot); len(ids) != 0 {
+		t.Errorf("Wanted no subnets, got %v", ids)
+	}
+	c.AddAggregatorSubnetID(slot, 3)
+	c.AddAggregatorSubnetID(slot, 5)
+	c.AddAggregatorSubnetID(slot, 3)
+	c.AddAggregatorSubnetID(slot+1, 7)
+
+	if ids := c.GetAggregatorSubnetIDs(slot); !reflect.DeepEqual(ids, []uint64{3, 5}) {
+		t.Errorf("Wanted subnets %v, got %v", []uint64{3, 5}, ids)
+	}
+	if ids := c.GetAggregatorSubnetIDs(slot + 1); !reflect.DeepEqual(ids, []uint64{7}) {
+		t.Errorf("Wanted subnets %v, got %v", []uint64{7}, ids)
+	}
+}
+
+func TestSubnetIDsCache_PersistentSubnets(t *testing.T) {
+	c := newSubnetIDs()
+	pubkey := []byte{'A'}
+	if _, ok := c.GetPersistentSubnets(pubkey); ok {
+		t.Error("Expected no persistent subnets")
+	}
+	c.AddPersistentCommittee(pubkey, []uint64{8, 2}, time.Minute)
+	c.AddPersistentCommittee([]byte{'B'}, []uint64{2, 4}, time.Minute)
+
+	ids, ok := c.GetPersistentSubnets(pubkey)
+	if !ok {
+		t.Fatal("Expected persistent subnets")
+	}
+	if !reflect.DeepEqual(ids, []uint64{8, 2}) {
+		t.Errorf("Wanted subnets %v, got %v", []uint64{8, 2}, ids)
+	}
+	if all := c.GetAllSubnets(); !reflect.DeepEqual(all, []uint64{2, 4, 8}) {
+		t.Errorf("Wanted subnets %v, got %v", []uint64{2, 4, 8}, all)
+	}
+
+	c.AddPersistentCommittee([]byte{'C'}, []uint64{9}, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if _, ok := c.GetPersistentSubnets([]byte{'C'}); ok {
+		t.Error("Expected expired persistent subnets")
+	}
+	if all := c.GetAllSubnets(); !reflect.DeepEqual(all, []uint64{2, 4, 8}) {
+		t.Errorf("Wanted subnets %v, got %v", []uint64{2, 4, 8}, all)
+	}
+}
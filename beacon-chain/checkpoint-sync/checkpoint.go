@@ -0,0 +1,163 @@
+// Package checkpointsync allows a beacon node to start from a trusted finalized state and
+// block, rather than from genesis. The checkpoint is loaded from SSZ files or fetched from
+// the checkpoint service of another beacon node, then saved to the database as the head,
+// justified and finalized checkpoint for initial sync to sync forward from.
+//
+// The checkpoint block is saved as the anchor block, the oldest block held by the node. Blocks
+// before the anchor, including the genesis block, are not available until they are backfilled.
+package checkpointsync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Checkpoint is a finalized state and the block it was produced by.
+type Checkpoint struct {
+	Epoch uint64
+	State *stateTrie.BeaconState
+	Block *ethpb.SignedBeaconBlock
+}
+
+// LoadFromFiles reads a checkpoint from SSZ encoded state and signed block files. The
+// checkpoint epoch is the first epoch starting at or after the slot of the block.
+func LoadFromFiles(statePath string, blockPath string) (*Checkpoint, error) {
+	encodedState, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint state")
+	}
+	encodedBlock, err := ioutil.ReadFile(blockPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint block")
+	}
+	cp, err := decode(encodedState, encodedBlock)
+	if err != nil {
+		return nil, err
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	cp.Epoch = (cp.Block.Block.Slot + slotsPerEpoch - 1) / slotsPerEpoch
+	return cp, nil
+}
+
+// FetchFromProvider requests the latest finalized checkpoint of the beacon node at the
+// given gRPC endpoint. The connection is secured with TLS if a certificate is provided.
+func FetchFromProvider(ctx context.Context, endpoint string, cert string) (*Checkpoint, error) {
+	var dialOpt grpc.DialOption
+	if cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get valid credentials")
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.Warn("You are using an insecure gRPC connection to the checkpoint provider! Please provide a certificate to use a secure connection.")
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to checkpoint provider")
+		}
+	}()
+
+	resp, err := pb.NewCheckpointClient(conn).FinalizedCheckpoint(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch finalized checkpoint")
+	}
+	cp, err := decode(resp.State, resp.Block)
+	if err != nil {
+		return nil, err
+	}
+	cp.Epoch = resp.Epoch
+	return cp, nil
+}
+
+// Initialize verifies that the checkpoint block produced the checkpoint state, then saves
+// them to the database as the anchor, head, justified and finalized block and state. The
+// genesis block root is left unset until backfill reaches the genesis block.
+func Initialize(ctx context.Context, beaconDB db.HeadAccessDatabase, cp *Checkpoint) error {
+	blockRoot, err := ssz.HashTreeRoot(cp.Block.Block)
+	if err != nil {
+		return errors.Wrap(err, "could not get checkpoint block root")
+	}
+	stateBlockRoot, err := latestBlockRoot(cp.State)
+	if err != nil {
+		return err
+	}
+	if blockRoot != stateBlockRoot {
+		return fmt.Errorf("checkpoint block %#x does not match latest block %#x of checkpoint state", blockRoot, stateBlockRoot)
+	}
+	if cp.Epoch*params.BeaconConfig().SlotsPerEpoch < cp.Block.Block.Slot {
+		return fmt.Errorf("checkpoint epoch %d starts before checkpoint block slot %d", cp.Epoch, cp.Block.Block.Slot)
+	}
+
+	if err := beaconDB.SaveCheckpointAnchor(ctx, cp.State, cp.Block, cp.Epoch); err != nil {
+		return errors.Wrap(err, "could not save checkpoint")
+	}
+
+	log.WithFields(logrus.Fields{
+		"epoch": cp.Epoch,
+		"slot":  cp.Block.Block.Slot,
+		"root":  fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:])),
+	}).Info("Initialized beacon chain from finalized checkpoint")
+	return nil
+}
+
+func decode(encodedState []byte, encodedBlock []byte) (*Checkpoint, error) {
+	st := &pbp2p.BeaconState{}
+	if err := ssz.Unmarshal(encodedState, st); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	trie, err := stateTrie.InitializeFromProto(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state trie")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := ssz.Unmarshal(encodedBlock, blk); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	if blk.Block == nil {
+		return nil, errors.New("checkpoint block is empty")
+	}
+	return &Checkpoint{State: trie, Block: blk}, nil
+}
+
+// latestBlockRoot returns the root of the latest block applied to the state.
+func latestBlockRoot(st *stateTrie.BeaconState) ([32]byte, error) {
+	header := st.LatestBlockHeader()
+	if header == nil {
+		return [32]byte{}, errors.New("checkpoint state has no latest block header")
+	}
+	// The state root of the header is only filled in when the next slot is processed.
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := st.HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not get state root")
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	root, err := ssz.HashTreeRoot(header)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get block header root")
+	}
+	return root, nil
+}
@@ -0,0 +1,125 @@
+package checkpointsync
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// checkpointAtSlot returns a block at the given slot and its post-state.
+func checkpointAtSlot(t *testing.T, slot uint64) (*stateTrie.BeaconState, *ethpb.SignedBeaconBlock) {
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(genesisState, privKeys, nil, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.ExecuteStateTransition(context.Background(), genesisState, blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st, blk
+}
+
+func writeSSZ(t *testing.T, path string, val interface{}) {
+	enc, err := ssz.Marshal(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, enc, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadFromFiles_Initialize(t *testing.T) {
+	ctx := context.Background()
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	st, blk := checkpointAtSlot(t, 8)
+	dir := filepath.Join(testutil.TempDir(), "checkpoint")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	writeSSZ(t, statePath, st.InnerStateUnsafe())
+	writeSSZ(t, blockPath, blk)
+
+	cp, err := LoadFromFiles(statePath, blockPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Epoch != 1 {
+		t.Errorf("Wanted checkpoint epoch 1, received %d", cp.Epoch)
+	}
+	if err := Initialize(ctx, db, cp); err != nil {
+		t.Fatal(err)
+	}
+
+	blockRoot, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if finalized.Epoch != 1 || !bytes.Equal(finalized.Root, blockRoot[:]) {
+		t.Errorf("Unexpected finalized checkpoint %v", finalized)
+	}
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headState == nil || headState.Slot() != 8 {
+		t.Fatal("Expected head state at checkpoint slot")
+	}
+	anchorRoot, err := db.AnchorBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anchorRoot != blockRoot {
+		t.Errorf("Wanted anchor root %#x, received %#x", blockRoot, anchorRoot)
+	}
+	genesis, err := db.GenesisBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesis != nil {
+		t.Error("Expected the genesis block root to be kept separate from the checkpoint root")
+	}
+	if !db.IsFinalizedBlock(ctx, blockRoot) {
+		t.Error("Expected checkpoint block to be finalized")
+	}
+	coldState, err := db.ColdState(ctx, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coldState == nil || coldState.Slot() != 8 {
+		t.Error("Expected checkpoint state to be saved in cold storage")
+	}
+}
+
+func TestInitialize_MismatchedBlock(t *testing.T) {
+	db := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, db)
+
+	st, _ := checkpointAtSlot(t, 8)
+	_, otherBlk := checkpointAtSlot(t, 9)
+	err := Initialize(context.Background(), db, &Checkpoint{Epoch: 1, State: st, Block: otherBlk})
+	if err == nil || !strings.Contains(err.Error(), "does not match latest block") {
+		t.Errorf("Expected mismatched block error, received %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+package checkpointsync
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "checkpoint-sync")
@@ -9,10 +9,20 @@ const (
 	ChainStarted
 	// Initialized is sent when the internal beacon node's state is ready to be accessed.
 	Initialized
+	// NewHead is sent when the head of the chain changes.
+	NewHead
+	// Reorg is sent when the new head of the chain is not a descendant of the old head.
+	Reorg
+	// JustifiedCheckpoint is sent when the head state has a new current justified checkpoint.
+	JustifiedCheckpoint
+	// FinalizedCheckpoint is sent when the head state has a new finalized checkpoint.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
 type BlockProcessedData struct {
+	// Slot is the slot of the processed block.
+	Slot uint64
 	// BlockHash is the hash of the processed block.
 	BlockRoot [32]byte
 	// Verified is true if the block's BLS contents have been verified.
@@ -30,3 +40,36 @@ type InitializedData struct {
 	// StartTime is the time at which the chain started.
 	StartTime time.Time
 }
+
+// NewHeadData is the data sent with NewHead events.
+type NewHeadData struct {
+	// Slot is the slot of the new head block.
+	Slot uint64
+	// BlockRoot is the root of the new head block.
+	BlockRoot [32]byte
+	// StateRoot is the state root of the new head block.
+	StateRoot [32]byte
+}
+
+// ReorgData is the data sent with Reorg events.
+type ReorgData struct {
+	// Slot is the slot of the new head block.
+	Slot uint64
+	// Depth is the number of blocks of the old chain which are no longer canonical.
+	Depth uint64
+	// OldHeadRoot is the root of the head block before the reorg.
+	OldHeadRoot [32]byte
+	// NewHeadRoot is the root of the head block after the reorg.
+	NewHeadRoot [32]byte
+	// DroppedRoots are the roots of the blocks of the old chain which are no longer
+	// canonical, newest first.
+	DroppedRoots [][32]byte
+}
+
+// CheckpointData is the data sent with JustifiedCheckpoint and FinalizedCheckpoint events.
+type CheckpointData struct {
+	// Epoch is the epoch of the checkpoint.
+	Epoch uint64
+	// Root is the block root of the checkpoint.
+	Root [32]byte
+}
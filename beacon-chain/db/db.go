@@ -6,3 +6,9 @@ import "github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 func NewDB(dirPath string) (Database, error) {
 	return kv.NewKVStore(dirPath)
 }
+
+// DryRunMigrations reports the schema migrations which would be applied to the DB at dirPath
+// when it is next opened, without applying them.
+func DryRunMigrations(dirPath string) ([]string, error) {
+	return kv.DryRunMigrations(dirPath)
+}
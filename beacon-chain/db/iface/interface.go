@@ -34,6 +34,8 @@ type ReadOnlyDatabase interface {
 	State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error)
 	GenesisState(ctx context.Context) (*state.BeaconState, error)
 	HasState(ctx context.Context, blockRoot [32]byte) bool
+	ColdState(ctx context.Context, slot uint64) (*state.BeaconState, error)
+	LastColdStateSlot(ctx context.Context) (uint64, error)
 	// Slashing operations.
 	ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.ProposerSlashing, error)
 	AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.AttesterSlashing, error)
@@ -54,6 +56,11 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Backfill related methods.
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	AnchorBlockRoot(ctx context.Context) ([32]byte, error)
+	// Peer ban related methods.
+	PeerBans(ctx context.Context) ([]*db.PeerBan, error)
 }
 
 // NoHeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.NoHeadAccessDatabase
@@ -79,6 +86,7 @@ type NoHeadAccessDatabase interface {
 	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
 	DeleteState(ctx context.Context, blockRoot [32]byte) error
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
+	SaveColdState(ctx context.Context, state *state.BeaconState) error
 	// Slashing operations.
 	SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error
 	SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error
@@ -99,6 +107,11 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Backfill related methods.
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	// Peer ban related methods.
+	SavePeerBan(ctx context.Context, ban *db.PeerBan) error
+	DeletePeerBan(ctx context.Context, target string) error
 }
 
 // HeadAccessDatabase -- See github.com/prysmaticlabs/prysm/beacon-chain/db.HeadAccessDatabase
@@ -110,6 +123,8 @@ type HeadAccessDatabase interface {
 	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	HeadState(ctx context.Context) (*state.BeaconState, error)
+	// Checkpoint sync related methods.
+	SaveCheckpointAnchor(ctx context.Context, state *state.BeaconState, block *eth.SignedBeaconBlock, epoch uint64) error
 }
 
 // Database -- See github.com/prysmaticlabs/prysm/beacon-chain/db.Database
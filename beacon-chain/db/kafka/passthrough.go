@@ -117,6 +117,16 @@ func (e Exporter) GenesisState(ctx context.Context) (*state.BeaconState, error)
 	return e.db.GenesisState(ctx)
 }
 
+// ColdState -- passthrough.
+func (e Exporter) ColdState(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	return e.db.ColdState(ctx, slot)
+}
+
+// LastColdStateSlot -- passthrough.
+func (e Exporter) LastColdStateSlot(ctx context.Context) (uint64, error) {
+	return e.db.LastColdStateSlot(ctx)
+}
+
 // ProposerSlashing -- passthrough.
 func (e Exporter) ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.ProposerSlashing, error) {
 	return e.db.ProposerSlashing(ctx, slashingRoot)
@@ -227,6 +237,11 @@ func (e Exporter) SaveState(ctx context.Context, state *state.BeaconState, block
 	return e.db.SaveState(ctx, state, blockRoot)
 }
 
+// SaveColdState -- passthrough.
+func (e Exporter) SaveColdState(ctx context.Context, state *state.BeaconState) error {
+	return e.db.SaveColdState(ctx, state)
+}
+
 // SaveProposerSlashing -- passthrough.
 func (e Exporter) SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error {
 	return e.db.SaveProposerSlashing(ctx, slashing)
@@ -306,3 +321,38 @@ func (e Exporter) PowchainData(ctx context.Context) (*db.ETH1ChainData, error) {
 func (e Exporter) SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error {
 	return e.db.SavePowchainData(ctx, data)
 }
+
+// BackfillBlockRoot -- passthrough.
+func (e Exporter) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.BackfillBlockRoot(ctx)
+}
+
+// AnchorBlockRoot -- passthrough.
+func (e Exporter) AnchorBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.AnchorBlockRoot(ctx)
+}
+
+// SaveCheckpointAnchor -- passthrough.
+func (e Exporter) SaveCheckpointAnchor(ctx context.Context, state *state.BeaconState, block *eth.SignedBeaconBlock, epoch uint64) error {
+	return e.db.SaveCheckpointAnchor(ctx, state, block, epoch)
+}
+
+// SaveBackfillBlockRoot -- passthrough.
+func (e Exporter) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveBackfillBlockRoot(ctx, blockRoot)
+}
+
+// PeerBans -- passthrough.
+func (e Exporter) PeerBans(ctx context.Context) ([]*db.PeerBan, error) {
+	return e.db.PeerBans(ctx)
+}
+
+// SavePeerBan -- passthrough.
+func (e Exporter) SavePeerBan(ctx context.Context, ban *db.PeerBan) error {
+	return e.db.SavePeerBan(ctx, ban)
+}
+
+// DeletePeerBan -- passthrough.
+func (e Exporter) DeletePeerBan(ctx context.Context, target string) error {
+	return e.db.DeletePeerBan(ctx, target)
+}
@@ -0,0 +1,112 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// AnchorBlockRoot returns the root of the finalized checkpoint block the node was started
+// from, or a zero root if the node was started from genesis.
+func (k *Store) AnchorBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AnchorBlockRoot")
+	defer span.End()
+	var root [32]byte
+	err := k.db.View(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainMetadataBucket)
+		if enc := chainInfo.Get(anchorBlockRootKey); enc != nil {
+			root = bytesutil.ToBytes32(enc)
+		}
+		return nil
+	})
+	return root, err
+}
+
+// SaveCheckpointAnchor saves the block and post-state of a finalized checkpoint as the head,
+// justified and finalized block and state of a node started from that checkpoint rather than
+// from genesis. Everything is written in a single transaction, so an error cannot leave the
+// database partially initialized.
+func (k *Store) SaveCheckpointAnchor(ctx context.Context, st *state.BeaconState, signed *ethpb.SignedBeaconBlock, epoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveCheckpointAnchor")
+	defer span.End()
+
+	blockRoot, err := ssz.HashTreeRoot(signed.Block)
+	if err != nil {
+		return err
+	}
+	encBlock, err := encode(signed)
+	if err != nil {
+		return err
+	}
+	encState, err := encode(st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	encCheckpoint, err := encode(&ethpb.Checkpoint{Epoch: epoch, Root: blockRoot[:]})
+	if err != nil {
+		return err
+	}
+	encContainer, err := encode(&dbpb.FinalizedBlockRootContainer{ParentRoot: signed.Block.ParentRoot})
+	if err != nil {
+		return err
+	}
+
+	err = k.db.Update(func(tx *bolt.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		indicesByBucket := createBlockIndicesFromBlock(signed.Block)
+		if err := updateValueForIndices(indicesByBucket, blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update DB indices")
+		}
+		if err := blocks.Put(blockRoot[:], encBlock); err != nil {
+			return err
+		}
+		if err := blocks.Put(headBlockRootKey, blockRoot[:]); err != nil {
+			return err
+		}
+		if err := tx.Bucket(stateBucket).Put(blockRoot[:], encState); err != nil {
+			return err
+		}
+		// Keep the checkpoint state in cold storage so that states after the checkpoint can
+		// be regenerated once the hot state is pruned.
+		if err := tx.Bucket(coldStateBucket).Put(slotToColdStateKey(st.Slot()), encState); err != nil {
+			return err
+		}
+
+		checkpoints := tx.Bucket(checkpointBucket)
+		if err := checkpoints.Put(justifiedCheckpointKey, encCheckpoint); err != nil {
+			return err
+		}
+		if err := checkpoints.Put(finalizedCheckpointKey, encCheckpoint); err != nil {
+			return err
+		}
+		// The anchor block starts the finalized block roots index, as its ancestors are not held.
+		finalizedRoots := tx.Bucket(finalizedBlockRootsIndexBucket)
+		if err := finalizedRoots.Put(blockRoot[:], encContainer); err != nil {
+			return err
+		}
+		if err := finalizedRoots.Put(previousFinalizedCheckpointKey, encCheckpoint); err != nil {
+			return err
+		}
+
+		validators := tx.Bucket(validatorsBucket)
+		for i := 0; i < st.NumValidators(); i++ {
+			pubKey := st.PubkeyAtIndex(uint64(i))
+			if err := validators.Put(pubKey[:], uint64ToBytes(uint64(i))); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(chainMetadataBucket).Put(anchorBlockRootKey, blockRoot[:])
+	})
+	if err != nil {
+		return err
+	}
+	k.blockCache.Set(string(blockRoot[:]), signed, int64(len(encBlock)))
+	return nil
+}
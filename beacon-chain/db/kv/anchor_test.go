@@ -0,0 +1,104 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestStore_SaveCheckpointAnchor(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	// The parent of the anchor block is not held.
+	parentRoot := bytesutil.ToBytes32([]byte{'P'})
+	anchor := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slotsPerEpoch, ParentRoot: parentRoot[:]}}
+	anchorRoot, err := ssz.HashTreeRoot(anchor.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := [48]byte{'A'}
+	st, err := state.InitializeFromProto(&pb.BeaconState{
+		Slot:       slotsPerEpoch,
+		Validators: []*ethpb.Validator{{PublicKey: pubKey[:]}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveCheckpointAnchor(ctx, st, anchor, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := db.AnchorBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != anchorRoot {
+		t.Errorf("Wanted anchor root %#x, received %#x", anchorRoot, root)
+	}
+	head, err := db.HeadBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head == nil || head.Block.Slot != slotsPerEpoch {
+		t.Error("Expected the anchor block to be the head block")
+	}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if finalized.Epoch != 1 || bytesutil.ToBytes32(finalized.Root) != anchorRoot {
+		t.Errorf("Unexpected finalized checkpoint %v", finalized)
+	}
+	coldState, err := db.ColdState(ctx, slotsPerEpoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if coldState == nil || coldState.Slot() != slotsPerEpoch {
+		t.Error("Expected the anchor state to be saved in cold storage")
+	}
+	if idx, ok, err := db.ValidatorIndex(ctx, pubKey[:]); err != nil || !ok || idx != 0 {
+		t.Errorf("Expected validator index 0 to be saved, received %d, %v, %v", idx, ok, err)
+	}
+	genesis, err := db.GenesisBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if genesis != nil {
+		t.Error("Expected no genesis block for a node started from a checkpoint")
+	}
+	if !db.IsFinalizedBlock(ctx, anchorRoot) {
+		t.Error("Expected the anchor block to be finalized")
+	}
+
+	// Finalizing a later block indexes its ancestors back to the anchor block.
+	blks := makeBlocks(t, int(slotsPerEpoch), int(slotsPerEpoch)*2, anchorRoot)
+	if err := db.SaveBlocks(ctx, blks); err != nil {
+		t.Fatal(err)
+	}
+	finalizedRoot, err := ssz.HashTreeRoot(blks[slotsPerEpoch-1].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, finalizedRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalizedRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+	childRoot, err := ssz.HashTreeRoot(blks[0].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !db.IsFinalizedBlock(ctx, childRoot) {
+		t.Error("Expected the child of the anchor block to be finalized")
+	}
+}
@@ -0,0 +1,36 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// BackfillBlockRoot returns the root of the oldest block which has been backfilled and
+// verified to descend to the genesis block root, or a zero root if backfill has not started.
+func (k *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+	var root [32]byte
+	err := k.db.View(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainMetadataBucket)
+		if enc := chainInfo.Get(backfillBlockRootKey); enc != nil {
+			root = bytesutil.ToBytes32(enc)
+		}
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlockRoot saves the root of the oldest block which has been backfilled.
+func (k *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainMetadataBucket)
+		return chainInfo.Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
@@ -0,0 +1,31 @@
+package kv
+
+import (
+	"context"
+	"testing"
+)
+
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	root, err := db.BackfillBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != [32]byte{} {
+		t.Errorf("Expected zero root before backfill, received %#x", root)
+	}
+	want := [32]byte{'A'}
+	if err := db.SaveBackfillBlockRoot(ctx, want); err != nil {
+		t.Fatal(err)
+	}
+	root, err = db.BackfillBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != want {
+		t.Errorf("Wanted root %#x, received %#x", want, root)
+	}
+}
@@ -0,0 +1,81 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"go.opencensus.io/trace"
+)
+
+// SaveColdState stores a finalized state in cold storage, keyed by its slot.
+func (k *Store) SaveColdState(ctx context.Context, st *state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveColdState")
+	defer span.End()
+	enc, err := encode(st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(coldStateBucket)
+		return bucket.Put(slotToColdStateKey(st.Slot()), enc)
+	})
+}
+
+// ColdState returns the state in cold storage with the highest slot at or below the
+// requested slot. Returns nil if no such state exists.
+func (k *Store) ColdState(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ColdState")
+	defer span.End()
+	var enc []byte
+	err := k.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(coldStateBucket).Cursor()
+		key, v := c.Seek(slotToColdStateKey(slot))
+		// Seek positions the cursor at the first key at or above the requested slot,
+		// so step back unless the slot itself was found.
+		if key == nil || binary.BigEndian.Uint64(key) > slot {
+			_, v = c.Prev()
+		}
+		if v != nil {
+			enc = append([]byte{}, v...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if enc == nil {
+		return nil, nil
+	}
+	s, err := createState(enc)
+	if err != nil {
+		return nil, err
+	}
+	return state.InitializeFromProtoUnsafe(s)
+}
+
+// LastColdStateSlot returns the slot of the most recent state in cold storage, or 0 if
+// cold storage is empty.
+func (k *Store) LastColdStateSlot(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastColdStateSlot")
+	defer span.End()
+	var slot uint64
+	err := k.db.View(func(tx *bolt.Tx) error {
+		key, _ := tx.Bucket(coldStateBucket).Cursor().Last()
+		if key != nil {
+			slot = binary.BigEndian.Uint64(key)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// slotToColdStateKey encodes the slot big-endian, so that cold states are ordered by
+// slot within the bucket.
+func slotToColdStateKey(slot uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, slot)
+	return key
+}
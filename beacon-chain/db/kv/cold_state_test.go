@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestColdState_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slot, err := db.LastColdStateSlot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 0 {
+		t.Errorf("Wanted last cold state slot 0 for empty cold storage, received %d", slot)
+	}
+
+	for _, slot := range []uint64{64, 128, 512} {
+		st, err := state.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveColdState(ctx, st); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		slot   uint64
+		wanted uint64
+		exists bool
+	}{
+		{slot: 10, exists: false},
+		{slot: 64, wanted: 64, exists: true},
+		{slot: 100, wanted: 64, exists: true},
+		{slot: 300, wanted: 128, exists: true},
+		{slot: 1000, wanted: 512, exists: true},
+	}
+	for _, tt := range tests {
+		st, err := db.ColdState(ctx, tt.slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !tt.exists {
+			if st != nil {
+				t.Errorf("Wanted no cold state at or below slot %d, received state at slot %d", tt.slot, st.Slot())
+			}
+			continue
+		}
+		if st == nil {
+			t.Fatalf("Wanted cold state at or below slot %d, received nil", tt.slot)
+		}
+		if st.Slot() != tt.wanted {
+			t.Errorf("Wanted cold state at slot %d for slot %d, received %d", tt.wanted, tt.slot, st.Slot())
+		}
+	}
+
+	slot, err = db.LastColdStateSlot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slot != 512 {
+		t.Errorf("Wanted last cold state slot 512, received %d", slot)
+	}
+}
@@ -38,13 +38,16 @@ type Store struct {
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
-// path specified, creates the kv-buckets based on the schema, and stores
-// an open connection db object as a property of the Store struct.
+// path specified, creates the kv-buckets based on the schema, applies any
+// pending schema migrations, and stores an open connection db object as a
+// property of the Store struct.
 func NewKVStore(dirPath string) (*Store, error) {
 	if err := os.MkdirAll(dirPath, 0700); err != nil {
 		return nil, err
 	}
 	datafile := path.Join(dirPath, databaseFileName)
+	_, err := os.Stat(datafile)
+	newDatabase := os.IsNotExist(err)
 	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, InitialMmapSize: 10e6})
 	if err != nil {
 		if err == bolt.ErrTimeout {
@@ -78,36 +81,12 @@ func NewKVStore(dirPath string) (*Store, error) {
 		validatorIndexCache: validatorCache,
 	}
 
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
-		return createBuckets(
-			tx,
-			attestationsBucket,
-			blocksBucket,
-			stateBucket,
-			validatorsBucket,
-			proposerSlashingsBucket,
-			attesterSlashingsBucket,
-			voluntaryExitsBucket,
-			chainMetadataBucket,
-			checkpointBucket,
-			archivedValidatorSetChangesBucket,
-			archivedCommitteeInfoBucket,
-			archivedBalancesBucket,
-			archivedValidatorParticipationBucket,
-			powchainBucket,
-			// Indices buckets.
-			attestationHeadBlockRootBucket,
-			attestationSourceRootIndicesBucket,
-			attestationSourceEpochIndicesBucket,
-			attestationTargetRootIndicesBucket,
-			attestationTargetEpochIndicesBucket,
-			blockSlotIndicesBucket,
-			blockParentRootIndicesBucket,
-			finalizedBlockRootsIndexBucket,
-			// Migration bucket.
-			migrationBucket,
-		)
-	}); err != nil {
+	if err := kv.db.Update(createSchemaBuckets); err != nil {
+		return nil, err
+	}
+	if err := runMigrations(kv.db, newDatabase); err != nil {
+		// #nosec G104. Release the database lock, the migration error is the one to report.
+		kv.db.Close()
 		return nil, err
 	}
 
@@ -136,6 +115,40 @@ func (k *Store) DatabasePath() string {
 	return k.databasePath
 }
 
+// createSchemaBuckets creates all buckets of the current schema which do not yet exist.
+func createSchemaBuckets(tx *bolt.Tx) error {
+	return createBuckets(
+		tx,
+		attestationsBucket,
+		blocksBucket,
+		stateBucket,
+		coldStateBucket,
+		validatorsBucket,
+		proposerSlashingsBucket,
+		attesterSlashingsBucket,
+		voluntaryExitsBucket,
+		chainMetadataBucket,
+		checkpointBucket,
+		archivedValidatorSetChangesBucket,
+		archivedCommitteeInfoBucket,
+		archivedBalancesBucket,
+		archivedValidatorParticipationBucket,
+		powchainBucket,
+		peerBansBucket,
+		// Indices buckets.
+		attestationHeadBlockRootBucket,
+		attestationSourceRootIndicesBucket,
+		attestationSourceEpochIndicesBucket,
+		attestationTargetRootIndicesBucket,
+		attestationTargetEpochIndicesBucket,
+		blockSlotIndicesBucket,
+		blockParentRootIndicesBucket,
+		finalizedBlockRootsIndexBucket,
+		// Migration bucket.
+		migrationBucket,
+	)
+}
+
 func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
@@ -0,0 +1,157 @@
+package kv
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrNewerSchema is returned when opening a database written by a newer version of the
+// schema than this node supports.
+var ErrNewerSchema = errors.New("database was written by a newer schema version")
+
+// errDryRun is returned from a migration transaction to roll it back in dry-run mode.
+var errDryRun = errors.New("dry run")
+
+// migration is a numbered change to the database schema. Each migration is run in its own
+// bolt transaction, which also records the new schema version, so an interrupted upgrade
+// resumes from the first migration which has not been applied.
+type migration struct {
+	version     uint64
+	description string
+	migrate     func(tx *bolt.Tx) error
+}
+
+// migrations is the ordered registry of schema migrations. Versions must be consecutive,
+// starting from 1. Released migrations must never be changed or removed; schema changes
+// are made by appending a migration with the next version.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "Record the schema version of databases created before versioned migrations",
+		migrate:     func(*bolt.Tx) error { return nil },
+	},
+}
+
+// latestSchemaVersion is the schema version written by this node.
+func latestSchemaVersion() uint64 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].version
+}
+
+// schemaVersion returns the schema version recorded in the database, or 0 for a database
+// created before versioned migrations.
+func schemaVersion(tx *bolt.Tx) uint64 {
+	enc := tx.Bucket(migrationBucket).Get(schemaVersionKey)
+	if enc == nil {
+		return 0
+	}
+	return bytesutil.FromBytes8(enc)
+}
+
+// pendingMigrations returns the migrations which have not yet been applied to a database
+// at the given schema version.
+func pendingMigrations(version uint64) ([]migration, error) {
+	if version > latestSchemaVersion() {
+		return nil, errors.Wrapf(ErrNewerSchema, "database schema version %d, latest supported version %d", version, latestSchemaVersion())
+	}
+	var pending []migration
+	for _, m := range migrations {
+		if m.version > version {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// runMigrations brings the schema of the database up to the latest version. A new database
+// is stamped with the latest version without running any migrations.
+func runMigrations(db *bolt.DB, newDatabase bool) error {
+	if newDatabase {
+		return db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(migrationBucket).Put(schemaVersionKey, bytesutil.Bytes8(latestSchemaVersion()))
+		})
+	}
+
+	var pending []migration
+	if err := db.View(func(tx *bolt.Tx) error {
+		var err error
+		pending, err = pendingMigrations(schemaVersion(tx))
+		return err
+	}); err != nil {
+		return err
+	}
+	for _, m := range pending {
+		log.WithField("version", m.version).Infof("Applying database migration: %s", m.description)
+		if err := db.Update(func(tx *bolt.Tx) error {
+			return applyMigration(tx, m)
+		}); err != nil {
+			return errors.Wrapf(err, "could not apply database migration %d", m.version)
+		}
+	}
+	return nil
+}
+
+// applyMigration runs the migration and records its version within the given transaction.
+func applyMigration(tx *bolt.Tx, m migration) error {
+	// Guard against applying a migration twice, should it be reached out of order.
+	if schemaVersion(tx) >= m.version {
+		return nil
+	}
+	if err := m.migrate(tx); err != nil {
+		return err
+	}
+	return tx.Bucket(migrationBucket).Put(schemaVersionKey, bytesutil.Bytes8(m.version))
+}
+
+// DryRunMigrations runs the migrations which would be applied to the database in the given
+// directory, then rolls them back, returning a description of each. The database is left
+// unchanged. No migrations are reported if there is no database in the directory.
+func DryRunMigrations(dirPath string) ([]string, error) {
+	datafile := path.Join(dirPath, databaseFileName)
+	if _, err := os.Stat(datafile); os.IsNotExist(err) {
+		return nil, nil
+	}
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	defer func() {
+		if err := boltDB.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	var applied []string
+	err = boltDB.Update(func(tx *bolt.Tx) error {
+		if err := createSchemaBuckets(tx); err != nil {
+			return err
+		}
+		pending, err := pendingMigrations(schemaVersion(tx))
+		if err != nil {
+			return err
+		}
+		for _, m := range pending {
+			if err := applyMigration(tx, m); err != nil {
+				return errors.Wrapf(err, "could not apply database migration %d", m.version)
+			}
+			applied = append(applied, fmt.Sprintf("%d: %s", m.version, m.description))
+		}
+		return errDryRun
+	})
+	if err != errDryRun {
+		return nil, err
+	}
+	return applied, nil
+}
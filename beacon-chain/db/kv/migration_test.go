@@ -0,0 +1,154 @@
+package kv
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+var testMigrationKey = []byte("test-migration")
+
+// setTestMigrations replaces the migration registry with two migrations, the second of
+// which counts how many times it has been run. It returns a function to restore the registry.
+func setTestMigrations(runs *int) func() {
+	original := migrations
+	migrations = []migration{
+		{version: 1, description: "first", migrate: func(*bolt.Tx) error { return nil }},
+		{version: 2, description: "second", migrate: func(tx *bolt.Tx) error {
+			*runs++
+			return tx.Bucket(chainMetadataBucket).Put(testMigrationKey, []byte{1})
+		}},
+	}
+	return func() { migrations = original }
+}
+
+func setSchemaVersion(t *testing.T, db *Store, version uint64) {
+	if err := db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationBucket).Put(schemaVersionKey, bytesutil.Bytes8(version))
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readSchemaVersion(t *testing.T, db *Store) uint64 {
+	var version uint64
+	if err := db.db.View(func(tx *bolt.Tx) error {
+		version = schemaVersion(tx)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return version
+}
+
+func TestNewKVStore_NewDatabaseHasLatestSchemaVersion(t *testing.T) {
+	runs := 0
+	defer setTestMigrations(&runs)()
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if version := readSchemaVersion(t, db); version != 2 {
+		t.Errorf("Wanted schema version 2, received %d", version)
+	}
+	if runs != 0 {
+		t.Errorf("Expected no migrations to run on a new database, ran %d", runs)
+	}
+}
+
+func TestNewKVStore_AppliesPendingMigrationsOnce(t *testing.T) {
+	runs := 0
+	defer setTestMigrations(&runs)()
+	db := setupDB(t)
+	dirPath := db.databasePath
+	setSchemaVersion(t, db, 1)
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := NewKVStore(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version := readSchemaVersion(t, db); version != 2 {
+		t.Errorf("Wanted schema version 2, received %d", version)
+	}
+	if err := db.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(chainMetadataBucket).Get(testMigrationKey) == nil {
+			t.Error("Expected migration to have been applied")
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = NewKVStore(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(t, db)
+	if runs != 1 {
+		t.Errorf("Expected migration to run once, ran %d times", runs)
+	}
+}
+
+func TestNewKVStore_RefusesNewerSchema(t *testing.T) {
+	runs := 0
+	defer setTestMigrations(&runs)()
+	db := setupDB(t)
+	dirPath := db.databasePath
+	setSchemaVersion(t, db, 3)
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dirPath); err != nil {
+			t.Fatalf("Failed to remove directory: %v", err)
+		}
+	}()
+
+	if _, err := NewKVStore(dirPath); errors.Cause(err) != ErrNewerSchema {
+		t.Errorf("Wanted error %v, received %v", ErrNewerSchema, err)
+	}
+	if _, err := DryRunMigrations(dirPath); errors.Cause(err) != ErrNewerSchema {
+		t.Errorf("Wanted error %v from dry run, received %v", ErrNewerSchema, err)
+	}
+}
+
+func TestDryRunMigrations_LeavesDatabaseUnchanged(t *testing.T) {
+	runs := 0
+	defer setTestMigrations(&runs)()
+	db := setupDB(t)
+	dirPath := db.databasePath
+	setSchemaVersion(t, db, 0)
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	applied, err := DryRunMigrations(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != 2 || !strings.Contains(applied[0], "first") || !strings.Contains(applied[1], "second") {
+		t.Errorf("Unexpected migrations reported by dry run: %v", applied)
+	}
+	if runs != 1 {
+		t.Errorf("Expected dry run to run migration once, ran %d times", runs)
+	}
+
+	db, err = NewKVStore(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(t, db)
+	// The migration runs again when the database is opened, as the dry run was rolled back.
+	if runs != 2 {
+		t.Errorf("Expected migration to be applied after dry run, ran %d times", runs)
+	}
+}
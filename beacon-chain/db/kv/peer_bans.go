@@ -0,0 +1,66 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"go.opencensus.io/trace"
+)
+
+// PeerBans retrieves the bans of peers and IP addresses, including expired bans which
+// have not been deleted yet.
+func (k *Store) PeerBans(ctx context.Context) ([]*db.PeerBan, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PeerBans")
+	defer span.End()
+
+	bans := make([]*db.PeerBan, 0)
+	err := k.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerBansBucket)
+		return bkt.ForEach(func(k, v []byte) error {
+			ban := &db.PeerBan{}
+			if err := proto.Unmarshal(v, ban); err != nil {
+				return err
+			}
+			bans = append(bans, ban)
+			return nil
+		})
+	})
+	return bans, err
+}
+
+// SavePeerBan saves a ban of a peer or an IP address, replacing any existing ban of the
+// same peer or IP address.
+func (k *Store) SavePeerBan(ctx context.Context, ban *db.PeerBan) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SavePeerBan")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerBansBucket)
+		enc, err := proto.Marshal(ban)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(peerBanKey(ban), enc)
+	})
+}
+
+// DeletePeerBan deletes the ban of the given peer ID or IP address.
+func (k *Store) DeletePeerBan(ctx context.Context, target string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeletePeerBan")
+	defer span.End()
+
+	return k.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(peerBansBucket)
+		return bkt.Delete([]byte(target))
+	})
+}
+
+// peerBanKey keys a ban by the banned peer ID or IP address, which cannot collide.
+func peerBanKey(ban *db.PeerBan) []byte {
+	if ban.PeerId != "" {
+		return []byte(ban.PeerId)
+	}
+	return []byte(ban.Ip)
+}
@@ -0,0 +1,60 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+func TestStore_PeerBans_CRUD(t *testing.T) {
+	store := setupDB(t)
+	defer teardownDB(t, store)
+	ctx := context.Background()
+
+	bans, err := store.PeerBans(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 0 {
+		t.Fatalf("Expected no bans, received %v", bans)
+	}
+
+	peerBan := &db.PeerBan{PeerId: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", Expiry: 100, Reason: "spam"}
+	ipBan := &db.PeerBan{Ip: "213.202.254.180"}
+	if err := store.SavePeerBan(ctx, peerBan); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SavePeerBan(ctx, ipBan); err != nil {
+		t.Fatal(err)
+	}
+	// Saving a ban of the same peer replaces the existing ban.
+	peerBan.Expiry = 200
+	if err := store.SavePeerBan(ctx, peerBan); err != nil {
+		t.Fatal(err)
+	}
+	bans, err = store.PeerBans(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 2 {
+		t.Fatalf("Expected 2 bans, received %d", len(bans))
+	}
+	for _, ban := range bans {
+		if !proto.Equal(ban, peerBan) && !proto.Equal(ban, ipBan) {
+			t.Errorf("Unexpected ban %v", ban)
+		}
+	}
+
+	if err := store.DeletePeerBan(ctx, ipBan.Ip); err != nil {
+		t.Fatal(err)
+	}
+	bans, err = store.PeerBans(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 1 || !proto.Equal(bans[0], peerBan) {
+		t.Errorf("Expected only the peer ban to remain, received %v", bans)
+	}
+}
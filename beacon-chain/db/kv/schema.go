@@ -11,6 +11,7 @@ var (
 	blocksBucket                         = []byte("blocks")
 	validatorsBucket                     = []byte("validators")
 	stateBucket                          = []byte("state")
+	coldStateBucket                      = []byte("cold-state")
 	proposerSlashingsBucket              = []byte("proposer-slashings")
 	attesterSlashingsBucket              = []byte("attester-slashings")
 	voluntaryExitsBucket                 = []byte("voluntary-exits")
@@ -21,6 +22,7 @@ var (
 	archivedBalancesBucket               = []byte("archived-balances")
 	archivedValidatorParticipationBucket = []byte("archived-validator-participation")
 	powchainBucket                       = []byte("powchain")
+	peerBansBucket                       = []byte("peer-bans")
 
 	// Key indices buckets.
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
@@ -39,6 +41,9 @@ var (
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	schemaVersionKey          = []byte("schema-version")
+	backfillBlockRootKey      = []byte("backfill-block-root")
+	anchorBlockRootKey        = []byte("anchor-block-root")
 
 	// Migration bucket.
 	migrationBucket = []byte("migrations")
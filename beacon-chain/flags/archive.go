@@ -29,4 +29,11 @@ var (
 		Name:  "archive-attestations",
 		Usage: "Whether or not beacon chain should archive historical blocks",
 	}
+	// SlotsPerArchivePointFlag defines the interval in slots at which finalized states are
+	// kept in cold storage. States in between are regenerated by replaying blocks.
+	SlotsPerArchivePointFlag = cli.IntFlag{
+		Name:  "slots-per-archive-point",
+		Usage: "The slot interval at which finalized states are saved to cold storage, allowing any historical state to be regenerated by replaying blocks. Disabled when 0",
+		Value: 0,
+	}
 )
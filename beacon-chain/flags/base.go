@@ -39,6 +39,11 @@ var (
 		Usage: "RPC port exposed by a beacon node",
 		Value: 4000,
 	}
+	// AdminRPCPort defines the port of the RPC server serving the administrative services.
+	AdminRPCPort = cli.IntFlag{
+		Name:  "admin-rpc-port",
+		Usage: "Port of the RPC server serving administrative services, such as banning peers, on localhost only. Disabled if not set",
+	}
 	// RPCMaxPageSize defines the maximum numbers per page returned in RPC responses from this
 	// beacon node (default: 500).
 	RPCMaxPageSize = cli.IntFlag{
@@ -68,6 +73,36 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// RPCRequestsPerSecondFlag sets the rate at which the request budget of a peer refills for
+	// each p2p RPC topic.
+	RPCRequestsPerSecondFlag = cli.Float64Flag{
+		Name:  "p2p-rpc-requests-per-second",
+		Usage: "The number of requests per second a peer may make on each p2p RPC topic.",
+		Value: 5,
+	}
+	// RPCRequestsBurstFlag sets the number of requests a peer may make at once on a p2p RPC topic.
+	RPCRequestsBurstFlag = cli.Int64Flag{
+		Name:  "p2p-rpc-requests-burst",
+		Usage: "The number of requests a peer may make at once on each p2p RPC topic.",
+		Value: 50,
+	}
+	// RPCBlocksPerSecondFlag sets the rate at which the budget of blocks a peer may request refills.
+	RPCBlocksPerSecondFlag = cli.Float64Flag{
+		Name:  "p2p-rpc-blocks-per-second",
+		Usage: "The number of blocks per second a peer may request over p2p RPC.",
+		Value: 32,
+	}
+	// RPCBlocksBurstFlag sets the number of blocks a peer may request at once.
+	RPCBlocksBurstFlag = cli.Int64Flag{
+		Name:  "p2p-rpc-blocks-burst",
+		Usage: "The number of blocks a peer may request at once over p2p RPC.",
+		Value: 320,
+	}
+	// DBMigrationDryRunFlag reports the pending database schema migrations without applying them.
+	DBMigrationDryRunFlag = cli.BoolFlag{
+		Name:  "db-migration-dry-run",
+		Usage: "Report the schema migrations which would be applied to the database, then exit without changing it",
+	}
 	// ContractDeploymentBlock is the block in which the eth1 deposit contract was deployed.
 	ContractDeploymentBlock = cli.IntFlag{
 		Name:  "contract-deployment-block",
@@ -0,0 +1,33 @@
+package flags
+
+import (
+	"github.com/urfave/cli"
+)
+
+var (
+	// CheckpointStateFlag defines a flag for the beacon node to start from a finalized state loaded from file.
+	CheckpointStateFlag = cli.StringFlag{
+		Name:  "checkpoint-state",
+		Usage: "The finalized state file (.SSZ) to start syncing from instead of genesis. Requires --checkpoint-block",
+	}
+	// CheckpointBlockFlag defines a flag for the block of the finalized state given by CheckpointStateFlag.
+	CheckpointBlockFlag = cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "The signed block file (.SSZ) of the finalized state given by --checkpoint-state",
+	}
+	// CheckpointProviderFlag defines a flag for a trusted beacon node to fetch the finalized state and block from.
+	CheckpointProviderFlag = cli.StringFlag{
+		Name:  "checkpoint-provider",
+		Usage: "A trusted beacon node gRPC endpoint to fetch the finalized state and block from, to start syncing from instead of genesis",
+	}
+	// CheckpointCertFlag defines a flag for the TLS certificate of the checkpoint provider.
+	CheckpointCertFlag = cli.StringFlag{
+		Name:  "checkpoint-tls-cert",
+		Usage: "Certificate for secure gRPC connection to the checkpoint provider",
+	}
+	// BackfillBlocksFlag enables the backfill of blocks before the checkpoint the node was started from.
+	BackfillBlocksFlag = cli.BoolFlag{
+		Name:  "backfill-blocks",
+		Usage: "Fetch the blocks before the checkpoint the node was started from in the background, back to genesis",
+	}
+)
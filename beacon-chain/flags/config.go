@@ -13,6 +13,7 @@ type GlobalFlags struct {
 	EnableArchivedValidatorSetChanges bool
 	EnableArchivedBlocks              bool
 	EnableArchivedAttestations        bool
+	SlotsPerArchivePoint              uint64
 	MinimumSyncPeers                  int
 	MaxPageSize                       int
 	DeploymentBlock                   int
@@ -49,6 +50,9 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	if ctx.GlobalBool(ArchiveAttestationsFlag.Name) {
 		cfg.EnableArchivedAttestations = true
 	}
+	if ctx.GlobalInt(SlotsPerArchivePointFlag.Name) > 0 {
+		cfg.SlotsPerArchivePoint = uint64(ctx.GlobalInt(SlotsPerArchivePointFlag.Name))
+	}
 	cfg.MaxPageSize = ctx.GlobalInt(RPCMaxPageSize.Name)
 	cfg.DeploymentBlock = ctx.GlobalInt(ContractDeploymentBlock.Name)
 	configureMinimumPeers(ctx, cfg)
@@ -0,0 +1,92 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// eventsPath is the path serving the events of the beacon node as server-sent events.
+const eventsPath = "/eth/v1alpha1/events"
+
+// eventsHandler serves the StreamEvents RPC as server-sent events. Topics are selected
+// with the topics query parameter, for example ?topics=head,finalized_checkpoint, and
+// events of every topic are sent if none is given.
+func eventsHandler(client pb.EventsClient, marshaler gwruntime.Marshaler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		req := &pb.StreamEventsRequest{}
+		for _, param := range r.URL.Query()["topics"] {
+			for _, name := range strings.Split(param, ",") {
+				topic, ok := pb.EventTopic_value[strings.ToUpper(strings.TrimSpace(name))]
+				if !ok {
+					http.Error(w, fmt.Sprintf("Unknown topic %q", name), http.StatusBadRequest)
+					return
+				}
+				req.Topics = append(req.Topics, pb.EventTopic(topic))
+			}
+		}
+
+		stream, err := client.StreamEvents(r.Context(), req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		flusher.Flush()
+
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if r.Context().Err() == nil {
+					log.WithError(err).Debug("Events stream closed")
+				}
+				return
+			}
+			name, payload := eventPayload(event)
+			if payload == nil {
+				continue
+			}
+			data, err := marshaler.Marshal(payload)
+			if err != nil {
+				log.WithError(err).Error("Could not marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// eventPayload returns the server-sent event name and the payload of an event.
+func eventPayload(event *pb.Event) (string, interface{}) {
+	switch e := event.Event.(type) {
+	case *pb.Event_Block:
+		return "block", e.Block
+	case *pb.Event_Head:
+		return "head", e.Head
+	case *pb.Event_ChainReorg:
+		return "chain_reorg", e.ChainReorg
+	case *pb.Event_JustifiedCheckpoint:
+		return "justified_checkpoint", e.JustifiedCheckpoint
+	case *pb.Event_FinalizedCheckpoint:
+		return "finalized_checkpoint", e.FinalizedCheckpoint
+	case *pb.Event_VoluntaryExit:
+		return "voluntary_exit", e.VoluntaryExit
+	case *pb.Event_Slashing:
+		return "slashing", e.Slashing
+	default:
+		return "", nil
+	}
+}
@@ -30,20 +30,32 @@ var appFlags = []cli.Flag{
 	flags.HTTPWeb3ProviderFlag,
 	flags.RPCHost,
 	flags.RPCPort,
+	flags.AdminRPCPort,
 	flags.CertFlag,
 	flags.KeyFlag,
 	flags.GRPCGatewayPort,
 	flags.MinSyncPeers,
+	flags.RPCRequestsPerSecondFlag,
+	flags.RPCRequestsBurstFlag,
+	flags.RPCBlocksPerSecondFlag,
+	flags.RPCBlocksBurstFlag,
+	flags.DBMigrationDryRunFlag,
 	flags.RPCMaxPageSize,
 	flags.ContractDeploymentBlock,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
+	flags.CheckpointStateFlag,
+	flags.CheckpointBlockFlag,
+	flags.CheckpointProviderFlag,
+	flags.CheckpointCertFlag,
+	flags.BackfillBlocksFlag,
 	flags.ArchiveEnableFlag,
 	flags.ArchiveValidatorSetChangesFlag,
 	flags.ArchiveBlocksFlag,
 	flags.ArchiveAttestationsFlag,
+	flags.SlotsPerArchivePointFlag,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
@@ -66,6 +78,7 @@ var appFlags = []cli.Flag{
 	cmd.MonitoringPortFlag,
 	cmd.DisableMonitoringFlag,
 	cmd.ClearDB,
+	cmd.ChainConfigFileFlag,
 	cmd.ForceClearDB,
 	cmd.LogFormat,
 	cmd.MaxGoroutines,
@@ -154,6 +167,10 @@ func startNode(ctx *cli.Context) error {
 		golog.SetAllLoggers(gologging.DEBUG)
 	}
 
+	if ctx.GlobalBool(flags.DBMigrationDryRunFlag.Name) {
+		return node.DryRunDBMigrations(ctx)
+	}
+
 	beacon, err := node.NewBeaconNode(ctx)
 	if err != nil {
 		return err
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/archiver"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	checkpointsync "github.com/prysmaticlabs/prysm/beacon-chain/checkpoint-sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
@@ -30,7 +31,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -98,6 +101,14 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 			params.UseDemoBeaconConfig()
 		}
 	}
+	// Values from a chain config file take precedence over the selected preset.
+	if ctx.GlobalIsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFile); err != nil {
+			return nil, err
+		}
+		log.WithField("path", chainConfigFile).Info("Using chain config file")
+	}
 
 	beacon := &BeaconNode{
 		ctx:             ctx,
@@ -113,6 +124,10 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.initCheckpointSync(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerP2P(ctx); err != nil {
 		return nil, err
 	}
@@ -143,6 +158,10 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(ctx); err != nil {
 		return nil, err
 	}
@@ -262,6 +281,59 @@ func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	return nil
 }
 
+// initCheckpointSync saves a trusted finalized checkpoint to an empty database, if one is
+// configured, so that the node syncs forward from the checkpoint instead of from genesis.
+func (b *BeaconNode) initCheckpointSync(ctx *cli.Context) error {
+	statePath := ctx.GlobalString(flags.CheckpointStateFlag.Name)
+	blockPath := ctx.GlobalString(flags.CheckpointBlockFlag.Name)
+	provider := ctx.GlobalString(flags.CheckpointProviderFlag.Name)
+	if statePath == "" && blockPath == "" && provider == "" {
+		return nil
+	}
+
+	headState, err := b.db.HeadState(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "could not fetch head state")
+	}
+	if headState != nil {
+		log.Warn("Beacon chain data already exists in DB, ignoring checkpoint sync flags")
+		return nil
+	}
+
+	var cp *checkpointsync.Checkpoint
+	switch {
+	case provider != "":
+		log.WithField("provider", provider).Info("Fetching finalized checkpoint")
+		cp, err = checkpointsync.FetchFromProvider(context.Background(), provider, ctx.GlobalString(flags.CheckpointCertFlag.Name))
+	case statePath != "" && blockPath != "":
+		cp, err = checkpointsync.LoadFromFiles(statePath, blockPath)
+	default:
+		return errors.New("both --checkpoint-state and --checkpoint-block are required to start from a checkpoint file")
+	}
+	if err != nil {
+		return err
+	}
+	return checkpointsync.Initialize(context.Background(), b.db, cp)
+}
+
+// DryRunDBMigrations reports the schema migrations which would be applied to the beacon
+// chain database on startup, without applying them.
+func DryRunDBMigrations(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.GlobalString(cmd.DataDirFlag.Name), beaconChainDBName)
+	migrations, err := db.DryRunMigrations(dbPath)
+	if err != nil {
+		return errors.Wrap(err, "database migration dry run failed")
+	}
+	if len(migrations) == 0 {
+		log.WithField("database-path", dbPath).Info("Database schema is up to date")
+		return nil
+	}
+	for _, m := range migrations {
+		log.WithField("database-path", dbPath).Infof("Database migration would be applied: %s", m)
+	}
+	return nil
+}
+
 func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 	// Bootnode ENR may be a filepath to an ENR file.
 	bootnodeAddrs := strings.Split(ctx.GlobalString(cmd.BootstrapNode.Name), ",")
@@ -291,6 +363,7 @@ func (b *BeaconNode) registerP2P(ctx *cli.Context) error {
 		WhitelistCIDR:     ctx.GlobalString(cmd.P2PWhitelist.Name),
 		EnableUPnP:        ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
 		Encoding:          ctx.GlobalString(cmd.P2PEncoding.Name),
+		BeaconDB:          b.db,
 	})
 	if err != nil {
 		return err
@@ -312,6 +385,11 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		return err
 	}
 
+	var stateGen *stategen.State
+	if slotsPerArchivePoint := flags.Get().SlotsPerArchivePoint; slotsPerArchivePoint > 0 {
+		stateGen = stategen.New(b.db, slotsPerArchivePoint)
+	}
+
 	maxRoutines := ctx.GlobalInt64(cmd.MaxGoroutines.Name)
 	blockchainService, err := blockchain.NewService(context.Background(), &blockchain.Config{
 		BeaconDB:          b.db,
@@ -323,6 +401,7 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		MaxRoutines:       maxRoutines,
 		StateNotifier:     b,
 		ForkChoiceStore:   b.forkChoiceStore,
+		StateGen:          stateGen,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -402,13 +481,20 @@ func (b *BeaconNode) registerSyncService(ctx *cli.Context) error {
 	}
 
 	rs := prysmsync.NewRegularSync(&prysmsync.Config{
-		DB:            b.db,
-		P2P:           b.fetchP2P(ctx),
-		Chain:         chainService,
-		InitialSync:   initSync,
-		StateNotifier: b,
-		AttPool:       b.attestationPool,
-		ExitPool:      b.exitPool,
+		DB:                b.db,
+		P2P:               b.fetchP2P(ctx),
+		Chain:             chainService,
+		InitialSync:       initSync,
+		StateNotifier:     b,
+		OperationNotifier: b,
+		AttPool:           b.attestationPool,
+		ExitPool:          b.exitPool,
+		RateLimits: &prysmsync.RateLimits{
+			RequestsPerSecond: ctx.GlobalFloat64(flags.RPCRequestsPerSecondFlag.Name),
+			RequestsBurst:     ctx.GlobalInt64(flags.RPCRequestsBurstFlag.Name),
+			BlocksPerSecond:   ctx.GlobalFloat64(flags.RPCBlocksPerSecondFlag.Name),
+			BlocksBurst:       ctx.GlobalInt64(flags.RPCBlocksBurstFlag.Name),
+		},
 	})
 
 	return b.services.RegisterService(rs)
@@ -432,6 +518,25 @@ func (b *BeaconNode) registerInitialSyncService(ctx *cli.Context) error {
 
 }
 
+func (b *BeaconNode) registerBackfillService(ctx *cli.Context) error {
+	if !ctx.GlobalBool(flags.BackfillBlocksFlag.Name) {
+		return nil
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	svc := backfill.NewService(context.Background(), &backfill.Config{
+		DB:          b.db,
+		P2P:         b.fetchP2P(ctx),
+		InitialSync: initSync,
+	})
+
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -466,6 +571,7 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 
 	host := ctx.GlobalString(flags.RPCHost.Name)
 	port := ctx.GlobalString(flags.RPCPort.Name)
+	adminPort := ctx.GlobalInt(flags.AdminRPCPort.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	key := ctx.GlobalString(flags.KeyFlag.Name)
 	slasherCert := ctx.GlobalString(flags.SlasherCertFlag.Name)
@@ -475,15 +581,18 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 	rpcService := rpc.NewService(context.Background(), &rpc.Config{
 		Host:                  host,
 		Port:                  port,
+		AdminPort:             adminPort,
 		CertFlag:              cert,
 		KeyFlag:               key,
 		BeaconDB:              b.db,
 		Broadcaster:           b.fetchP2P(ctx),
 		PeersFetcher:          b.fetchP2P(ctx),
+		BanManager:            b.fetchP2P(ctx),
 		HeadFetcher:           chainService,
 		ForkFetcher:           chainService,
 		FinalizationFetcher:   chainService,
 		ParticipationFetcher:  chainService,
+		ReorgHistoryFetcher:   chainService,
 		BlockReceiver:         chainService,
 		AttestationReceiver:   chainService,
 		GenesisTimeFetcher:    chainService,
@@ -0,0 +1,238 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+// ErrNotBanned is returned when lifting the ban of a peer or IP address which is not banned.
+var ErrNotBanned = errors.New("peer or IP address is not banned")
+
+// banList holds the peers and IP addresses banned from connecting to the node. Bans are
+// kept in the database, if there is one, so that they survive restarts.
+type banList struct {
+	lock     sync.RWMutex
+	beaconDB db.NoHeadAccessDatabase
+	// bans are keyed by the banned peer ID or IP address.
+	bans map[string]*dbpb.PeerBan
+}
+
+// newBanList creates a ban list holding the bans stored in the database which have not
+// expired yet.
+func newBanList(ctx context.Context, beaconDB db.NoHeadAccessDatabase) (*banList, error) {
+	b := &banList{
+		beaconDB: beaconDB,
+		bans:     make(map[string]*dbpb.PeerBan),
+	}
+	if beaconDB == nil {
+		return b, nil
+	}
+	bans, err := beaconDB.PeerBans(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve peer bans")
+	}
+	for _, ban := range bans {
+		b.bans[banKey(ban)] = ban
+	}
+	if err := b.prune(ctx); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// add bans a peer or an IP address, replacing any existing ban of it.
+func (b *banList) add(ctx context.Context, ban *dbpb.PeerBan) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.beaconDB != nil {
+		if err := b.beaconDB.SavePeerBan(ctx, ban); err != nil {
+			return errors.Wrap(err, "could not save peer ban")
+		}
+	}
+	b.bans[banKey(ban)] = ban
+	return nil
+}
+
+// remove lifts the ban of a peer ID or IP address.
+func (b *banList) remove(ctx context.Context, target string) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	ban, ok := b.bans[target]
+	if !ok || expired(ban) {
+		return ErrNotBanned
+	}
+	if b.beaconDB != nil {
+		if err := b.beaconDB.DeletePeerBan(ctx, target); err != nil {
+			return errors.Wrap(err, "could not delete peer ban")
+		}
+	}
+	delete(b.bans, target)
+	return nil
+}
+
+// isBanned returns whether the peer, or the IP address of the given multiaddress, is
+// banned. The address may be nil if it is not known.
+func (b *banList) isBanned(pid peer.ID, addr ma.Multiaddr) bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	if ban, ok := b.bans[pid.String()]; ok && !expired(ban) {
+		return true
+	}
+	if ip := ipFromMultiAddr(addr); ip != nil {
+		if ban, ok := b.bans[ip.String()]; ok && !expired(ban) {
+			return true
+		}
+	}
+	return false
+}
+
+// list returns the bans which have not expired, ordered by the banned peer ID or IP address.
+func (b *banList) list() []*dbpb.PeerBan {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	bans := make([]*dbpb.PeerBan, 0, len(b.bans))
+	for _, ban := range b.bans {
+		if !expired(ban) {
+			bans = append(bans, ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return banKey(bans[i]) < banKey(bans[j])
+	})
+	return bans
+}
+
+// prune deletes the expired bans.
+func (b *banList) prune(ctx context.Context) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for key, ban := range b.bans {
+		if !expired(ban) {
+			continue
+		}
+		if b.beaconDB != nil {
+			if err := b.beaconDB.DeletePeerBan(ctx, key); err != nil {
+				return errors.Wrap(err, "could not delete expired peer ban")
+			}
+		}
+		delete(b.bans, key)
+	}
+	return nil
+}
+
+// BanPeer bans a peer from connecting to the node for the given duration, or permanently
+// if the duration is zero, and disconnects from it.
+func (s *Service) BanPeer(pid peer.ID, duration time.Duration, reason string) error {
+	if err := s.bans.add(s.ctx, newBan(pid.String(), "", duration, reason)); err != nil {
+		return err
+	}
+	log.WithField("peer", pid).WithField("reason", reason).Info("Banned peer")
+	return s.Disconnect(pid)
+}
+
+// BanIP bans all peers connecting from an IP address for the given duration, or
+// permanently if the duration is zero, and disconnects from the peers connected from it.
+func (s *Service) BanIP(ip net.IP, duration time.Duration, reason string) error {
+	if err := s.bans.add(s.ctx, newBan("", ip.String(), duration, reason)); err != nil {
+		return err
+	}
+	log.WithField("ip", ip).WithField("reason", reason).Info("Banned IP address")
+	// Disconnect from all of the matching peers even if some of the disconnections fail.
+	var disconnectErr error
+	for _, conn := range s.host.Network().Conns() {
+		if remoteIP := ipFromMultiAddr(conn.RemoteMultiaddr()); remoteIP != nil && remoteIP.Equal(ip) {
+			if err := s.Disconnect(conn.RemotePeer()); err != nil {
+				log.WithError(err).WithField("peer", conn.RemotePeer()).Error("Unable to disconnect from banned peer")
+				if disconnectErr == nil {
+					disconnectErr = errors.Wrapf(err, "could not disconnect from peer %s", conn.RemotePeer())
+				}
+			}
+		}
+	}
+	return disconnectErr
+}
+
+// Unban lifts the ban of the given peer ID or IP address.
+func (s *Service) Unban(target string) error {
+	if ip := net.ParseIP(target); ip != nil {
+		target = ip.String()
+	}
+	return s.bans.remove(s.ctx, target)
+}
+
+// Bans returns the active bans of peers and IP addresses.
+func (s *Service) Bans() []*dbpb.PeerBan {
+	return s.bans.list()
+}
+
+// isBannedAddr returns whether the peer of a multiaddress string, such as the address of
+// a bootnode or of a static peer, or its IP address is banned.
+func (s *Service) isBannedAddr(addr string) bool {
+	info, err := MakePeer(addr)
+	if err != nil {
+		return false
+	}
+	return s.isBannedAddrInfo(*info)
+}
+
+// withoutBannedAddrs returns the multiaddress strings whose peers are not banned.
+func (s *Service) withoutBannedAddrs(addrs []string) []string {
+	allowed := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if !s.isBannedAddr(addr) {
+			allowed = append(allowed, addr)
+		}
+	}
+	return allowed
+}
+
+func newBan(pid string, ip string, duration time.Duration, reason string) *dbpb.PeerBan {
+	ban := &dbpb.PeerBan{
+		PeerId: pid,
+		Ip:     ip,
+		Reason: reason,
+	}
+	if duration > 0 {
+		ban.Expiry = roughtime.Now().Add(duration).Unix()
+	}
+	return ban
+}
+
+func banKey(ban *dbpb.PeerBan) string {
+	if ban.PeerId != "" {
+		return ban.PeerId
+	}
+	return ban.Ip
+}
+
+func expired(ban *dbpb.PeerBan) bool {
+	return ban.Expiry != 0 && roughtime.Now().Unix() >= ban.Expiry
+}
+
+// ipFromMultiAddr returns the IP address of a multiaddress, or nil if it has none.
+func ipFromMultiAddr(addr ma.Multiaddr) net.IP {
+	if addr == nil {
+		return nil
+	}
+	for _, code := range []int{ma.P_IP4, ma.P_IP6} {
+		if value, err := addr.ValueForProtocol(code); err == nil {
+			return net.ParseIP(value)
+		}
+	}
+	return nil
+}
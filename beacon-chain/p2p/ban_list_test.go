@@ -0,0 +1,144 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+)
+
+func TestBanList_BanPeerAndIP(t *testing.T) {
+	ctx := context.Background()
+	b, err := newBanList(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := peer.ID("banned")
+	other := peer.ID("other")
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherAddr, err := ma.NewMultiaddr("/ip4/10.0.0.2/tcp/13000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.add(ctx, newBan(pid.String(), "", 0, "misbehaving")); err != nil {
+		t.Fatal(err)
+	}
+	if !b.isBanned(pid, nil) {
+		t.Error("Wanted peer to be banned")
+	}
+	if b.isBanned(other, otherAddr) {
+		t.Error("Wanted other peer not to be banned")
+	}
+
+	if err := b.add(ctx, newBan("", "10.0.0.1", time.Hour, "spam")); err != nil {
+		t.Fatal(err)
+	}
+	if !b.isBanned(other, addr) {
+		t.Error("Wanted peer connecting from banned IP to be banned")
+	}
+	if len(b.list()) != 2 {
+		t.Errorf("Wanted %d bans, got %d", 2, len(b.list()))
+	}
+
+	if err := b.remove(ctx, "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	if b.isBanned(other, addr) {
+		t.Error("Wanted peer connecting from unbanned IP not to be banned")
+	}
+	if err := b.remove(ctx, "10.0.0.1"); err != ErrNotBanned {
+		t.Errorf("Wanted error %v, got %v", ErrNotBanned, err)
+	}
+}
+
+func TestBanList_ExpiredBansArePruned(t *testing.T) {
+	ctx := context.Background()
+	b, err := newBanList(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := peer.ID("banned")
+	ban := newBan(pid.String(), "", time.Hour, "")
+	ban.Expiry = time.Now().Add(-time.Minute).Unix()
+	if err := b.add(ctx, ban); err != nil {
+		t.Fatal(err)
+	}
+	if b.isBanned(pid, nil) {
+		t.Error("Wanted expired ban to be ignored")
+	}
+	if len(b.list()) != 0 {
+		t.Errorf("Wanted no active bans, got %d", len(b.list()))
+	}
+	if err := b.prune(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.bans) != 0 {
+		t.Errorf("Wanted expired ban to be pruned, got %d bans", len(b.bans))
+	}
+}
+
+func TestBanList_PersistsAcrossRestarts(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	ctx := context.Background()
+
+	b, err := newBanList(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := peer.ID("banned")
+	if err := b.add(ctx, newBan(pid.String(), "", 0, "misbehaving")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.add(ctx, newBan("", "10.0.0.1", time.Hour, "")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.remove(ctx, "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := newBanList(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bans := reloaded.list()
+	if len(bans) != 1 {
+		t.Fatalf("Wanted %d ban, got %d", 1, len(bans))
+	}
+	if bans[0].PeerId != pid.String() || bans[0].Reason != "misbehaving" {
+		t.Errorf("Unexpected ban %v", bans[0])
+	}
+	if !reloaded.isBanned(pid, nil) {
+		t.Error("Wanted peer to still be banned after reload")
+	}
+}
+
+func TestService_WithoutBannedAddrs(t *testing.T) {
+	ctx := context.Background()
+	b, err := newBanList(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Service{bans: b}
+	bannedPeer := "/ip4/10.0.0.1/tcp/13000/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"
+	bannedIP := "/ip4/10.0.0.2/tcp/13000/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG"
+	allowed := "/ip4/10.0.0.3/tcp/13000/p2p/QmUn6ycS8Fu6L462uZvuEfDoSgYX6kqP4aSZWMa7z1tWAX"
+	if err := b.add(ctx, newBan("QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi", "", 0, "bootnode misbehaving")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.add(ctx, newBan("", "10.0.0.2", 0, "static peer misbehaving")); err != nil {
+		t.Fatal(err)
+	}
+
+	addrs := s.withoutBannedAddrs([]string{bannedPeer, bannedIP, allowed})
+	if len(addrs) != 1 || addrs[0] != allowed {
+		t.Errorf("Wanted only %s to be left, got %v", allowed, addrs)
+	}
+}
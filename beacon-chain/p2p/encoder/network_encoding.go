@@ -2,6 +2,7 @@ package encoder
 
 import (
 	"io"
+	"strings"
 )
 
 // Defines the different encoding formats
@@ -10,6 +11,25 @@ const (
 	SSZSnappy = "ssz-snappy" // SSZSnappy is SSZ with snappy compression.
 )
 
+// Protocol ID suffixes of the encoding formats.
+const (
+	ProtocolSuffixSSZ       = "/ssz"
+	ProtocolSuffixSSZSnappy = "/ssz_snappy"
+)
+
+// RPCProtocolSuffixes are the suffixes req/resp protocols are registered under, in order of
+// preference when opening a stream to a peer.
+var RPCProtocolSuffixes = []string{ProtocolSuffixSSZSnappy, ProtocolSuffixSSZ}
+
+// ForProtocol returns the encoding of a req/resp protocol ID, as given by its suffix. Protocols
+// without a known suffix are SSZ encoded.
+func ForProtocol(id string) NetworkEncoding {
+	if strings.HasSuffix(id, ProtocolSuffixSSZSnappy) {
+		return &SszNetworkEncoder{UseSnappyCompression: true}
+	}
+	return &SszNetworkEncoder{}
+}
+
 // NetworkEncoding represents an encoder compatible with Ethereum 2.0 p2p.
 type NetworkEncoding interface {
 	// Decodes to the provided message. The interface must be a pointer to the decoding destination.
@@ -1,6 +1,7 @@
 package encoder
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 
@@ -12,7 +13,9 @@ import (
 var _ = NetworkEncoding(&SszNetworkEncoder{})
 
 // SszNetworkEncoder supports p2p networking encoding using SimpleSerialize
-// with snappy compression (if enabled).
+// with snappy compression (if enabled). Gossip messages are compressed as a
+// single snappy block, while length prefixed req/resp messages use the snappy
+// framing format.
 type SszNetworkEncoder struct {
 	UseSnappyCompression bool
 }
@@ -47,12 +50,11 @@ func (e SszNetworkEncoder) EncodeWithLength(w io.Writer, msg interface{}) (int,
 	if msg == nil {
 		return 0, nil
 	}
-	b, err := e.doEncode(msg)
+	b, err := ssz.Marshal(msg)
 	if err != nil {
 		return 0, err
 	}
-	b = append(proto.EncodeVarint(uint64(len(b))), b...)
-	return w.Write(b)
+	return e.writeWithLength(w, b)
 }
 
 // EncodeWithMaxLength the proto message to the io.Writer. This encoding prefixes the byte slice with a protobuf varint
@@ -61,15 +63,34 @@ func (e SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg interface{}, max
 	if msg == nil {
 		return 0, nil
 	}
-	b, err := e.doEncode(msg)
+	b, err := ssz.Marshal(msg)
 	if err != nil {
 		return 0, err
 	}
 	if uint64(len(b)) > maxSize {
 		return 0, fmt.Errorf("size of encoded message is %d which is larger than the provided max limit of %d", len(b), maxSize)
 	}
-	b = append(proto.EncodeVarint(uint64(len(b))), b...)
-	return w.Write(b)
+	return e.writeWithLength(w, b)
+}
+
+// writeWithLength writes the varint length of the serialized message followed by the
+// message. With snappy compression, the length is the one of the uncompressed message
+// and the message is written in the snappy framing format, so that it can be read as a
+// stream.
+func (e SszNetworkEncoder) writeWithLength(w io.Writer, b []byte) (int, error) {
+	buf := bytes.NewBuffer(proto.EncodeVarint(uint64(len(b))))
+	if e.UseSnappyCompression {
+		sw := snappy.NewBufferedWriter(buf)
+		if _, err := sw.Write(b); err != nil {
+			return 0, err
+		}
+		if err := sw.Close(); err != nil {
+			return 0, err
+		}
+	} else {
+		buf.Write(b)
+	}
+	return w.Write(buf.Bytes())
 }
 
 // Decode the bytes to the protobuf message provided.
@@ -91,12 +112,7 @@ func (e SszNetworkEncoder) DecodeWithLength(r io.Reader, to interface{}) error {
 	if err != nil {
 		return err
 	}
-	b := make([]byte, msgLen)
-	_, err = r.Read(b)
-	if err != nil {
-		return err
-	}
-	return e.Decode(b, to)
+	return e.readWithLength(r, msgLen, to)
 }
 
 // DecodeWithMaxLength the bytes from io.Reader to the protobuf message provided.
@@ -109,18 +125,25 @@ func (e SszNetworkEncoder) DecodeWithMaxLength(r io.Reader, to interface{}, maxS
 	if msgLen > maxSize {
 		return fmt.Errorf("size of decoded message is %d which is larger than the provided max limit of %d", msgLen, maxSize)
 	}
+	return e.readWithLength(r, msgLen, to)
+}
+
+// readWithLength reads a serialized message of the given length, written by writeWithLength.
+func (e SszNetworkEncoder) readWithLength(r io.Reader, msgLen uint64, to interface{}) error {
+	if e.UseSnappyCompression {
+		r = snappy.NewReader(r)
+	}
 	b := make([]byte, msgLen)
-	_, err = r.Read(b)
-	if err != nil {
+	if _, err := io.ReadFull(r, b); err != nil {
 		return err
 	}
-	return e.Decode(b, to)
+	return ssz.Unmarshal(b, to)
 }
 
 // ProtocolSuffix returns the appropriate suffix for protocol IDs.
 func (e SszNetworkEncoder) ProtocolSuffix() string {
 	if e.UseSnappyCompression {
-		return "/ssz_snappy"
+		return ProtocolSuffixSSZSnappy
 	}
-	return "/ssz"
+	return ProtocolSuffixSSZ
 }
@@ -103,3 +103,51 @@ func TestSszNetworkEncoder_DecodeWithMaxLength(t *testing.T) {
 		t.Errorf("error did not contain wanted message. Wanted: %s but Got: %s", wanted, err.Error())
 	}
 }
+
+func TestSszNetworkEncoder_DecodeWithLength_Snappy_Stream(t *testing.T) {
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: true}
+	// Larger than the 64KiB maximum size of a snappy frame.
+	msgs := []*testpb.TestSimpleMessage{
+		{Foo: bytes.Repeat([]byte("foo"), 1<<15), Bar: 1},
+		{Foo: []byte("bar"), Bar: 2},
+	}
+	buf := new(bytes.Buffer)
+	for _, msg := range msgs {
+		if _, err := e.EncodeWithLength(buf, msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Messages written one after the other are read back one at a time from the stream.
+	for _, msg := range msgs {
+		decoded := &testpb.TestSimpleMessage{}
+		if err := e.DecodeWithMaxLength(buf, decoded, 1<<20); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(decoded, msg) {
+			t.Errorf("Decoded message with bar %d is not the same as original", decoded.Bar)
+		}
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Wanted stream to be fully read, %d bytes left", buf.Len())
+	}
+}
+
+func TestForProtocol(t *testing.T) {
+	tests := []struct {
+		protocol string
+		snappy   bool
+	}{
+		{protocol: "/eth2/beacon_chain/req/status/1/ssz_snappy", snappy: true},
+		{protocol: "/eth2/beacon_chain/req/status/1/ssz", snappy: false},
+		{protocol: "/testing", snappy: false},
+	}
+	for _, tt := range tests {
+		e, ok := encoder.ForProtocol(tt.protocol).(*encoder.SszNetworkEncoder)
+		if !ok {
+			t.Fatalf("Wanted SSZ encoder for protocol %s", tt.protocol)
+		}
+		if e.UseSnappyCompression != tt.snappy {
+			t.Errorf("Wanted snappy compression %v for protocol %s, got %v", tt.snappy, tt.protocol, e.UseSnappyCompression)
+		}
+	}
+}
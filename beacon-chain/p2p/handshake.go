@@ -20,6 +20,16 @@ func (s *Service) AddConnectionHandler(reqFunc func(ctx context.Context, id peer
 		ConnectedF: func(net network.Network, conn network.Conn) {
 			log := log.WithField("peer", conn.RemotePeer().Pretty())
 
+			// Banned peers are disconnected first, whatever the state of the peer, as static
+			// peers and bootnodes may be dialled again while still marked as connected.
+			if s.bans.isBanned(conn.RemotePeer(), conn.RemoteMultiaddr()) {
+				log.WithField("reason", "banned peer").Trace("Ignoring connection request")
+				if err := s.Disconnect(conn.RemotePeer()); err != nil {
+					log.WithError(err).Error("Unable to disconnect from peer")
+				}
+				return
+			}
+
 			// Handle the various pre-existing conditions that will result in us not handshaking.
 			peerConnectionState, err := s.peers.ConnectionState(conn.RemotePeer())
 			if err == nil && (peerConnectionState == peers.PeerConnected || peerConnectionState == peers.PeerConnecting) {
@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/network"
@@ -9,6 +11,8 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
 // P2P represents the full p2p interface composed of all of the sub-interfaces.
@@ -21,6 +25,8 @@ type P2P interface {
 	Sender
 	ConnectionHandler
 	PeersProvider
+	MetadataProvider
+	BanManager
 }
 
 // Broadcaster broadcasts messages to peers over the p2p pubsub protocol.
@@ -53,6 +59,8 @@ type PubSubProvider interface {
 type PeerManager interface {
 	Disconnect(peer.ID) error
 	PeerID() peer.ID
+	RefreshENR()
+	FindPeersWithSubnet(index uint64) (bool, error)
 }
 
 // Sender abstracts the sending functionality from libp2p.
@@ -64,3 +72,17 @@ type Sender interface {
 type PeersProvider interface {
 	Peers() *peers.Status
 }
+
+// BanManager manages the peers and IP addresses banned from connecting to the node.
+type BanManager interface {
+	BanPeer(pid peer.ID, duration time.Duration, reason string) error
+	BanIP(ip net.IP, duration time.Duration, reason string) error
+	Unban(target string) error
+	Bans() []*dbpb.PeerBan
+}
+
+// MetadataProvider returns the metadata related information for the local peer.
+type MetadataProvider interface {
+	Metadata() *pb.MetaData
+	MetadataSeq() uint64
+}
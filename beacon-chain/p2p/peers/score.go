@@ -0,0 +1,194 @@
+package peers
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+const (
+	// badPeerScore is the score at or below which a peer is considered bad. Bad peers are
+	// disconnected, their new connections are refused and they are not used for syncing.
+	badPeerScore = -1.0
+
+	// invalidGossipWeight is the score of each gossip message from the peer that failed validation.
+	invalidGossipWeight = -0.2
+	// ignoredGossipWeight is the score of each gossip message from the peer that was ignored,
+	// for example because it was stale or already known.
+	ignoredGossipWeight = -0.01
+	// maxIgnoredGossipPenalty caps the penalty of ignored gossip, so that ignored messages alone
+	// never make a peer bad.
+	maxIgnoredGossipPenalty = -0.3
+	// firstSeenGossipWeight is the score of each valid gossip message first seen from the peer.
+	firstSeenGossipWeight = 0.005
+	// maxFirstSeenGossipScore caps the score earned from valid gossip, so that forwarding valid
+	// messages cannot hide an arbitrary amount of bad behaviour.
+	maxFirstSeenGossipScore = 0.5
+	// gossipDecayFactor is the factor gossip counters are multiplied by on every decay.
+	gossipDecayFactor = 0.5
+	// IgnoredGossipDecayInterval is the interval at which DecayIgnoredGossip should run. Messages
+	// are often ignored for transient reasons, so their penalty is forgiven much faster than
+	// that of invalid messages.
+	IgnoredGossipDecayInterval = time.Minute
+
+	// slowResponseThreshold is the average time to the first byte of RPC responses above which
+	// the peer is penalised.
+	slowResponseThreshold = 2 * time.Second
+	// maxSlowResponsePenalty is the penalty of a peer whose average response time is twice
+	// slowResponseThreshold or more.
+	maxSlowResponsePenalty = -0.5
+	// responseLatencyWeight is the weight of the latest response time in the moving average of
+	// the response times of a peer.
+	responseLatencyWeight = 0.2
+
+	// staleStatusPenalty is the penalty of a peer whose chain state has not been updated for
+	// staleStatusEpochs epochs.
+	staleStatusPenalty = -0.25
+	staleStatusEpochs  = 2
+)
+
+// ScoreBreakdown is the score of a peer broken down into the components it is made of. A
+// component is negative if it penalises the peer and positive if it rewards it.
+type ScoreBreakdown struct {
+	// BadResponses is the score of invalid or erroneous RPC responses and requests.
+	BadResponses float64
+	// ResponseLatency is the score of the average time to the first byte of RPC responses.
+	ResponseLatency float64
+	// InvalidGossip is the score of gossip messages that failed validation.
+	InvalidGossip float64
+	// IgnoredGossip is the score of gossip messages that were ignored.
+	IgnoredGossip float64
+	// FirstSeenGossip is the score of valid gossip messages first seen from the peer.
+	FirstSeenGossip float64
+	// StaleStatus is the score of the freshness of the chain state of the peer.
+	StaleStatus float64
+}
+
+// Total returns the overall score of the peer.
+func (b *ScoreBreakdown) Total() float64 {
+	return b.BadResponses + b.ResponseLatency + b.InvalidGossip + b.IgnoredGossip + b.FirstSeenGossip + b.StaleStatus
+}
+
+// Score returns the overall score of the given remote peer.
+// If the peer is unknown this will return 0, the score of a peer we know nothing about.
+func (p *Status) Score(pid peer.ID) float64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return p.scoreBreakdown(status).Total()
+	}
+	return 0
+}
+
+// ScoreBreakdown returns the components of the score of the given remote peer.
+// This will error if the peer does not exist.
+func (p *Status) ScoreBreakdown(pid peer.ID) (*ScoreBreakdown, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return p.scoreBreakdown(status), nil
+	}
+	return nil, ErrPeerUnknown
+}
+
+// IncrementInvalidGossip increments the number of gossip messages from the given remote peer that failed validation.
+func (p *Status) IncrementInvalidGossip(pid peer.ID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.fetch(pid)
+	status.invalidGossip++
+}
+
+// IncrementIgnoredGossip increments the number of gossip messages from the given remote peer that were ignored.
+func (p *Status) IncrementIgnoredGossip(pid peer.ID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.fetch(pid)
+	status.ignoredGossip++
+}
+
+// IncrementFirstSeenGossip increments the number of valid gossip messages first seen from the given remote peer.
+func (p *Status) IncrementFirstSeenGossip(pid peer.ID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.fetch(pid)
+	status.firstSeenGossip++
+}
+
+// DecayIgnoredGossip reduces the number of ignored gossip messages of all peers.
+func (p *Status) DecayIgnoredGossip() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, status := range p.status {
+		status.ignoredGossip *= gossipDecayFactor
+	}
+}
+
+// RecordResponseLatency adds the time to the first byte of an RPC response of the given remote peer to the moving
+// average of its response times.
+func (p *Status) RecordResponseLatency(pid peer.ID, latency time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.fetch(pid)
+	if status.responseLatency == 0 {
+		status.responseLatency = latency
+		return
+	}
+	status.responseLatency = time.Duration(responseLatencyWeight*float64(latency) + (1-responseLatencyWeight)*float64(status.responseLatency))
+}
+
+// ResponseLatency returns the moving average of the time to the first byte of RPC responses of the given remote peer.
+// This will error if the peer does not exist.
+func (p *Status) ResponseLatency(pid peer.ID) (time.Duration, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return status.responseLatency, nil
+	}
+	return 0, ErrPeerUnknown
+}
+
+// scoreBreakdown calculates the score of a peer. The caller must hold the lock.
+func (p *Status) scoreBreakdown(status *peerStatus) *ScoreBreakdown {
+	b := &ScoreBreakdown{
+		InvalidGossip:   invalidGossipWeight * status.invalidGossip,
+		IgnoredGossip:   ignoredGossipWeight * status.ignoredGossip,
+		FirstSeenGossip: firstSeenGossipWeight * status.firstSeenGossip,
+	}
+	if b.IgnoredGossip < maxIgnoredGossipPenalty {
+		b.IgnoredGossip = maxIgnoredGossipPenalty
+	}
+	if b.FirstSeenGossip > maxFirstSeenGossipScore {
+		b.FirstSeenGossip = maxFirstSeenGossipScore
+	}
+	// Reaching the maximum number of bad responses on its own makes a peer bad.
+	if p.maxBadResponses > 0 {
+		b.BadResponses = badPeerScore * float64(status.badResponses) / float64(p.maxBadResponses)
+	}
+	if status.responseLatency > slowResponseThreshold {
+		excess := float64(status.responseLatency-slowResponseThreshold) / float64(slowResponseThreshold)
+		if excess > 1 {
+			excess = 1
+		}
+		b.ResponseLatency = maxSlowResponsePenalty * excess
+	}
+	// Peers without a chain state are still handshaking, so they are not penalised.
+	if status.chainState != nil && roughtime.Now().After(status.chainStateLastUpdated.Add(staleStatusAge())) {
+		b.StaleStatus = staleStatusPenalty
+	}
+	return b
+}
+
+// staleStatusAge is the age after which the chain state of a peer is considered stale.
+func staleStatusAge() time.Duration {
+	return time.Duration(staleStatusEpochs*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+}
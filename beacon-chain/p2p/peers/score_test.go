@@ -0,0 +1,166 @@
+package peers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestScore_UnknownPeer(t *testing.T) {
+	p := peers.NewStatus(2)
+	pid := addPeer(t, p, peers.PeerConnected)
+	p2 := peers.NewStatus(2)
+	if score := p2.Score(pid); score != 0 {
+		t.Errorf("Wanted score 0 for unknown peer, got %v", score)
+	}
+	if _, err := p2.ScoreBreakdown(pid); err != peers.ErrPeerUnknown {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if score := p.Score(pid); score != 0 {
+		t.Errorf("Wanted score 0 for new peer, got %v", score)
+	}
+}
+
+func TestScore_InvalidGossipMakesPeerBad(t *testing.T) {
+	p := peers.NewStatus(2)
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	for i := 0; i < 4; i++ {
+		p.IncrementInvalidGossip(pid)
+	}
+	if p.IsBad(pid) {
+		t.Error("Peer marked as bad before reaching the bad score")
+	}
+	p.IncrementInvalidGossip(pid)
+	if !p.IsBad(pid) {
+		t.Errorf("Peer not marked as bad with score %v", p.Score(pid))
+	}
+	if len(p.Bad()) != 1 {
+		t.Errorf("Wanted %d bad peers, got %d", 1, len(p.Bad()))
+	}
+
+	// Decaying gives the peer another chance.
+	p.Decay()
+	if p.IsBad(pid) {
+		t.Errorf("Peer still marked as bad after decay with score %v", p.Score(pid))
+	}
+}
+
+func TestScore_FirstSeenGossipRewardIsCapped(t *testing.T) {
+	p := peers.NewStatus(2)
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	for i := 0; i < 1000; i++ {
+		p.IncrementFirstSeenGossip(pid)
+	}
+	breakdown, err := p.ScoreBreakdown(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakdown.FirstSeenGossip != 0.5 {
+		t.Errorf("Wanted capped first seen gossip score %v, got %v", 0.5, breakdown.FirstSeenGossip)
+	}
+
+	// A peer with good gossip tolerates more bad responses, but not an unbounded amount.
+	p.IncrementBadResponses(pid)
+	p.IncrementBadResponses(pid)
+	if p.IsBad(pid) {
+		t.Error("Peer with good gossip marked as bad")
+	}
+	p.IncrementBadResponses(pid)
+	if !p.IsBad(pid) {
+		t.Errorf("Peer not marked as bad with score %v", p.Score(pid))
+	}
+}
+
+func TestScore_IgnoredGossipPenaltyIsCapped(t *testing.T) {
+	p := peers.NewStatus(2)
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	for i := 0; i < 1000; i++ {
+		p.IncrementIgnoredGossip(pid)
+	}
+	breakdown, err := p.ScoreBreakdown(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakdown.IgnoredGossip != -0.3 {
+		t.Errorf("Wanted capped ignored gossip penalty %v, got %v", -0.3, breakdown.IgnoredGossip)
+	}
+	if p.IsBad(pid) {
+		t.Errorf("Peer marked as bad for ignored gossip with score %v", p.Score(pid))
+	}
+
+	// Ignored gossip decays on its own schedule.
+	for i := 0; i < 10; i++ {
+		p.DecayIgnoredGossip()
+	}
+	breakdown, err = p.ScoreBreakdown(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakdown.IgnoredGossip < -0.01 {
+		t.Errorf("Wanted ignored gossip penalty of less than one message after decay, got %v", breakdown.IgnoredGossip)
+	}
+}
+
+func TestScore_ResponseLatency(t *testing.T) {
+	p := peers.NewStatus(2)
+	pid := addPeer(t, p, peers.PeerConnected)
+
+	p.RecordResponseLatency(pid, 100*time.Millisecond)
+	breakdown, err := p.ScoreBreakdown(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakdown.ResponseLatency != 0 {
+		t.Errorf("Wanted no penalty for fast responses, got %v", breakdown.ResponseLatency)
+	}
+
+	for i := 0; i < 50; i++ {
+		p.RecordResponseLatency(pid, 10*time.Second)
+	}
+	latency, err := p.ResponseLatency(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latency < 9*time.Second {
+		t.Errorf("Wanted average latency close to %v, got %v", 10*time.Second, latency)
+	}
+	breakdown, err = p.ScoreBreakdown(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakdown.ResponseLatency != -0.5 {
+		t.Errorf("Wanted maximum slow response penalty %v, got %v", -0.5, breakdown.ResponseLatency)
+	}
+}
+
+func TestBestFinalized_IgnoresBadPeersAndOrdersByScore(t *testing.T) {
+	p := peers.NewStatus(2)
+	root := [32]byte{'a'}
+
+	pid1 := addPeer(t, p, peers.PeerConnected)
+	pid2 := addPeer(t, p, peers.PeerConnected)
+	pid3 := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pid1, &pb.Status{FinalizedEpoch: 4, FinalizedRoot: root[:]})
+	p.SetChainState(pid2, &pb.Status{FinalizedEpoch: 4, FinalizedRoot: root[:]})
+	p.SetChainState(pid3, &pb.Status{FinalizedEpoch: 5, FinalizedRoot: root[:]})
+
+	p.IncrementFirstSeenGossip(pid2)
+	p.IncrementBadResponses(pid3)
+	p.IncrementBadResponses(pid3)
+
+	_, epoch, pids := p.BestFinalized(10, 0)
+	if epoch != 4 {
+		t.Errorf("Wanted finalized epoch %d, got %d", 4, epoch)
+	}
+	if len(pids) != 2 {
+		t.Fatalf("Wanted %d peers, got %d", 2, len(pids))
+	}
+	if pids[0] != pid2 || pids[1] != pid1 {
+		t.Errorf("Wanted peers ordered by score, got %v", pids)
+	}
+}
@@ -16,7 +16,8 @@
 // - inactive if we are disconnecting or disconnected
 //
 // Peer information is persistent for the run of the service.  This allows for collection of useful long-term statistics such as
-// number of bad responses obtained from the peer, giving the basis for decisions to not talk to known-bad peers.
+// number of bad responses obtained from the peer and the outcome of validating its gossip messages.  These statistics are combined
+// into a score, giving the basis for decisions to not talk to known-bad peers.
 package peers
 
 import (
@@ -67,6 +68,11 @@ type peerStatus struct {
 	chainState            *pb.Status
 	chainStateLastUpdated time.Time
 	badResponses          int
+	metaData              *pb.MetaData
+	invalidGossip         float64
+	ignoredGossip         float64
+	firstSeenGossip       float64
+	responseLatency       time.Duration
 }
 
 // NewStatus creates a new status entity.
@@ -77,7 +83,8 @@ func NewStatus(maxBadResponses int) *Status {
 	}
 }
 
-// MaxBadResponses returns the maximum number of bad responses a peer can provide before it is considered bad.
+// MaxBadResponses returns the maximum number of bad responses a peer can provide before it is considered bad, if it has
+// no other contributions to its score.
 func (p *Status) MaxBadResponses() int {
 	return p.maxBadResponses
 }
@@ -150,6 +157,28 @@ func (p *Status) ChainState(pid peer.ID) (*pb.Status, error) {
 	return nil, ErrPeerUnknown
 }
 
+// SetMetadata sets the metadata of the given remote peer.
+func (p *Status) SetMetadata(pid peer.ID, metaData *pb.MetaData) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	status := p.fetch(pid)
+	status.metaData = metaData
+}
+
+// Metadata returns the metadata of the given remote peer.
+// This can return nil if there is no known metadata for the peer.
+// This will error if the peer does not exist.
+func (p *Status) Metadata(pid peer.ID) (*pb.MetaData, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if status, ok := p.status[pid]; ok {
+		return status.metaData, nil
+	}
+	return nil, ErrPeerUnknown
+}
+
 // SetConnectionState sets the connection state of the given remote peer.
 func (p *Status) SetConnectionState(pid peer.ID, state PeerConnectionState) {
 	p.lock.Lock()
@@ -204,14 +233,14 @@ func (p *Status) BadResponses(pid peer.ID) (int, error) {
 	return -1, ErrPeerUnknown
 }
 
-// IsBad states if the peer is to be considered bad.
+// IsBad states if the peer is to be considered bad, which is the case when its score has dropped to the bad peer score.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
 
 	if status, ok := p.status[pid]; ok {
-		return status.badResponses >= p.maxBadResponses
+		return p.scoreBreakdown(status).Total() <= badPeerScore
 	}
 	return false
 }
@@ -300,7 +329,7 @@ func (p *Status) Bad() []peer.ID {
 	defer p.lock.RUnlock()
 	peers := make([]peer.ID, 0)
 	for pid, status := range p.status {
-		if status.badResponses >= p.maxBadResponses {
+		if p.scoreBreakdown(status).Total() <= badPeerScore {
 			peers = append(peers, pid)
 		}
 	}
@@ -318,7 +347,7 @@ func (p *Status) All() []peer.ID {
 	return pids
 }
 
-// Decay reduces the bad responses of all peers, giving reformed peers a chance to join the network.
+// Decay reduces the bad responses and the invalid and first seen gossip statistics of all peers, giving reformed peers a chance to join the network.
 // This can be run periodically, although note that each time it runs it does give all bad peers another chance as well to clog up
 // the network with bad responses, so should not be run too frequently; once an hour would be reasonable.
 func (p *Status) Decay() {
@@ -328,6 +357,8 @@ func (p *Status) Decay() {
 		if status.badResponses > 0 {
 			status.badResponses--
 		}
+		status.invalidGossip *= gossipDecayFactor
+		status.firstSeenGossip *= gossipDecayFactor
 	}
 }
 
@@ -335,14 +366,21 @@ func (p *Status) Decay() {
 // This method may not return the absolute highest finalized, but the finalized epoch in which most peers can serve blocks.
 // Ideally, all peers would be reporting the same finalized epoch but some may be behind due to their own latency, or because of
 // their finalized epoch at the time we queried them.
+// Bad peers are not taken into account, and peers with the same finalized epoch are ordered by decreasing score.
 // Returns the best finalized root, epoch number, and list of peers that are at or beyond that epoch.
 func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte, uint64, []peer.ID) {
 	connected := p.Connected()
 	finalized := make(map[[32]byte]uint64)
 	rootToEpoch := make(map[[32]byte]uint64)
 	pidEpochs := make(map[peer.ID]uint64)
+	pidScores := make(map[peer.ID]float64)
 	potentialPIDs := make([]peer.ID, 0, len(connected))
 	for _, pid := range connected {
+		score := p.Score(pid)
+		if score <= badPeerScore {
+			continue
+		}
+		pidScores[pid] = score
 		peerChainState, err := p.ChainState(pid)
 		if err == nil && peerChainState != nil && peerChainState.FinalizedEpoch >= ourFinalizedEpoch {
 			root := bytesutil.ToBytes32(peerChainState.FinalizedRoot)
@@ -364,8 +402,11 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte,
 	}
 	targetEpoch := rootToEpoch[targetRoot]
 
-	// Sort PIDs by finalized epoch, in decreasing order.
+	// Sort PIDs by finalized epoch and then by score, in decreasing order.
 	sort.Slice(potentialPIDs, func(i, j int) bool {
+		if pidEpochs[potentialPIDs[i]] == pidEpochs[potentialPIDs[j]] {
+			return pidScores[potentialPIDs[i]] > pidScores[potentialPIDs[j]]
+		}
 		return pidEpochs[potentialPIDs[i]] > pidEpochs[potentialPIDs[j]]
 	})
 
@@ -385,6 +426,78 @@ func (p *Status) BestFinalized(maxPeers int, ourFinalizedEpoch uint64) ([]byte,
 	return targetRoot[:], targetEpoch, potentialPIDs
 }
 
+// BestHead returns the head root above our head slot that is advertised by the most peers, with ties broken in
+// favour of the highest head slot. As a root has a single slot, the slot of the chosen root is the one reported by
+// most of its peers, with ties broken in favour of the lowest slot, and peers reporting another slot for it are
+// ignored. Bad peers are not taken into account.
+// Returns the head root, its slot, and the list of peers advertising it at that slot ordered by decreasing score.
+func (p *Status) BestHead(maxPeers int, ourHeadSlot uint64) ([]byte, uint64, []peer.ID) {
+	votes := make(map[[32]byte]uint64)
+	slotVotes := make(map[[32]byte]map[uint64]uint64)
+	pidRoots := make(map[peer.ID][32]byte)
+	pidSlots := make(map[peer.ID]uint64)
+	pidScores := make(map[peer.ID]float64)
+	for _, pid := range p.Connected() {
+		score := p.Score(pid)
+		if score <= badPeerScore {
+			continue
+		}
+		peerChainState, err := p.ChainState(pid)
+		if err != nil || peerChainState == nil || peerChainState.HeadSlot <= ourHeadSlot {
+			continue
+		}
+		root := bytesutil.ToBytes32(peerChainState.HeadRoot)
+		votes[root]++
+		if slotVotes[root] == nil {
+			slotVotes[root] = make(map[uint64]uint64)
+		}
+		slotVotes[root][peerChainState.HeadSlot]++
+		pidRoots[pid] = root
+		pidSlots[pid] = peerChainState.HeadSlot
+		pidScores[pid] = score
+	}
+
+	rootToSlot := make(map[[32]byte]uint64, len(slotVotes))
+	for root, slots := range slotVotes {
+		var rootSlot, mostSlotVotes uint64
+		for slot, count := range slots {
+			if count > mostSlotVotes || (count == mostSlotVotes && slot < rootSlot) {
+				mostSlotVotes = count
+				rootSlot = slot
+			}
+		}
+		rootToSlot[root] = rootSlot
+	}
+
+	var targetRoot [32]byte
+	var mostVotes uint64
+	for root, count := range votes {
+		if count > mostVotes || (count == mostVotes && rootToSlot[root] > rootToSlot[targetRoot]) {
+			mostVotes = count
+			targetRoot = root
+		}
+	}
+	if mostVotes == 0 {
+		return nil, 0, []peer.ID{}
+	}
+	targetSlot := rootToSlot[targetRoot]
+
+	pids := make([]peer.ID, 0, mostVotes)
+	for pid, root := range pidRoots {
+		if root == targetRoot && pidSlots[pid] == targetSlot {
+			pids = append(pids, pid)
+		}
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		return pidScores[pids[i]] > pidScores[pids[j]]
+	})
+	if len(pids) > maxPeers {
+		pids = pids[:maxPeers]
+	}
+
+	return targetRoot[:], targetSlot, pids
+}
+
 // fetch is a helper function that fetches a peer status, possibly creating it.
 func (p *Status) fetch(pid peer.ID) *peerStatus {
 	if _, ok := p.status[pid]; !ok {
@@ -182,6 +182,41 @@ func TestPeerChainState(t *testing.T) {
 	}
 }
 
+func TestPeerMetadata(t *testing.T) {
+	maxBadResponses := 2
+	p := peers.NewStatus(maxBadResponses)
+
+	id, err := peer.IDB58Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Metadata(id); err != peers.ErrPeerUnknown {
+		t.Errorf("Unexpected error: expected %v, received %v", peers.ErrPeerUnknown, err)
+	}
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	if err != nil {
+		t.Fatalf("Failed to create address: %v", err)
+	}
+	p.Add(id, address, network.DirInbound)
+
+	metaData, err := p.Metadata(id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if metaData != nil {
+		t.Errorf("Unexpected metadata for new peer: %v", metaData)
+	}
+
+	p.SetMetadata(id, &pb.MetaData{SeqNumber: 5, Attnets: []byte{1, 0, 0, 0, 0, 0, 0, 0}})
+	metaData, err = p.Metadata(id)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if metaData.SeqNumber != 5 {
+		t.Errorf("Unexpected sequence number: expected %v, received %v", 5, metaData.SeqNumber)
+	}
+}
+
 func TestPeerBadResponses(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(maxBadResponses)
@@ -458,6 +493,95 @@ func TestBestFinalized_returnsMaxValue(t *testing.T) {
 	}
 }
 
+func TestBestHead(t *testing.T) {
+	p := peers.NewStatus(2)
+	rootA := []byte("head a")
+	rootB := []byte("head b")
+
+	// Two peers behind us are ignored.
+	for i := 0; i < 2; i++ {
+		pid := addPeer(t, p, peers.PeerConnected)
+		p.SetChainState(pid, &pb.Status{HeadRoot: []byte("old head"), HeadSlot: 5})
+	}
+	pidA1 := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pidA1, &pb.Status{HeadRoot: rootA, HeadSlot: 20})
+	pidA2 := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pidA2, &pb.Status{HeadRoot: rootA, HeadSlot: 20})
+	pidB := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pidB, &pb.Status{HeadRoot: rootB, HeadSlot: 30})
+	p.IncrementFirstSeenGossip(pidA2)
+
+	root, slot, pids := p.BestHead(10, 10)
+	if !bytes.Equal(bytes.TrimRight(root, "\x00"), rootA) {
+		t.Errorf("Wanted head root %#x, got %#x", rootA, root)
+	}
+	if slot != 20 {
+		t.Errorf("Wanted head slot %d, got %d", 20, slot)
+	}
+	if len(pids) != 2 || pids[0] != pidA2 || pids[1] != pidA1 {
+		t.Errorf("Wanted peers %v ordered by score, got %v", []peer.ID{pidA2, pidA1}, pids)
+	}
+
+	// Once the peers advertising the same head are bad, the other head is chosen.
+	for i := 0; i < 2; i++ {
+		p.IncrementBadResponses(pidA1)
+		p.IncrementBadResponses(pidA2)
+		p.IncrementBadResponses(pidA2)
+	}
+	root, slot, pids = p.BestHead(10, 10)
+	if !bytes.Equal(bytes.TrimRight(root, "\x00"), rootB) || slot != 30 || len(pids) != 1 || pids[0] != pidB {
+		t.Errorf("Wanted head %#x at slot %d from peer %s, got %#x at slot %d from %v", rootB, 30, pidB, root, slot, pids)
+	}
+
+	_, _, pids = p.BestHead(10, 30)
+	if len(pids) != 0 {
+		t.Errorf("Wanted no peers ahead of our head, got %v", pids)
+	}
+}
+
+func TestBestHead_IgnoresPeerLyingAboutSlot(t *testing.T) {
+	p := peers.NewStatus(2)
+	root := []byte("head")
+
+	var honestPIDs []peer.ID
+	for i := 0; i < 3; i++ {
+		pid := addPeer(t, p, peers.PeerConnected)
+		p.SetChainState(pid, &pb.Status{HeadRoot: root, HeadSlot: 20})
+		honestPIDs = append(honestPIDs, pid)
+	}
+	// A peer repeating the same head root with an inflated slot.
+	liar := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(liar, &pb.Status{HeadRoot: root, HeadSlot: 1000})
+	p.IncrementFirstSeenGossip(liar)
+
+	headRoot, slot, pids := p.BestHead(10, 10)
+	if !bytes.Equal(bytes.TrimRight(headRoot, "\x00"), root) {
+		t.Errorf("Wanted head root %#x, got %#x", root, headRoot)
+	}
+	if slot != 20 {
+		t.Errorf("Wanted head slot %d, got %d", 20, slot)
+	}
+	if len(pids) != len(honestPIDs) {
+		t.Fatalf("Wanted peers %v, got %v", honestPIDs, pids)
+	}
+	for _, pid := range pids {
+		if pid == liar {
+			t.Errorf("Did not want peer %s lying about its head slot, got %v", liar, pids)
+		}
+	}
+
+	// On a tie, the lowest slot is chosen.
+	p = peers.NewStatus(2)
+	pidA := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pidA, &pb.Status{HeadRoot: root, HeadSlot: 20})
+	pidB := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(pidB, &pb.Status{HeadRoot: root, HeadSlot: 1000})
+	_, slot, pids = p.BestHead(10, 10)
+	if slot != 20 || len(pids) != 1 || pids[0] != pidA {
+		t.Errorf("Wanted head slot %d from peer %s, got slot %d from %v", 20, pidA, slot, pids)
+	}
+}
+
 // addPeer is a helper to add a peer with a given connection state)
 func addPeer(t *testing.T, p *peers.Status, state peers.PeerConnectionState) peer.ID {
 	// Set up some peers with different states
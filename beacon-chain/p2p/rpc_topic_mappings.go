@@ -6,6 +6,10 @@ import (
 	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
+// RPCMetaDataTopic is the protocol ID of metadata requests. Metadata requests have no
+// request body, so they are mapped to an empty interface pointer.
+const RPCMetaDataTopic = "/eth2/beacon_chain/req/metadata/1"
+
 // RPCTopicMappings represent the protocol ID to protobuf message type map for easy
 // lookup. These mappings should be used for outbound sending only. Peers may respond
 // with a different message type as defined by the p2p protocol.
@@ -14,6 +18,8 @@ var RPCTopicMappings = map[string]interface{}{
 	"/eth2/beacon_chain/req/goodbye/1":                new(uint64),
 	"/eth2/beacon_chain/req/beacon_blocks_by_range/1": &p2ppb.BeaconBlocksByRangeRequest{},
 	"/eth2/beacon_chain/req/beacon_blocks_by_root/1":  [][32]byte{},
+	"/eth2/beacon_chain/req/ping/1":                   &p2ppb.Ping{},
+	RPCMetaDataTopic:                                  new(interface{}),
 }
 
 // RPCTypeMapping is the inverse of RPCTopicMappings so that an arbitrary protobuf message
@@ -3,11 +3,14 @@ package p2p
 import (
 	"context"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -17,19 +20,22 @@ import (
 func (s *Service) Send(ctx context.Context, message interface{}, pid peer.ID) (network.Stream, error) {
 	ctx, span := trace.StartSpan(ctx, "p2p.Send")
 	defer span.End()
-	topic := RPCTypeMapping[reflect.TypeOf(message)] + s.Encoding().ProtocolSuffix()
-	span.AddAttributes(trace.StringAttribute("topic", topic))
+	baseTopic := RPCTypeMapping[reflect.TypeOf(message)]
+	span.AddAttributes(trace.StringAttribute("topic", baseTopic))
 
 	// TTFB_TIME (5s) + RESP_TIMEOUT (10s).
 	const deadline = 15 * time.Second
 	ctx, cancel := context.WithTimeout(ctx, deadline)
 	defer cancel()
 
-	stream, err := s.host.NewStream(ctx, pid, protocol.ID(topic))
+	// The first encoding supported by the peer is negotiated, so that peers which do not
+	// support snappy compression can still be requested.
+	stream, err := s.host.NewStream(ctx, pid, rpcProtocols(baseTopic)...)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, err
 	}
+	encoding := encoder.ForProtocol(string(stream.Protocol()))
 	if err := stream.SetReadDeadline(time.Now().Add(deadline)); err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, err
@@ -38,9 +44,12 @@ func (s *Service) Send(ctx context.Context, message interface{}, pid peer.ID) (n
 		traceutil.AnnotateError(span, err)
 		return nil, err
 	}
-	if _, err := s.Encoding().EncodeWithLength(stream, message); err != nil {
-		traceutil.AnnotateError(span, err)
-		return nil, err
+	// Metadata requests have no request body.
+	if baseTopic != RPCMetaDataTopic {
+		if _, err := encoding.EncodeWithLength(stream, message); err != nil {
+			traceutil.AnnotateError(span, err)
+			return nil, err
+		}
 	}
 
 	// Close stream for writing.
@@ -49,5 +58,35 @@ func (s *Service) Send(ctx context.Context, message interface{}, pid peer.ID) (n
 		return nil, err
 	}
 
-	return stream, nil
+	return &latencyStream{Stream: stream, peers: s.peers, sent: time.Now()}, nil
+}
+
+// rpcProtocols returns the protocol IDs of a req/resp topic for each encoding, in order
+// of preference.
+func rpcProtocols(baseTopic string) []protocol.ID {
+	protocols := make([]protocol.ID, len(encoder.RPCProtocolSuffixes))
+	for i, suffix := range encoder.RPCProtocolSuffixes {
+		protocols[i] = protocol.ID(baseTopic + suffix)
+	}
+	return protocols
+}
+
+// latencyStream records the time to the first byte of the response to a request in the
+// score of the peer.
+type latencyStream struct {
+	network.Stream
+	peers *peers.Status
+	sent  time.Time
+	once  sync.Once
+}
+
+// Read reads from the underlying stream, recording the response latency on the first read.
+func (l *latencyStream) Read(b []byte) (int, error) {
+	n, err := l.Stream.Read(b)
+	if n > 0 {
+		l.once.Do(func() {
+			l.peers.RecordResponseLatency(l.Conn().RemotePeer(), time.Since(l.sent))
+		})
+	}
+	return n, err
 }
@@ -9,6 +9,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	testpb "github.com/prysmaticlabs/prysm/proto/testing"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -20,8 +22,9 @@ func TestService_Send(t *testing.T) {
 	p1.Connect(p2)
 
 	svc := &Service{
-		host: p1.Host,
-		cfg:  &Config{Encoding: "ssz"},
+		host:  p1.Host,
+		cfg:   &Config{Encoding: "ssz"},
+		peers: peers.NewStatus(maxBadResponses),
 	}
 
 	msg := &testpb.TestSimpleMessage{
@@ -66,4 +69,86 @@ func TestService_Send(t *testing.T) {
 		t.Errorf("Expected identical message to be received. got %v want %v", rcvd, msg)
 	}
 
+	// The response latency is recorded in the score of the peer.
+	latency, err := svc.peers.ResponseLatency(p2.Host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latency == 0 {
+		t.Error("Expected response latency of peer to be recorded")
+	}
+}
+
+func TestService_Send_NegotiatesEncoding(t *testing.T) {
+	msg := &testpb.TestSimpleMessage{
+		Foo: []byte("hello"),
+		Bar: 55,
+	}
+	RPCTypeMapping[reflect.TypeOf(msg)] = "/testing/1"
+
+	tests := []struct {
+		name      string
+		suffixes  []string
+		wantProto string
+	}{
+		{
+			name:      "snappy preferred",
+			suffixes:  []string{encoder.ProtocolSuffixSSZ, encoder.ProtocolSuffixSSZSnappy},
+			wantProto: "/testing/1/ssz_snappy",
+		},
+		{
+			name:      "fallback to ssz",
+			suffixes:  []string{encoder.ProtocolSuffixSSZ},
+			wantProto: "/testing/1/ssz",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := testp2p.NewTestP2P(t)
+			p2 := testp2p.NewTestP2P(t)
+			p1.Connect(p2)
+			svc := &Service{
+				host:  p1.Host,
+				cfg:   &Config{Encoding: "ssz"},
+				peers: peers.NewStatus(maxBadResponses),
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			for _, suffix := range tt.suffixes {
+				p2.SetStreamHandler("/testing/1"+suffix, func(stream network.Stream) {
+					defer wg.Done()
+					e := encoder.ForProtocol(string(stream.Protocol()))
+					rcvd := &testpb.TestSimpleMessage{}
+					if err := e.DecodeWithLength(stream, rcvd); err != nil {
+						t.Error(err)
+						return
+					}
+					if _, err := e.EncodeWithLength(stream, rcvd); err != nil {
+						t.Error(err)
+					}
+					if err := stream.Close(); err != nil {
+						t.Error(err)
+					}
+				})
+			}
+
+			stream, err := svc.Send(context.Background(), msg, p2.Host.ID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.WaitTimeout(&wg, 1*time.Second)
+
+			if string(stream.Protocol()) != tt.wantProto {
+				t.Errorf("Wanted protocol %s, got %s", tt.wantProto, stream.Protocol())
+			}
+			rcvd := &testpb.TestSimpleMessage{}
+			if err := encoder.ForProtocol(string(stream.Protocol())).DecodeWithLength(stream, rcvd); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(rcvd, msg) {
+				t.Errorf("Expected identical message to be received. got %v want %v", rcvd, msg)
+			}
+		})
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -24,7 +25,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 )
 
@@ -51,6 +54,9 @@ type Service struct {
 	privKey       *ecdsa.PrivateKey
 	dht           *kaddht.IpfsDHT
 	peers         *peers.Status
+	bans          *banList
+	metaData      *pb.MetaData
+	metaDataLock  sync.RWMutex
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -69,6 +75,7 @@ func NewService(cfg *Config) (*Service, error) {
 		cancel:        cancel,
 		cfg:           cfg,
 		exclusionList: cache,
+		metaData:      &pb.MetaData{Attnets: attSubnetBitfield(nil)},
 	}
 
 	dv5Nodes, kadDHTNodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -126,6 +133,11 @@ func NewService(cfg *Config) (*Service, error) {
 	s.pubsub = gs
 
 	s.peers = peers.NewStatus(maxBadResponses)
+	s.bans, err = newBanList(s.ctx, cfg.BeaconDB)
+	if err != nil {
+		log.WithError(err).Error("Failed to load peer ban list")
+		return nil, err
+	}
 
 	return s, nil
 }
@@ -138,7 +150,7 @@ func (s *Service) Start() {
 	}
 
 	var peersToWatch []string
-	if s.cfg.RelayNodeAddr != "" {
+	if s.cfg.RelayNodeAddr != "" && !s.isBannedAddr(s.cfg.RelayNodeAddr) {
 		peersToWatch = append(peersToWatch, s.cfg.RelayNodeAddr)
 		if err := dialRelayNode(s.ctx, s.host, s.cfg.RelayNodeAddr); err != nil {
 			log.WithError(err).Errorf("Could not dial relay node")
@@ -171,6 +183,10 @@ func (s *Service) Start() {
 
 	if len(s.cfg.KademliaBootStrapAddr) != 0 && !s.cfg.NoDiscovery {
 		for _, addr := range s.cfg.KademliaBootStrapAddr {
+			if s.isBannedAddr(addr) {
+				log.WithField("addr", addr).Warn("Not connecting to banned bootnode")
+				continue
+			}
 			peersToWatch = append(peersToWatch, addr)
 			err := startDHTDiscovery(s.host, addr)
 			if err != nil {
@@ -207,10 +223,23 @@ func (s *Service) Start() {
 
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, 5*time.Second, func() {
-		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
+		// Peers may be banned after startup, in which case they are no longer reconnected to.
+		ensurePeerConnections(s.ctx, s.host, s.withoutBannedAddrs(peersToWatch)...)
 	})
 	runutil.RunEvery(s.ctx, time.Hour, s.Peers().Decay)
+	runutil.RunEvery(s.ctx, peers.IgnoredGossipDecayInterval, s.Peers().DecayIgnoredGossip)
+	runutil.RunEvery(s.ctx, 10*time.Second, s.disconnectBadPeers)
+	runutil.RunEvery(s.ctx, time.Minute, func() {
+		if err := s.bans.prune(s.ctx); err != nil {
+			log.WithError(err).Error("Could not prune expired peer bans")
+		}
+	})
 	runutil.RunEvery(s.ctx, 10*time.Second, s.updateMetrics)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	runutil.RunEvery(s.ctx, slotDuration, s.RefreshENR)
+	if s.dv5Listener != nil {
+		runutil.RunEvery(s.ctx, slotDuration, s.maintainSubnetPeers)
+	}
 
 	multiAddrs := s.host.Network().ListenAddresses()
 	logIP4Addr(s.host.ID(), multiAddrs...)
@@ -252,7 +281,8 @@ func (s *Service) Started() bool {
 	return s.started
 }
 
-// Encoding returns the configured networking encoding.
+// Encoding returns the configured networking encoding of gossip messages. Req/resp streams
+// use the encoding negotiated with the peer instead.
 func (s *Service) Encoding() encoder.NetworkEncoding {
 	encoding := s.cfg.Encoding
 	switch encoding {
@@ -304,6 +334,32 @@ func (s *Service) listenForNewNodes() {
 	})
 }
 
+// disconnectBadPeers disconnects from connected peers whose score has dropped to that of a bad peer.
+func (s *Service) disconnectBadPeers() {
+	for _, pid := range s.peers.Connected() {
+		if !s.peers.IsBad(pid) {
+			continue
+		}
+		log.WithField("peer", pid).WithField("score", s.peers.Score(pid)).Debug("Disconnecting bad peer")
+		if err := s.Disconnect(pid); err != nil {
+			log.WithError(err).Error("Unable to disconnect from peer")
+		}
+	}
+}
+
+// isBannedAddrInfo returns whether the peer, or any of its addresses, is banned.
+func (s *Service) isBannedAddrInfo(info peer.AddrInfo) bool {
+	if s.bans.isBanned(info.ID, nil) {
+		return true
+	}
+	for _, addr := range info.Addrs {
+		if s.bans.isBanned(info.ID, addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) connectWithAllPeers(multiAddrs []ma.Multiaddr) {
 	addrInfos, err := peer.AddrInfosFromP2pAddrs(multiAddrs...)
 	if err != nil {
@@ -320,6 +376,9 @@ func (s *Service) connectWithAllPeers(multiAddrs []ma.Multiaddr) {
 		if s.Peers().IsBad(info.ID) {
 			continue
 		}
+		if s.isBannedAddrInfo(info) {
+			continue
+		}
 		if err := s.host.Connect(s.ctx, info); err != nil {
 			log.Errorf("Could not connect with peer %s: %v", info.String(), err)
 			s.exclusionList.Set(info.ID.String(), true, 1)
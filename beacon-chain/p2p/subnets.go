@@ -0,0 +1,154 @@
+package p2p
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// attSubnetEnrKey is the ENR key of the bitfield of attestation subnets a node is
+// subscribed to for the long term.
+const attSubnetEnrKey = "attnets"
+
+// minimumPeersInSubnet is the number of peers of an attestation subnet we are subscribed
+// to, below which discovery searches for more peers on that subnet.
+const minimumPeersInSubnet = 4
+
+// AttestationSubnetTopic returns the gossip topic of the given attestation subnet.
+func AttestationSubnetTopic(subnet uint64) string {
+	return fmt.Sprintf(attestationSubnetTopicFormat, subnet)
+}
+
+// RefreshENR updates the attestation subnets advertised in the node's ENR and metadata to
+// the long lived subnets of the validators attached to the node. The metadata sequence
+// number is bumped whenever the subnets change.
+func (s *Service) RefreshENR() {
+	bitV := attSubnetBitfield(cache.SubnetIDs.GetAllSubnets())
+	s.metaDataLock.Lock()
+	if bytes.Equal(s.metaData.Attnets, bitV) {
+		s.metaDataLock.Unlock()
+		return
+	}
+	s.metaData = &pb.MetaData{
+		SeqNumber: s.metaData.SeqNumber + 1,
+		Attnets:   bitV,
+	}
+	s.metaDataLock.Unlock()
+
+	if s.dv5Listener != nil {
+		s.dv5Listener.LocalNode().Set(enr.WithEntry(attSubnetEnrKey, bitV))
+	}
+	log.WithField("attnets", fmt.Sprintf("%#x", bitV)).Debug("Updated advertised attestation subnets")
+}
+
+// Metadata returns the metadata of the node.
+func (s *Service) Metadata() *pb.MetaData {
+	s.metaDataLock.RLock()
+	defer s.metaDataLock.RUnlock()
+	return s.metaData
+}
+
+// MetadataSeq returns the sequence number of the metadata of the node.
+func (s *Service) MetadataSeq() uint64 {
+	return s.Metadata().SeqNumber
+}
+
+// FindPeersWithSubnet performs a network search for peers subscribed to the given
+// attestation subnet and connects to the ones found. It returns whether any such peer
+// was found.
+func (s *Service) FindPeersWithSubnet(index uint64) (bool, error) {
+	if s.dv5Listener == nil {
+		return false, nil
+	}
+	nodes := s.dv5Listener.LookupRandom()
+	var found []*enode.Node
+	for _, node := range nodes {
+		subnets, err := retrieveAttSubnets(node.Record())
+		if err != nil {
+			log.WithError(err).Debug("Could not retrieve attestation subnets of node")
+			continue
+		}
+		for _, subnet := range subnets {
+			if subnet == index {
+				found = append(found, node)
+				break
+			}
+		}
+	}
+	var multiAddrs []ma.Multiaddr
+	for _, node := range found {
+		multiAddr, err := convertToSingleMultiAddr(node)
+		if err != nil {
+			continue
+		}
+		info, err := peer.AddrInfoFromP2pAddr(multiAddr)
+		if err != nil || s.host.Network().Connectedness(info.ID) == network.Connected {
+			continue
+		}
+		multiAddrs = append(multiAddrs, multiAddr)
+	}
+	if len(multiAddrs) > 0 {
+		s.connectWithAllPeers(multiAddrs)
+	}
+	return len(found) > 0, nil
+}
+
+// maintainSubnetPeers searches for more peers on the attestation subnets we are
+// subscribed to which have too few peers.
+func (s *Service) maintainSubnetPeers() {
+	for _, topic := range s.pubsub.GetTopics() {
+		var subnet uint64
+		base := strings.TrimSuffix(topic, s.Encoding().ProtocolSuffix())
+		if _, err := fmt.Sscanf(base, attestationSubnetTopicFormat, &subnet); err != nil {
+			continue
+		}
+		if len(s.pubsub.ListPeers(topic)) >= minimumPeersInSubnet {
+			continue
+		}
+		if _, err := s.FindPeersWithSubnet(subnet); err != nil {
+			log.WithError(err).WithField("subnet", subnet).Error("Could not search for subnet peers")
+		}
+	}
+}
+
+// attSubnetBitfield returns the attestation subnets as an SSZ bitvector of
+// AttestationSubnetCount bits.
+func attSubnetBitfield(subnets []uint64) []byte {
+	bitV := make([]byte, (params.BeaconConfig().AttestationSubnetCount+7)/8)
+	for _, subnet := range subnets {
+		if subnet >= params.BeaconConfig().AttestationSubnetCount {
+			continue
+		}
+		bitV[subnet/8] |= 1 << (subnet % 8)
+	}
+	return bitV
+}
+
+// retrieveAttSubnets returns the attestation subnets advertised in an ENR record. A
+// record without the attnets entry advertises no subnets.
+func retrieveAttSubnets(record *enr.Record) ([]uint64, error) {
+	var bitV []byte
+	if err := record.Load(enr.WithEntry(attSubnetEnrKey, &bitV)); err != nil {
+		if enr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "could not load attnets entry")
+	}
+	var subnets []uint64
+	for i := uint64(0); i < params.BeaconConfig().AttestationSubnetCount && i/8 < uint64(len(bitV)); i++ {
+		if bitV[i/8]&(1<<(i%8)) != 0 {
+			subnets = append(subnets, i)
+		}
+	}
+	return subnets, nil
+}
@@ -0,0 +1,81 @@
+package p2p
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestAttSubnetBitfield_RoundTrip(t *testing.T) {
+	subnets := []uint64{0, 7, 8, 63}
+	bitV := attSubnetBitfield(subnets)
+	if len(bitV) != 8 {
+		t.Fatalf("Wanted bitfield of %d bytes, got %d", 8, len(bitV))
+	}
+	if bitV[0] != 0x81 || bitV[1] != 0x01 || bitV[7] != 0x80 {
+		t.Errorf("Unexpected bitfield %#x", bitV)
+	}
+
+	record := &enr.Record{}
+	record.Set(enr.WithEntry(attSubnetEnrKey, bitV))
+	retrieved, err := retrieveAttSubnets(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(retrieved, subnets) {
+		t.Errorf("Wanted subnets %v, got %v", subnets, retrieved)
+	}
+
+	retrieved, err = retrieveAttSubnets(&enr.Record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(retrieved) != 0 {
+		t.Errorf("Wanted no subnets for a record without attnets, got %v", retrieved)
+	}
+}
+
+func TestRefreshENR_AdvertisesPersistentSubnets(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	listener := createListener(ipAddr, pkey, &Config{UDPPort: 4000})
+	defer listener.Close()
+
+	subnets, err := retrieveAttSubnets(listener.Self().Record())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subnets) != 0 {
+		t.Errorf("Wanted no subnets in a new ENR, got %v", subnets)
+	}
+
+	cache.SubnetIDs.AddPersistentCommittee([]byte("refresh-enr"), []uint64{5, 12}, time.Minute)
+	s := &Service{
+		dv5Listener: listener,
+		metaData:    &pb.MetaData{Attnets: attSubnetBitfield(nil)},
+	}
+	s.RefreshENR()
+
+	subnets, err = retrieveAttSubnets(listener.Self().Record())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(subnets, []uint64{5, 12}) {
+		t.Errorf("Wanted subnets %v, got %v", []uint64{5, 12}, subnets)
+	}
+	if s.MetadataSeq() != 1 {
+		t.Errorf("Wanted metadata sequence number %d, got %d", 1, s.MetadataSeq())
+	}
+
+	// The metadata is unchanged if the subnets are unchanged.
+	s.RefreshENR()
+	if s.MetadataSeq() != 1 {
+		t.Errorf("Wanted metadata sequence number %d, got %d", 1, s.MetadataSeq())
+	}
+	if !reflect.DeepEqual(s.Metadata().Attnets, attSubnetBitfield([]uint64{5, 12})) {
+		t.Errorf("Unexpected metadata attnets %#x", s.Metadata().Attnets)
+	}
+}
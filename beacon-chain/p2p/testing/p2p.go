@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -19,6 +20,7 @@ import (
 	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	peers "github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/sirupsen/logrus"
 )
@@ -29,8 +31,13 @@ var TopicMappings = map[reflect.Type]string{
 	reflect.TypeOf(new(uint64)):                      "/eth2/beacon_chain/req/goodbye/1",
 	reflect.TypeOf(&pb.BeaconBlocksByRangeRequest{}): "/eth2/beacon_chain/req/beacon_blocks_by_range/1",
 	reflect.TypeOf([][32]byte{}):                     "/eth2/beacon_chain/req/beacon_blocks_by_root/1",
+	reflect.TypeOf(&pb.Ping{}):                       "/eth2/beacon_chain/req/ping/1",
+	reflect.TypeOf(new(interface{})):                 metaDataTopic,
 }
 
+// metaDataTopic is the protocol id of metadata requests, which have no request body.
+const metaDataTopic = "/eth2/beacon_chain/req/metadata/1"
+
 // TestP2P represents a p2p implementation that can be used for testing.
 type TestP2P struct {
 	t               *testing.T
@@ -39,6 +46,7 @@ type TestP2P struct {
 	BroadcastCalled bool
 	DelaySend       bool
 	peers           *peers.Status
+	LocalMetadata   *pb.MetaData
 }
 
 // NewTestP2P initializes a new p2p test service.
@@ -153,6 +161,14 @@ func (p *TestP2P) PeerID() peer.ID {
 	return p.Host.ID()
 }
 
+// RefreshENR mocks the p2p func.
+func (p *TestP2P) RefreshENR() {}
+
+// FindPeersWithSubnet mocks the p2p func.
+func (p *TestP2P) FindPeersWithSubnet(index uint64) (bool, error) {
+	return false, nil
+}
+
 // AddConnectionHandler handles the connection with a newly connected peer.
 func (p *TestP2P) AddConnectionHandler(f func(ctx context.Context, id peer.ID) error) {
 	p.Host.Network().Notify(&network.NotifyBundle{
@@ -202,8 +218,10 @@ func (p *TestP2P) Send(ctx context.Context, msg interface{}, pid peer.ID) (netwo
 		return nil, err
 	}
 
-	if _, err := p.Encoding().EncodeWithLength(stream, msg); err != nil {
-		return nil, err
+	if protocol != metaDataTopic {
+		if _, err := p.Encoding().EncodeWithLength(stream, msg); err != nil {
+			return nil, err
+		}
 	}
 
 	// Close stream for writing.
@@ -218,6 +236,39 @@ func (p *TestP2P) Send(ctx context.Context, msg interface{}, pid peer.ID) (netwo
 	return stream, nil
 }
 
+// Metadata mocks the peer's metadata.
+func (p *TestP2P) Metadata() *pb.MetaData {
+	if p.LocalMetadata == nil {
+		return &pb.MetaData{}
+	}
+	return p.LocalMetadata
+}
+
+// MetadataSeq mocks the sequence number of the peer's metadata.
+func (p *TestP2P) MetadataSeq() uint64 {
+	return p.Metadata().SeqNumber
+}
+
+// BanPeer mocks the p2p func.
+func (p *TestP2P) BanPeer(pid peer.ID, duration time.Duration, reason string) error {
+	return nil
+}
+
+// BanIP mocks the p2p func.
+func (p *TestP2P) BanIP(ip net.IP, duration time.Duration, reason string) error {
+	return nil
+}
+
+// Unban mocks the p2p func.
+func (p *TestP2P) Unban(target string) error {
+	return nil
+}
+
+// Bans mocks the p2p func.
+func (p *TestP2P) Bans() []*dbpb.PeerBan {
+	return nil
+}
+
 // Started always returns true.
 func (p *TestP2P) Started() bool {
 	return true
@@ -2,15 +2,22 @@ package aggregator
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -101,3 +108,54 @@ func (as *Server) SubmitAggregateAndProof(ctx context.Context, req *pb.Aggregati
 
 	return &pb.AggregationResponse{}, nil
 }
+
+// SubscribeCommitteeSubnets is called by a validator client with the attestation duties of
+// its validators. The beacon node joins the subnets of the aggregation duties ahead of
+// their slots, and assigns long lived random subnets to validators which do not have
+// any yet.
+func (as *Server) SubscribeCommitteeSubnets(ctx context.Context, req *pb.CommitteeSubnetsSubscribeRequest) (*ptypes.Empty, error) {
+	ctx, span := trace.StartSpan(ctx, "AggregatorServer.SubscribeCommitteeSubnets")
+	defer span.End()
+
+	subnetCount := params.BeaconConfig().AttestationSubnetCount
+	for _, sub := range req.Subscriptions {
+		if len(sub.PublicKey) != params.BeaconConfig().BLSPubkeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(sub.PublicKey))
+		}
+		if sub.IsAggregator {
+			cache.SubnetIDs.AddAggregatorSubnetID(sub.Slot, sub.CommitteeIndex%subnetCount)
+		}
+		if _, ok := cache.SubnetIDs.GetPersistentSubnets(sub.PublicKey); !ok {
+			subnets := randomSubnets(params.BeaconConfig().RandomSubnetsPerValidator)
+			cache.SubnetIDs.AddPersistentCommittee(sub.PublicKey, subnets, persistentSubnetDuration())
+			log.WithFields(logrus.Fields{
+				"pubKey":  fmt.Sprintf("%#x", bytesutil.Trunc(sub.PublicKey)),
+				"subnets": subnets,
+			}).Debug("Assigned long lived attestation subnets to validator")
+		}
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// randomSubnets picks the given number of distinct attestation subnets at random.
+func randomSubnets(count uint64) []uint64 {
+	subnetCount := params.BeaconConfig().AttestationSubnetCount
+	if count > subnetCount {
+		count = subnetCount
+	}
+	subnets := make([]uint64, count)
+	for i, subnet := range rand.Perm(int(subnetCount))[:count] {
+		subnets[i] = uint64(subnet)
+	}
+	return subnets
+}
+
+// persistentSubnetDuration returns how long a validator stays on its long lived random
+// subnets, which is picked at random between one and two subscription periods so that
+// the subnets of the network do not all rotate at once.
+func persistentSubnetDuration() time.Duration {
+	epochs := params.BeaconConfig().EpochsPerRandomSubnetSubscription
+	epochs += uint64(rand.Int63n(int64(epochs) + 1))
+	secondsPerEpoch := params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot
+	return time.Duration(epochs*secondsPerEpoch) * time.Second
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
@@ -239,3 +240,49 @@ func generateAtt(state *beaconstate.BeaconState, index uint64, privKeys []*bls.S
 
 	return att
 }
+
+func TestSubscribeCommitteeSubnets_CachesSubnets(t *testing.T) {
+	aggregatorServer := &Server{}
+	req := &pb.CommitteeSubnetsSubscribeRequest{
+		Subscriptions: []*pb.CommitteeSubnetSubscription{
+			{PublicKey: pubKey(100), Slot: 1000, CommitteeIndex: 70, IsAggregator: true},
+			{PublicKey: pubKey(101), Slot: 1000, CommitteeIndex: 3},
+		},
+	}
+	if _, err := aggregatorServer.SubscribeCommitteeSubnets(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+
+	subnetCount := params.BeaconConfig().AttestationSubnetCount
+	if ids := cache.SubnetIDs.GetAggregatorSubnetIDs(1000); !reflect.DeepEqual(ids, []uint64{70 % subnetCount}) {
+		t.Errorf("Wanted aggregator subnets %v, got %v", []uint64{70 % subnetCount}, ids)
+	}
+	for _, i := range []uint64{100, 101} {
+		subnets, ok := cache.SubnetIDs.GetPersistentSubnets(pubKey(i))
+		if !ok {
+			t.Fatalf("Expected long lived subnets for validator %d", i)
+		}
+		if uint64(len(subnets)) != params.BeaconConfig().RandomSubnetsPerValidator {
+			t.Errorf("Wanted %d long lived subnets, got %d", params.BeaconConfig().RandomSubnetsPerValidator, len(subnets))
+		}
+	}
+
+	// The long lived subnets of a validator are kept on later subscriptions.
+	subnets, _ := cache.SubnetIDs.GetPersistentSubnets(pubKey(100))
+	if _, err := aggregatorServer.SubscribeCommitteeSubnets(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if kept, _ := cache.SubnetIDs.GetPersistentSubnets(pubKey(100)); !reflect.DeepEqual(kept, subnets) {
+		t.Errorf("Wanted long lived subnets %v to be kept, got %v", subnets, kept)
+	}
+}
+
+func TestSubscribeCommitteeSubnets_InvalidPublicKey(t *testing.T) {
+	aggregatorServer := &Server{}
+	req := &pb.CommitteeSubnetsSubscribeRequest{
+		Subscriptions: []*pb.CommitteeSubnetSubscription{{PublicKey: []byte{'a'}}},
+	}
+	if _, err := aggregatorServer.SubscribeCommitteeSubnets(context.Background(), req); err == nil || !strings.Contains(err.Error(), "Invalid public key length") {
+		t.Errorf("Wanted invalid public key error, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package checkpoint
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC checkpoint service, serving the
+// finalized state and block to nodes starting from a trusted checkpoint.
+type Server struct {
+	BeaconDB            db.ReadOnlyDatabase
+	FinalizationFetcher blockchain.FinalizationFetcher
+}
+
+// FinalizedCheckpoint returns the SSZ encoded state and block of the latest finalized checkpoint.
+func (cs *Server) FinalizedCheckpoint(ctx context.Context, _ *ptypes.Empty) (*pb.FinalizedCheckpointResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "CheckpointServer.FinalizedCheckpoint")
+	defer span.End()
+
+	checkpoint := cs.FinalizationFetcher.FinalizedCheckpt()
+	if checkpoint.Epoch == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "Chain has not finalized beyond genesis")
+	}
+	root := bytesutil.ToBytes32(checkpoint.Root)
+	blk, err := cs.BeaconDB.Block(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized block: %v", err)
+	}
+	if blk == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find finalized block %#x", root)
+	}
+	st, err := cs.BeaconDB.State(ctx, root)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get finalized state: %v", err)
+	}
+	if st == nil {
+		return nil, status.Errorf(codes.NotFound, "Could not find finalized state %#x", root)
+	}
+
+	encodedState, err := ssz.Marshal(st.InnerStateUnsafe())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode finalized state: %v", err)
+	}
+	encodedBlock, err := ssz.Marshal(blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode finalized block: %v", err)
+	}
+	return &pb.FinalizedCheckpointResponse{
+		Epoch: checkpoint.Epoch,
+		State: encodedState,
+		Block: encodedBlock,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package checkpoint
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestFinalizedCheckpoint_OK(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+	ctx := context.Background()
+
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	if err := st.SetSlot(64); err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 64}}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, st, root); err != nil {
+		t.Fatal(err)
+	}
+
+	server := &Server{
+		BeaconDB:            db,
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1, Root: root[:]}},
+	}
+	resp, err := server.FinalizedCheckpoint(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Epoch != 1 {
+		t.Errorf("Wanted epoch 1, received %d", resp.Epoch)
+	}
+	receivedState := &pbp2p.BeaconState{}
+	if err := ssz.Unmarshal(resp.State, receivedState); err != nil {
+		t.Fatal(err)
+	}
+	if receivedState.Slot != 64 {
+		t.Errorf("Wanted state at slot 64, received %d", receivedState.Slot)
+	}
+	receivedBlock := &ethpb.SignedBeaconBlock{}
+	if err := ssz.Unmarshal(resp.Block, receivedBlock); err != nil {
+		t.Fatal(err)
+	}
+	receivedRoot, err := ssz.HashTreeRoot(receivedBlock.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receivedRoot != root {
+		t.Errorf("Wanted block root %#x, received %#x", root, receivedRoot)
+	}
+}
+
+func TestFinalizedCheckpoint_NotFinalized(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	defer dbutil.TeardownDB(t, db)
+
+	server := &Server{
+		BeaconDB:            db,
+		FinalizationFetcher: &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, 32)}},
+	}
+	wanted := "Chain has not finalized beyond genesis"
+	if _, err := server.FinalizedCheckpoint(context.Background(), &ptypes.Empty{}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %q, received %v", wanted, err)
+	}
+}
@@ -0,0 +1,174 @@
+// Package events defines a gRPC events service which streams notable events of the
+// beacon node, such as new blocks, head changes and finalized checkpoints, to clients.
+package events
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Events service,
+// providing RPC endpoints for subscribing to events of the beacon node.
+type Server struct {
+	Ctx                 context.Context
+	StateNotifier       statefeed.Notifier
+	OperationNotifier   opfeed.Notifier
+	ReorgHistoryFetcher blockchain.ReorgHistoryFetcher
+}
+
+// ChainReorgHistory returns the most recent chain reorgs of the beacon node, oldest first.
+func (s *Server) ChainReorgHistory(ctx context.Context, _ *ptypes.Empty) (*pb.ChainReorgHistoryResponse, error) {
+	history := s.ReorgHistoryFetcher.ReorgHistory()
+	reorgs := make([]*pb.ChainReorgEvent, len(history))
+	for i, reorg := range history {
+		reorgs[i] = chainReorgEvent(reorg)
+	}
+	return &pb.ChainReorgHistoryResponse{Reorgs: reorgs}, nil
+}
+
+// StreamEvents streams the events of the requested topics as they happen. Events of
+// every topic are streamed if no topic is requested.
+func (s *Server) StreamEvents(req *pb.StreamEventsRequest, stream pb.Events_StreamEventsServer) error {
+	topics := make(map[pb.EventTopic]bool, len(req.Topics))
+	for _, topic := range req.Topics {
+		topics[topic] = true
+	}
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+
+	for {
+		var res *pb.Event
+		var topic pb.EventTopic
+		select {
+		case event := <-stateChannel:
+			res, topic = stateEvent(event)
+		case event := <-opChannel:
+			res, topic = operationEvent(event)
+		case <-stateSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-opSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+		if res == nil || (len(topics) > 0 && !topics[topic]) {
+			continue
+		}
+		if err := stream.Send(res); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+}
+
+// stateEvent converts a state feed event into an event of the stream. It returns nil
+// if the event is not streamed.
+func stateEvent(event *feed.Event) (*pb.Event, pb.EventTopic) {
+	switch event.Type {
+	case statefeed.BlockProcessed:
+		data, ok := event.Data.(*statefeed.BlockProcessedData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_Block{Block: &pb.BlockEvent{
+			Slot:      data.Slot,
+			BlockRoot: data.BlockRoot[:],
+			Verified:  data.Verified,
+		}}}, pb.EventTopic_BLOCK
+	case statefeed.NewHead:
+		data, ok := event.Data.(*statefeed.NewHeadData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_Head{Head: &pb.HeadEvent{
+			Slot:      data.Slot,
+			BlockRoot: data.BlockRoot[:],
+			StateRoot: data.StateRoot[:],
+		}}}, pb.EventTopic_HEAD
+	case statefeed.Reorg:
+		data, ok := event.Data.(*statefeed.ReorgData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_ChainReorg{ChainReorg: chainReorgEvent(data)}}, pb.EventTopic_CHAIN_REORG
+	case statefeed.JustifiedCheckpoint:
+		data, ok := event.Data.(*statefeed.CheckpointData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_JustifiedCheckpoint{JustifiedCheckpoint: &pb.CheckpointEvent{
+			Epoch: data.Epoch,
+			Root:  data.Root[:],
+		}}}, pb.EventTopic_JUSTIFIED_CHECKPOINT
+	case statefeed.FinalizedCheckpoint:
+		data, ok := event.Data.(*statefeed.CheckpointData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_FinalizedCheckpoint{FinalizedCheckpoint: &pb.CheckpointEvent{
+			Epoch: data.Epoch,
+			Root:  data.Root[:],
+		}}}, pb.EventTopic_FINALIZED_CHECKPOINT
+	default:
+		return nil, 0
+	}
+}
+
+// operationEvent converts an operation feed event into an event of the stream. It
+// returns nil if the event is not streamed.
+func operationEvent(event *feed.Event) (*pb.Event, pb.EventTopic) {
+	switch event.Type {
+	case opfeed.ExitReceived:
+		data, ok := event.Data.(*opfeed.ExitReceivedData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_VoluntaryExit{VoluntaryExit: data.Exit}}, pb.EventTopic_VOLUNTARY_EXIT
+	case opfeed.ProposerSlashingReceived:
+		data, ok := event.Data.(*opfeed.ProposerSlashingReceivedData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_Slashing{Slashing: &pb.SlashingEvent{
+			Slashing: &pb.SlashingEvent_ProposerSlashing{ProposerSlashing: data.ProposerSlashing},
+		}}}, pb.EventTopic_SLASHING
+	case opfeed.AttesterSlashingReceived:
+		data, ok := event.Data.(*opfeed.AttesterSlashingReceivedData)
+		if !ok {
+			return nil, 0
+		}
+		return &pb.Event{Event: &pb.Event_Slashing{Slashing: &pb.SlashingEvent{
+			Slashing: &pb.SlashingEvent_AttesterSlashing{AttesterSlashing: data.AttesterSlashing},
+		}}}, pb.EventTopic_SLASHING
+	default:
+		return nil, 0
+	}
+}
+
+func chainReorgEvent(reorg *statefeed.ReorgData) *pb.ChainReorgEvent {
+	dropped := make([][]byte, len(reorg.DroppedRoots))
+	for i := range reorg.DroppedRoots {
+		dropped[i] = reorg.DroppedRoots[i][:]
+	}
+	return &pb.ChainReorgEvent{
+		Slot:              reorg.Slot,
+		Depth:             reorg.Depth,
+		OldHeadRoot:       reorg.OldHeadRoot[:],
+		NewHeadRoot:       reorg.NewHeadRoot[:],
+		DroppedBlockRoots: dropped,
+	}
+}
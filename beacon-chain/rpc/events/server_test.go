@@ -0,0 +1,164 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc"
+)
+
+type mockEventsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.Event
+}
+
+func (m *mockEventsStream) Send(event *pb.Event) error {
+	m.sent <- event
+	return nil
+}
+
+func (m *mockEventsStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestServer_StreamEvents_FiltersTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &mock.ChainService{}
+	server := &Server{
+		Ctx:               context.Background(),
+		StateNotifier:     chainService.StateNotifier(),
+		OperationNotifier: chainService.OperationNotifier(),
+	}
+	stream := &mockEventsStream{ctx: ctx, sent: make(chan *pb.Event, 1)}
+	req := &pb.StreamEventsRequest{Topics: []pb.EventTopic{pb.EventTopic_HEAD, pb.EventTopic_VOLUNTARY_EXIT}}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.StreamEvents(req, stream)
+	}()
+
+	// Block events are not requested, so they are not streamed.
+	for sent := 0; sent == 0; {
+		sent = server.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BlockProcessed,
+			Data: &statefeed.BlockProcessedData{Slot: 1, BlockRoot: [32]byte{'a'}},
+		})
+	}
+	server.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 2, BlockRoot: [32]byte{'b'}},
+	})
+	select {
+	case event := <-stream.sent:
+		head := event.GetHead()
+		if head == nil {
+			t.Fatalf("Wanted head event, got %v", event)
+		}
+		if head.Slot != 2 {
+			t.Errorf("Wanted head slot %d, got %d", 2, head.Slot)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive head event")
+	}
+
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 5}}
+	for sent := 0; sent == 0; {
+		sent = server.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.ExitReceived,
+			Data: &opfeed.ExitReceivedData{Exit: exit},
+		})
+	}
+	select {
+	case event := <-stream.sent:
+		if event.GetVoluntaryExit().Exit.ValidatorIndex != 5 {
+			t.Errorf("Wanted voluntary exit event, got %v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Did not receive voluntary exit event")
+	}
+
+	cancel()
+	if err := <-errs; err == nil || !strings.Contains(err.Error(), "Context canceled") {
+		t.Errorf("Expected context canceled error, got %v", err)
+	}
+}
+
+func TestStateEvent_Reorg(t *testing.T) {
+	event, topic := stateEvent(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			Slot:        10,
+			Depth:       2,
+			OldHeadRoot: [32]byte{'a'},
+			NewHeadRoot: [32]byte{'b'},
+		},
+	})
+	if topic != pb.EventTopic_CHAIN_REORG {
+		t.Errorf("Wanted topic %v, got %v", pb.EventTopic_CHAIN_REORG, topic)
+	}
+	reorg := event.GetChainReorg()
+	if reorg == nil || reorg.Depth != 2 || reorg.OldHeadRoot[0] != 'a' || reorg.NewHeadRoot[0] != 'b' {
+		t.Errorf("Unexpected chain reorg event %v", event)
+	}
+}
+
+func TestOperationEvent_Slashings(t *testing.T) {
+	event, topic := operationEvent(&feed.Event{
+		Type: opfeed.ProposerSlashingReceived,
+		Data: &opfeed.ProposerSlashingReceivedData{
+			ProposerSlashing: &ethpb.ProposerSlashing{ProposerIndex: 3},
+		},
+	})
+	if topic != pb.EventTopic_SLASHING {
+		t.Errorf("Wanted topic %v, got %v", pb.EventTopic_SLASHING, topic)
+	}
+	if event.GetSlashing().GetProposerSlashing().ProposerIndex != 3 {
+		t.Errorf("Unexpected slashing event %v", event)
+	}
+
+	event, _ = operationEvent(&feed.Event{
+		Type: opfeed.AttesterSlashingReceived,
+		Data: &opfeed.AttesterSlashingReceivedData{
+			AttesterSlashing: &ethpb.AttesterSlashing{},
+		},
+	})
+	if event.GetSlashing().GetAttesterSlashing() == nil {
+		t.Errorf("Unexpected slashing event %v", event)
+	}
+
+	if event, _ := operationEvent(&feed.Event{Type: opfeed.UnaggregatedAttReceived}); event != nil {
+		t.Errorf("Wanted no event, got %v", event)
+	}
+}
+
+func TestServer_ChainReorgHistory(t *testing.T) {
+	server := &Server{
+		ReorgHistoryFetcher: &mock.ChainService{Reorgs: []*statefeed.ReorgData{
+			{Slot: 5, Depth: 1, DroppedRoots: [][32]byte{{'a'}}},
+			{Slot: 9, Depth: 2, DroppedRoots: [][32]byte{{'b'}, {'c'}}},
+		}},
+	}
+	res, err := server.ChainReorgHistory(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Reorgs) != 2 {
+		t.Fatalf("Wanted %d reorgs, got %d", 2, len(res.Reorgs))
+	}
+	if res.Reorgs[1].Slot != 9 || res.Reorgs[1].Depth != 2 {
+		t.Errorf("Unexpected reorg %v", res.Reorgs[1])
+	}
+	if len(res.Reorgs[1].DroppedBlockRoots) != 2 || res.Reorgs[1].DroppedBlockRoots[1][0] != 'c' {
+		t.Errorf("Unexpected dropped roots %#x", res.Reorgs[1].DroppedBlockRoots)
+	}
+}
@@ -0,0 +1,154 @@
+package peers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBanDuration is the longest ban which can be requested, well below the durations
+// which overflow when converted to nanoseconds.
+const maxBanDuration = 100 * 365 * 24 * time.Hour
+
+// errBanManagementDisabled is returned by the ban management methods of a server which
+// is not the admin one.
+var errBanManagementDisabled = status.Error(codes.Unimplemented, "Peer bans are only managed through the admin RPC endpoint")
+
+// Server defines a server implementation of the gRPC peers service, providing the
+// protocol level view the beacon node has of its peers. The peer bans are only managed
+// by servers with a ban manager, which are only served on the admin RPC endpoint.
+type Server struct {
+	PeersFetcher p2p.PeersProvider
+	BanManager   p2p.BanManager
+}
+
+// ListPeers lists the peers connected to this node along with their scores.
+func (ps *Server) ListPeers(ctx context.Context, _ *ptypes.Empty) (*pb.ListPeersResponse, error) {
+	res := make([]*pb.PeerInfo, 0)
+	for _, pid := range ps.PeersFetcher.Peers().Connected() {
+		multiaddr, err := ps.PeersFetcher.Peers().Address(pid)
+		if err != nil {
+			continue
+		}
+		direction, err := ps.PeersFetcher.Peers().Direction(pid)
+		if err != nil {
+			continue
+		}
+		score, err := ps.PeersFetcher.Peers().ScoreBreakdown(pid)
+		if err != nil {
+			continue
+		}
+
+		pbDirection := ethpb.PeerDirection_UNKNOWN
+		switch direction {
+		case network.DirInbound:
+			pbDirection = ethpb.PeerDirection_INBOUND
+		case network.DirOutbound:
+			pbDirection = ethpb.PeerDirection_OUTBOUND
+		}
+		res = append(res, &pb.PeerInfo{
+			Address:   fmt.Sprintf("%s/p2p/%s", multiaddr.String(), pid.Pretty()),
+			Direction: pbDirection,
+			Score: &pb.PeerScore{
+				Total:           score.Total(),
+				BadResponses:    score.BadResponses,
+				ResponseLatency: score.ResponseLatency,
+				InvalidGossip:   score.InvalidGossip,
+				IgnoredGossip:   score.IgnoredGossip,
+				FirstSeenGossip: score.FirstSeenGossip,
+				StaleStatus:     score.StaleStatus,
+			},
+		})
+	}
+
+	return &pb.ListPeersResponse{
+		Peers: res,
+	}, nil
+}
+
+// BanPeer bans a peer ID or an IP address from connecting to the node, disconnecting from
+// the peers it matches.
+func (ps *Server) BanPeer(ctx context.Context, req *pb.BanPeerRequest) (*ptypes.Empty, error) {
+	if ps.BanManager == nil {
+		return nil, errBanManagementDisabled
+	}
+	if (req.PeerId == "") == (req.Ip == "") {
+		return nil, status.Error(codes.InvalidArgument, "Exactly one of peer ID or IP address must be provided")
+	}
+	if req.DurationSeconds > uint64(maxBanDuration/time.Second) {
+		return nil, status.Errorf(codes.InvalidArgument, "Ban duration must be at most %d seconds", uint64(maxBanDuration/time.Second))
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if req.PeerId != "" {
+		pid, err := peer.IDB58Decode(req.PeerId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid peer ID: %v", err)
+		}
+		if err := ps.BanManager.BanPeer(pid, duration, req.Reason); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not ban peer: %v", err)
+		}
+		return &ptypes.Empty{}, nil
+	}
+	ip := net.ParseIP(req.Ip)
+	if ip == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid IP address %q", req.Ip)
+	}
+	if err := ps.BanManager.BanIP(ip, duration, req.Reason); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ban IP address: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// UnbanPeer lifts the ban of a peer ID or an IP address.
+func (ps *Server) UnbanPeer(ctx context.Context, req *pb.UnbanPeerRequest) (*ptypes.Empty, error) {
+	if ps.BanManager == nil {
+		return nil, errBanManagementDisabled
+	}
+	if (req.PeerId == "") == (req.Ip == "") {
+		return nil, status.Error(codes.InvalidArgument, "Exactly one of peer ID or IP address must be provided")
+	}
+	target := req.PeerId
+	if req.Ip != "" {
+		if net.ParseIP(req.Ip) == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid IP address %q", req.Ip)
+		}
+		target = req.Ip
+	}
+	if err := ps.BanManager.Unban(target); err != nil {
+		if err == p2p.ErrNotBanned {
+			return nil, status.Errorf(codes.NotFound, "%s is not banned", target)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not lift ban: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// ListBannedPeers lists the active bans of peer IDs and IP addresses.
+func (ps *Server) ListBannedPeers(ctx context.Context, _ *ptypes.Empty) (*pb.ListBannedPeersResponse, error) {
+	if ps.BanManager == nil {
+		return nil, errBanManagementDisabled
+	}
+	bans := ps.BanManager.Bans()
+	res := make([]*pb.BannedPeer, len(bans))
+	for i, ban := range bans {
+		res[i] = &pb.BannedPeer{
+			PeerId: ban.PeerId,
+			Ip:     ban.Ip,
+			Expiry: ban.Expiry,
+			Reason: ban.Reason,
+		}
+	}
+	return &pb.ListBannedPeersResponse{
+		Bans: res,
+	}, nil
+}
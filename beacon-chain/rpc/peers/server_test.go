@@ -0,0 +1,160 @@
+package peers
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockBanManager keeps bans in memory, keyed by the banned peer ID or IP address.
+type mockBanManager struct {
+	bans map[string]*dbpb.PeerBan
+}
+
+func (m *mockBanManager) BanPeer(pid peer.ID, duration time.Duration, reason string) error {
+	m.bans[pid.String()] = &dbpb.PeerBan{PeerId: pid.String(), Reason: reason}
+	return nil
+}
+
+func (m *mockBanManager) BanIP(ip net.IP, duration time.Duration, reason string) error {
+	m.bans[ip.String()] = &dbpb.PeerBan{Ip: ip.String(), Reason: reason}
+	return nil
+}
+
+func (m *mockBanManager) Unban(target string) error {
+	if _, ok := m.bans[target]; !ok {
+		return p2p.ErrNotBanned
+	}
+	delete(m.bans, target)
+	return nil
+}
+
+func (m *mockBanManager) Bans() []*dbpb.PeerBan {
+	bans := make([]*dbpb.PeerBan, 0, len(m.bans))
+	for _, ban := range m.bans {
+		bans = append(bans, ban)
+	}
+	return bans
+}
+
+func TestServer_ListPeers_IncludesScores(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ps := &Server{
+		PeersFetcher: peersProvider,
+	}
+	outbound := peersProvider.Peers().Connected()[0]
+	for _, pid := range peersProvider.Peers().Connected() {
+		if direction, _ := peersProvider.Peers().Direction(pid); direction == network.DirOutbound {
+			outbound = pid
+		}
+	}
+	peersProvider.Peers().IncrementInvalidGossip(outbound)
+
+	res, err := ps.ListPeers(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Peers) != 2 {
+		t.Fatalf("Expected 2 peers, received %d: %v", len(res.Peers), res.Peers)
+	}
+	for _, p := range res.Peers {
+		if !strings.HasSuffix(p.Address, "/p2p/"+outbound.Pretty()) {
+			if p.Score.Total != 0 {
+				t.Errorf("Expected score 0 for peer %s, received %v", p.Address, p.Score.Total)
+			}
+			continue
+		}
+		if p.Direction != ethpb.PeerDirection_OUTBOUND {
+			t.Errorf("Expected outbound (%d) connection, received %d", ethpb.PeerDirection_OUTBOUND, p.Direction)
+		}
+		if p.Score.InvalidGossip >= 0 || p.Score.Total != p.Score.InvalidGossip {
+			t.Errorf("Expected invalid gossip penalty in score, received %v", p.Score)
+		}
+	}
+}
+
+func TestServer_BanPeer_ValidatesRequest(t *testing.T) {
+	ps := &Server{
+		BanManager: &mockBanManager{bans: make(map[string]*dbpb.PeerBan)},
+	}
+	requests := []*pb.BanPeerRequest{
+		{},
+		{PeerId: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR", Ip: "10.0.0.1"},
+		{PeerId: "not a peer id"},
+		{Ip: "10.0.0"},
+		// Durations overflowing when converted to nanoseconds must not become permanent bans.
+		{Ip: "10.0.0.1", DurationSeconds: 1 << 62},
+		{Ip: "10.0.0.1", DurationSeconds: uint64(maxBanDuration/time.Second) + 1},
+	}
+	for _, req := range requests {
+		_, err := ps.BanPeer(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument error for request %v, received %v", req, err)
+		}
+	}
+}
+
+func TestServer_BanUnbanAndListPeers(t *testing.T) {
+	ps := &Server{
+		BanManager: &mockBanManager{bans: make(map[string]*dbpb.PeerBan)},
+	}
+	ctx := context.Background()
+	pid := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+	if _, err := ps.BanPeer(ctx, &pb.BanPeerRequest{PeerId: pid, Reason: "spam"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ps.BanPeer(ctx, &pb.BanPeerRequest{Ip: "10.0.0.1", DurationSeconds: 60}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := ps.ListBannedPeers(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Bans) != 2 {
+		t.Fatalf("Expected 2 bans, received %d: %v", len(res.Bans), res.Bans)
+	}
+
+	if _, err := ps.UnbanPeer(ctx, &pb.UnbanPeerRequest{Ip: "10.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ps.UnbanPeer(ctx, &pb.UnbanPeerRequest{Ip: "10.0.0.1"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found error, received %v", err)
+	}
+	res, err = ps.ListBannedPeers(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Bans) != 1 || res.Bans[0].PeerId != pid || res.Bans[0].Reason != "spam" {
+		t.Errorf("Unexpected bans %v", res.Bans)
+	}
+}
+
+func TestServer_BanManagementDisabledWithoutBanManager(t *testing.T) {
+	ps := &Server{
+		PeersFetcher: &mockP2p.MockPeersProvider{},
+	}
+	ctx := context.Background()
+	if _, err := ps.BanPeer(ctx, &pb.BanPeerRequest{Ip: "10.0.0.1"}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected unimplemented error banning a peer, received %v", err)
+	}
+	if _, err := ps.UnbanPeer(ctx, &pb.UnbanPeerRequest{Ip: "10.0.0.1"}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected unimplemented error lifting a ban, received %v", err)
+	}
+	if _, err := ps.ListBannedPeers(ctx, &ptypes.Empty{}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("Expected unimplemented error listing bans, received %v", err)
+	}
+}
@@ -25,7 +25,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/aggregator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/checkpoint"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/events"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -58,6 +61,7 @@ type Service struct {
 	forkFetcher            blockchain.ForkFetcher
 	finalizationFetcher    blockchain.FinalizationFetcher
 	participationFetcher   blockchain.ParticipationFetcher
+	reorgHistoryFetcher    blockchain.ReorgHistoryFetcher
 	genesisTimeFetcher     blockchain.TimeFetcher
 	attestationReceiver    blockchain.AttestationReceiver
 	blockReceiver          blockchain.BlockReceiver
@@ -70,6 +74,9 @@ type Service struct {
 	host                   string
 	port                   string
 	listener               net.Listener
+	adminPort              int
+	adminListener          net.Listener
+	adminServer            *grpc.Server
 	withCert               string
 	withKey                string
 	grpcServer             *grpc.Server
@@ -78,6 +85,7 @@ type Service struct {
 	credentialError        error
 	p2p                    p2p.Broadcaster
 	peersFetcher           p2p.PeersProvider
+	banManager             p2p.BanManager
 	depositFetcher         depositcache.DepositFetcher
 	pendingDepositFetcher  depositcache.PendingDepositsFetcher
 	stateNotifier          statefeed.Notifier
@@ -93,6 +101,7 @@ type Service struct {
 type Config struct {
 	Host                  string
 	Port                  string
+	AdminPort             int
 	CertFlag              string
 	KeyFlag               string
 	BeaconDB              db.HeadAccessDatabase
@@ -100,6 +109,7 @@ type Config struct {
 	ForkFetcher           blockchain.ForkFetcher
 	FinalizationFetcher   blockchain.FinalizationFetcher
 	ParticipationFetcher  blockchain.ParticipationFetcher
+	ReorgHistoryFetcher   blockchain.ReorgHistoryFetcher
 	AttestationReceiver   blockchain.AttestationReceiver
 	BlockReceiver         blockchain.BlockReceiver
 	POWChainService       powchain.Chain
@@ -111,6 +121,7 @@ type Config struct {
 	SyncService           sync.Checker
 	Broadcaster           p2p.Broadcaster
 	PeersFetcher          p2p.PeersProvider
+	BanManager            p2p.BanManager
 	DepositFetcher        depositcache.DepositFetcher
 	PendingDepositFetcher depositcache.PendingDepositsFetcher
 	SlasherProvider       string
@@ -131,11 +142,13 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		forkFetcher:           cfg.ForkFetcher,
 		finalizationFetcher:   cfg.FinalizationFetcher,
 		participationFetcher:  cfg.ParticipationFetcher,
+		reorgHistoryFetcher:   cfg.ReorgHistoryFetcher,
 		genesisTimeFetcher:    cfg.GenesisTimeFetcher,
 		attestationReceiver:   cfg.AttestationReceiver,
 		blockReceiver:         cfg.BlockReceiver,
 		p2p:                   cfg.Broadcaster,
 		peersFetcher:          cfg.PeersFetcher,
+		banManager:            cfg.BanManager,
 		powChainService:       cfg.POWChainService,
 		chainStartFetcher:     cfg.ChainStartFetcher,
 		mockEth1Votes:         cfg.MockEth1Votes,
@@ -144,6 +157,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		syncService:           cfg.SyncService,
 		host:                  cfg.Host,
 		port:                  cfg.Port,
+		adminPort:             cfg.AdminPort,
 		withCert:              cfg.CertFlag,
 		withKey:               cfg.KeyFlag,
 		depositFetcher:        cfg.DepositFetcher,
@@ -251,7 +265,24 @@ func (s *Service) Start() {
 		AttPool:     s.attestationsPool,
 		P2p:         s.p2p,
 	}
+	checkpointServer := &checkpoint.Server{
+		BeaconDB:            s.beaconDB,
+		FinalizationFetcher: s.finalizationFetcher,
+	}
+	// Peer bans are only managed through the admin server.
+	peersServer := &peers.Server{
+		PeersFetcher: s.peersFetcher,
+	}
+	eventsServer := &events.Server{
+		Ctx:                 s.ctx,
+		StateNotifier:       s.stateNotifier,
+		OperationNotifier:   s.operationNotifier,
+		ReorgHistoryFetcher: s.reorgHistoryFetcher,
+	}
 	pb.RegisterAggregatorServiceServer(s.grpcServer, aggregatorServer)
+	pb.RegisterCheckpointServer(s.grpcServer, checkpointServer)
+	pb.RegisterEventsServer(s.grpcServer, eventsServer)
+	pb.RegisterPeersServer(s.grpcServer, peersServer)
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
@@ -266,11 +297,38 @@ func (s *Service) Start() {
 			}
 		}
 	}()
+	if s.adminPort != 0 {
+		s.startAdminServer(opts)
+	}
 	if featureconfig.Get().EnableSlasherConnection {
 		s.startSlasherClient()
 	}
 }
 
+// startAdminServer serves the administrative services, such as the management of peer
+// bans, on a listener bound to localhost only, as their callers are not authenticated.
+func (s *Service) startAdminServer(opts []grpc.ServerOption) {
+	address := fmt.Sprintf("127.0.0.1:%d", s.adminPort)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Errorf("Could not listen to admin port %s: %v", address, err)
+		return
+	}
+	s.adminListener = lis
+	log.WithField("address", address).Info("Admin RPC-API listening on port")
+
+	s.adminServer = grpc.NewServer(opts...)
+	pb.RegisterPeersServer(s.adminServer, &peers.Server{
+		PeersFetcher: s.peersFetcher,
+		BanManager:   s.banManager,
+	})
+	go func() {
+		if err := s.adminServer.Serve(s.adminListener); err != nil {
+			log.Errorf("Could not serve admin gRPC: %v", err)
+		}
+	}()
+}
+
 func (s *Service) startSlasherClient() {
 	var dialOpt grpc.DialOption
 	if s.slasherCert != "" {
@@ -313,6 +371,9 @@ func (s *Service) Stop() error {
 		s.grpcServer.GracefulStop()
 		log.Debug("Initiated graceful stop of gRPC server")
 	}
+	if s.adminListener != nil {
+		s.adminServer.GracefulStop()
+	}
 	if s.slasherConn != nil {
 		s.slasherConn.Close()
 	}
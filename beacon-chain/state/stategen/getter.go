@@ -0,0 +1,115 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// StateBySlot returns the state of the finalized chain at the given slot. The state is
+// regenerated by replaying blocks on top of the nearest snapshot in cold storage at or
+// below the slot, or on top of the genesis state if there is no such snapshot.
+func (s *State) StateBySlot(ctx context.Context, slot uint64) (*stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.StateBySlot")
+	defer span.End()
+
+	checkpoint, err := s.beaconDB.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	finalizedRoot := bytesutil.ToBytes32(checkpoint.Root)
+	finalizedBlock, err := s.beaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.Block == nil {
+		return nil, fmt.Errorf("could not find finalized block %#x", finalizedRoot)
+	}
+	if slot > finalizedBlock.Block.Slot {
+		return nil, fmt.Errorf("slot %d is not finalized, finalized block is at slot %d", slot, finalizedBlock.Block.Slot)
+	}
+
+	// The next snapshot above the slot, if there is one, anchors the canonical chain much
+	// closer to the requested slot than the finalized block does.
+	endRoot := finalizedRoot
+	next, err := s.beaconDB.ColdState(ctx, slot+s.slotsPerArchivePoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve cold state")
+	}
+	if next != nil && next.Slot() > slot {
+		endRoot, err = latestBlockRoot(next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.stateBySlot(ctx, slot, endRoot)
+}
+
+// stateBySlot generates the state at the given slot on the chain ending in endRoot.
+func (s *State) stateBySlot(ctx context.Context, slot uint64, endRoot [32]byte) (*stateTrie.BeaconState, error) {
+	base, err := s.beaconDB.ColdState(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve cold state")
+	}
+	if base == nil {
+		base, err = s.beaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve genesis state")
+		}
+		if base == nil {
+			return nil, errors.New("no cold state or genesis state to regenerate state from")
+		}
+	}
+	// A node started from a checkpoint holds no state before the checkpoint.
+	if base.Slot() > slot {
+		return nil, fmt.Errorf("slot %d is before the earliest state held, at slot %d", slot, base.Slot())
+	}
+	if base.Slot() == slot {
+		return base, nil
+	}
+
+	blocks, err := s.LoadBlocks(ctx, base.Slot()+1, slot, endRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load blocks")
+	}
+	// Start from the state of the last block if it has not yet been pruned from the
+	// hot state storage, to avoid replaying the whole range.
+	if len(blocks) > 0 {
+		lastRoot, err := ssz.HashTreeRoot(blocks[len(blocks)-1].Block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get block root")
+		}
+		hotState, err := s.beaconDB.State(ctx, lastRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve state")
+		}
+		if hotState != nil {
+			base = hotState
+			blocks = nil
+		}
+	}
+	return ReplayBlocks(ctx, base, blocks, slot)
+}
+
+// latestBlockRoot returns the root of the latest block applied to the state.
+func latestBlockRoot(st *stateTrie.BeaconState) ([32]byte, error) {
+	header := st.LatestBlockHeader()
+	// The state root of the header is only filled in when the next slot is processed.
+	if bytesutil.ToBytes32(header.StateRoot) == [32]byte{} {
+		stateRoot, err := st.HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not get state root")
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	root, err := ssz.HashTreeRoot(header)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get block header root")
+	}
+	return root, nil
+}
@@ -0,0 +1,91 @@
+package stategen
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestStateBySlot_RegeneratesFinalizedStates(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	blocks, postStates := setupChain(t, beaconDB, []uint64{1, 2, 3, 5, 6, 9})
+	finalized := blocks[len(blocks)-1]
+	finalizedRoot, err := ssz.HashTreeRoot(finalized.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, postStates[9], finalizedRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: finalizedRoot[:]}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(beaconDB, 4)
+	if err := s.MigrateToCold(ctx, finalizedRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, slot := range []uint64{0, 2, 4, 7, 8, 9} {
+		st, err := s.StateBySlot(ctx, slot)
+		if err != nil {
+			t.Fatalf("Could not get state at slot %d: %v", slot, err)
+		}
+		if st.Slot() != slot {
+			t.Errorf("Wanted state at slot %d, received %d", slot, st.Slot())
+		}
+		root, err := st.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wanted := stateRootAtSlot(t, postStates, slot); root != wanted {
+			t.Errorf("Wanted state root %#x at slot %d, received %#x", wanted, slot, root)
+		}
+	}
+
+	if _, err := s.StateBySlot(ctx, 10); err == nil || !strings.Contains(err.Error(), "is not finalized") {
+		t.Errorf("Expected error for slot which is not finalized, received %v", err)
+	}
+}
+
+func TestStateBySlot_BeforeCheckpoint(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	// A node started from a checkpoint holds the checkpoint block in place of genesis.
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	if err := st.SetSlot(8); err != nil {
+		t.Fatal(err)
+	}
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 8}}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, st, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: root[:]}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(beaconDB, 4)
+	if _, err := s.StateBySlot(ctx, 4); err == nil || !strings.Contains(err.Error(), "before the earliest state held") {
+		t.Errorf("Expected error for slot before the checkpoint, received %v", err)
+	}
+}
@@ -0,0 +1,5 @@
+package stategen
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "stategen")
@@ -0,0 +1,43 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+// MigrateToCold saves a snapshot of the finalized chain to cold storage at every archive
+// point between the last snapshot and the block with finalizedRoot. It should be called
+// on each new finalized checkpoint, before the finalized hot states are pruned.
+func (s *State) MigrateToCold(ctx context.Context, finalizedRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.MigrateToCold")
+	defer span.End()
+	s.migrationLock.Lock()
+	defer s.migrationLock.Unlock()
+
+	finalizedBlock, err := s.beaconDB.Block(ctx, finalizedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.Block == nil {
+		return fmt.Errorf("could not find finalized block %#x", finalizedRoot)
+	}
+	lastSlot, err := s.beaconDB.LastColdStateSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve last cold state slot")
+	}
+
+	for slot := lastSlot - lastSlot%s.slotsPerArchivePoint + s.slotsPerArchivePoint; slot <= finalizedBlock.Block.Slot; slot += s.slotsPerArchivePoint {
+		st, err := s.stateBySlot(ctx, slot, finalizedRoot)
+		if err != nil {
+			return errors.Wrapf(err, "could not generate state at slot %d", slot)
+		}
+		if err := s.beaconDB.SaveColdState(ctx, st); err != nil {
+			return errors.Wrapf(err, "could not save cold state at slot %d", slot)
+		}
+		log.WithField("slot", slot).Debug("Saved finalized state to cold storage")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+)
+
+func TestMigrateToCold_SavesArchivePoints(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	blocks, postStates := setupChain(t, beaconDB, []uint64{1, 2, 3, 5, 6, 7, 9, 10})
+	s := New(beaconDB, 4)
+
+	// Finalize the block at slot 6, which crosses the archive point at slot 4.
+	root, err := ssz.HashTreeRoot(blocks[4].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.MigrateToCold(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	lastSlot, err := beaconDB.LastColdStateSlot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastSlot != 4 {
+		t.Errorf("Wanted last cold state at slot 4, received %d", lastSlot)
+	}
+
+	// Finalize the block at slot 10, which crosses the archive point at slot 8.
+	root, err = ssz.HashTreeRoot(blocks[7].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.MigrateToCold(ctx, root); err != nil {
+		t.Fatal(err)
+	}
+	for _, slot := range []uint64{4, 8} {
+		st, err := beaconDB.ColdState(ctx, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st == nil || st.Slot() != slot {
+			t.Fatalf("Wanted cold state at slot %d, received %v", slot, st)
+		}
+		stateRoot, err := st.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wanted := stateRootAtSlot(t, postStates, slot); stateRoot != wanted {
+			t.Errorf("Wanted cold state root %#x at slot %d, received %#x", wanted, slot, stateRoot)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package stategen
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+// ReplayBlocks applies the blocks to the state in order, then processes any empty slots
+// up to the target slot. The blocks must be in ascending slot order and build on the state.
+//
+// WARNING: This method modifies the passed in state.
+func ReplayBlocks(ctx context.Context, st *stateTrie.BeaconState, signed []*ethpb.SignedBeaconBlock, targetSlot uint64) (*stateTrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.ReplayBlocks")
+	defer span.End()
+
+	var err error
+	for _, b := range signed {
+		st, err = state.ExecuteStateTransition(ctx, st, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not replay block at slot %d", b.Block.Slot)
+		}
+	}
+	if targetSlot > st.Slot() {
+		st, err = state.ProcessSlots(ctx, st, targetSlot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not process slots up to %d", targetSlot)
+		}
+	}
+	return st, nil
+}
+
+// LoadBlocks returns the blocks with slots from startSlot to endSlot inclusive which are
+// ancestors of, or are, the block with endBlockRoot. The blocks are returned in ascending
+// slot order. The end block may be above endSlot, in which case its ancestry is followed
+// down in to the requested range.
+func (s *State) LoadBlocks(ctx context.Context, startSlot uint64, endSlot uint64, endBlockRoot [32]byte) ([]*ethpb.SignedBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.LoadBlocks")
+	defer span.End()
+
+	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot)
+	blocks, err := s.beaconDB.Blocks(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve blocks")
+	}
+	blocksByRoot := make(map[[32]byte]*ethpb.SignedBeaconBlock, len(blocks))
+	for _, b := range blocks {
+		root, err := ssz.HashTreeRoot(b.Block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get block root")
+		}
+		blocksByRoot[root] = b
+	}
+
+	root := endBlockRoot
+	for {
+		if _, ok := blocksByRoot[root]; ok {
+			break
+		}
+		b, err := s.beaconDB.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve block")
+		}
+		if b == nil || b.Block == nil {
+			return nil, fmt.Errorf("could not find block %#x", root)
+		}
+		if b.Block.Slot < startSlot {
+			// The chain has no blocks in the requested range.
+			return []*ethpb.SignedBeaconBlock{}, nil
+		}
+		if b.Block.Slot <= endSlot {
+			blocksByRoot[root] = b
+			break
+		}
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+
+	var chain []*ethpb.SignedBeaconBlock
+	for b, ok := blocksByRoot[root]; ok; b, ok = blocksByRoot[root] {
+		chain = append(chain, b)
+		root = bytesutil.ToBytes32(b.Block.ParentRoot)
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
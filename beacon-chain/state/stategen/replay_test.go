@@ -0,0 +1,153 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// setupChain saves a genesis state and a chain of blocks at the given slots to the db,
+// returning the blocks and the post-state of each block keyed by its slot.
+func setupChain(t *testing.T, beaconDB db.Database, slots []uint64) ([]*ethpb.SignedBeaconBlock, map[uint64]*stateTrie.BeaconState) {
+	ctx := context.Background()
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := genesisState.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	if err := beaconDB.SaveBlock(ctx, genesis); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(genesis.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveState(ctx, genesisState, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks := make([]*ethpb.SignedBeaconBlock, 0, len(slots))
+	postStates := map[uint64]*stateTrie.BeaconState{0: genesisState.Copy()}
+	st := genesisState
+	for _, slot := range slots {
+		blk, err := testutil.GenerateFullBlock(st, privKeys, nil, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		st, err = state.ExecuteStateTransition(ctx, st, blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, blk)
+		postStates[slot] = st.Copy()
+	}
+	return blocks, postStates
+}
+
+// stateRootAtSlot returns the root of the post-state of the latest block at or before
+// the slot, advanced to the slot.
+func stateRootAtSlot(t *testing.T, postStates map[uint64]*stateTrie.BeaconState, slot uint64) [32]byte {
+	for i := slot; ; i-- {
+		st, ok := postStates[i]
+		if !ok {
+			continue
+		}
+		st, err := state.ProcessSlots(context.Background(), st.Copy(), slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := st.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+}
+
+func TestReplayBlocks_ProcessesBlocksAndSkippedSlots(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+
+	blocks, postStates := setupChain(t, beaconDB, []uint64{1, 2, 4})
+	genesisState, err := beaconDB.GenesisState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := ReplayBlocks(context.Background(), genesisState, blocks, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Slot() != 6 {
+		t.Errorf("Wanted state at slot 6, received %d", st.Slot())
+	}
+	root, err := st.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wanted := stateRootAtSlot(t, postStates, 6); root != wanted {
+		t.Errorf("Wanted state root %#x, received %#x", wanted, root)
+	}
+}
+
+func TestLoadBlocks_FollowsChainOfEndBlock(t *testing.T) {
+	beaconDB := testDB.SetupDB(t)
+	defer testDB.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	blocks, postStates := setupChain(t, beaconDB, []uint64{1, 2, 3, 5, 7})
+	// Save a competing block at slot 3 which builds on slot 1.
+	_, privKeys := testutil.DeterministicGenesisState(t, 64)
+	fork, err := testutil.GenerateFullBlock(postStates[1], privKeys, nil, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(ctx, fork); err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(beaconDB, 4)
+	endRoot, err := ssz.HashTreeRoot(blocks[4].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := s.LoadBlocks(ctx, 2, 5, endRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []uint64{2, 3, 5}
+	if len(loaded) != len(wanted) {
+		t.Fatalf("Wanted %d blocks, received %d", len(wanted), len(loaded))
+	}
+	for i, blk := range loaded {
+		if blk.Block.Slot != wanted[i] {
+			t.Errorf("Wanted block at slot %d, received %d", wanted[i], blk.Block.Slot)
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantedRoot, err := ssz.HashTreeRoot(blocks[i+1].Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != wantedRoot {
+			t.Errorf("Received block at slot %d which is not in the chain of the end block", blk.Block.Slot)
+		}
+	}
+}
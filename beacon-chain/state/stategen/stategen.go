@@ -0,0 +1,26 @@
+// Package stategen keeps finalized beacon states in cold storage as periodic snapshots,
+// and regenerates the states between snapshots by replaying blocks.
+package stategen
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+)
+
+// State keeps a full finalized state in cold storage every slotsPerArchivePoint slots,
+// and serves any finalized state by replaying blocks on top of the nearest snapshot.
+type State struct {
+	beaconDB             db.NoHeadAccessDatabase
+	slotsPerArchivePoint uint64
+	migrationLock        sync.Mutex
+}
+
+// New returns a state generator which saves a snapshot to cold storage every
+// slotsPerArchivePoint slots of the finalized chain.
+func New(beaconDB db.NoHeadAccessDatabase, slotsPerArchivePoint uint64) *State {
+	return &State{
+		beaconDB:             beaconDB,
+		slotsPerArchivePoint: slotsPerArchivePoint,
+	}
+}
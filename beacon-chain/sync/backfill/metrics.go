@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillOldestSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_oldest_slot",
+			Help: "Slot of the oldest block held by the node, which backfill is working back from.",
+		},
+	)
+	backfillBlocksCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "backfill_blocks_total",
+			Help: "Count of historical blocks saved by backfill.",
+		},
+	)
+)
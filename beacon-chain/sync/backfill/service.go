@@ -0,0 +1,338 @@
+// Package backfill fills in the blocks before the anchor block of a node which was started
+// from a finalized checkpoint, working backwards from the anchor towards genesis.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = shared.Service(&Service{})
+
+const (
+	blockBatchSize         = 64
+	refreshTime            = 6 * time.Second
+	allowedBlocksPerSecond = 32.0
+	minRetryDelay          = time.Second
+	maxRetryDelay          = time.Minute
+)
+
+// Config to set up the backfill service.
+type Config struct {
+	DB          db.NoHeadAccessDatabase
+	P2P         p2p.P2P
+	InitialSync prysmsync.Checker
+}
+
+// Service requests the blocks before the oldest block held from peers, saving each block
+// once its root is verified against the parent root of the block after it. Progress is
+// saved to the database, so backfill resumes where it left off after a restart.
+type Service struct {
+	ctx               context.Context
+	cancel            context.CancelFunc
+	db                db.NoHeadAccessDatabase
+	p2p               p2p.P2P
+	initialSync       prysmsync.Checker
+	blocksRateLimiter *leakybucket.Collector
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:               ctx,
+		cancel:            cancel,
+		db:                cfg.DB,
+		p2p:               cfg.P2P,
+		initialSync:       cfg.InitialSync,
+		blocksRateLimiter: leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+	}
+}
+
+// Start the backfill service.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	// Backfill only starts once initial sync is complete, so that it does not compete
+	// with initial sync for peers.
+	for s.initialSync.Syncing() {
+		if !wait(s.ctx, refreshTime) {
+			return
+		}
+	}
+
+	blk, err := s.oldestBlock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not determine where to backfill from")
+		return
+	}
+	if blk == nil {
+		return
+	}
+	if blk.Block.Slot > 0 {
+		log.WithField("slot", blk.Block.Slot).Info("Backfilling blocks before the oldest block held")
+		if blk = s.backfill(blk); blk == nil {
+			return
+		}
+		backfillOldestSlot.Set(0)
+		log.Info("Backfill complete")
+	}
+
+	// The oldest block is now the genesis block, whose root is not known to a node started
+	// from a checkpoint until backfill reaches it.
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		log.WithError(err).Error("Could not get genesis block root")
+		return
+	}
+	if err := s.db.SaveGenesisBlockRoot(s.ctx, root); err != nil {
+		log.WithError(err).Error("Could not save genesis block root")
+	}
+}
+
+// backfill the ancestors of the block down to genesis, returning the genesis block or nil if
+// backfill was stopped before reaching it. Failed requests are retried with an exponential
+// backoff, and peers which failed are skipped until no other peer is left to try.
+func (s *Service) backfill(blk *ethpb.SignedBeaconBlock) *ethpb.SignedBeaconBlock {
+	failedPeers := make(map[peer.ID]bool)
+	retryDelay := minRetryDelay
+	retry := func(pid peer.ID) bool {
+		failedPeers[pid] = true
+		if !wait(s.ctx, retryDelay) {
+			return false
+		}
+		retryDelay *= 2
+		if retryDelay > maxRetryDelay {
+			retryDelay = maxRetryDelay
+		}
+		return true
+	}
+
+	// Blocks are requested in batches of slots ending just below endSlot, moving the batch
+	// further back if it holds no ancestors of the oldest block.
+	endSlot := blk.Block.Slot
+	for blk.Block.Slot > 0 {
+		if s.ctx.Err() != nil {
+			return nil
+		}
+		backfillOldestSlot.Set(float64(blk.Block.Slot))
+
+		pid, headRoot, ok := s.bestPeer(failedPeers)
+		if !ok {
+			if len(failedPeers) > 0 {
+				// Every peer has failed, so give them another chance.
+				failedPeers = make(map[peer.ID]bool)
+				continue
+			}
+			log.Debug("No peers to backfill from; waiting for reconnect")
+			if !wait(s.ctx, refreshTime) {
+				return nil
+			}
+			continue
+		}
+		startSlot := uint64(0)
+		if endSlot > blockBatchSize {
+			startSlot = endSlot - blockBatchSize
+		}
+		req := &pb.BeaconBlocksByRangeRequest{
+			HeadBlockRoot: headRoot,
+			StartSlot:     startSlot,
+			Count:         endSlot - startSlot,
+			Step:          1,
+		}
+		blocks, err := s.requestBlocks(s.ctx, req, pid)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Backfill request failed")
+			s.p2p.Peers().IncrementBadResponses(pid)
+			if !retry(pid) {
+				return nil
+			}
+			continue
+		}
+
+		ancestors, err := ancestors(blk, blocks)
+		if err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not verify backfilled blocks")
+			if !retry(pid) {
+				return nil
+			}
+			continue
+		}
+		if len(ancestors) == 0 {
+			if startSlot == 0 {
+				// The peer holds no ancestors of the oldest block at all, so start
+				// over from the oldest block with the next peer.
+				log.WithField("peer", pid).Debug("Peer did not serve the parent of the oldest block")
+				s.p2p.Peers().IncrementBadResponses(pid)
+				endSlot = blk.Block.Slot
+				if !retry(pid) {
+					return nil
+				}
+				continue
+			}
+			endSlot = startSlot
+			continue
+		}
+
+		if err := s.db.SaveBlocks(s.ctx, ancestors); err != nil {
+			log.WithError(err).Error("Could not save backfilled blocks")
+			return nil
+		}
+		blk = ancestors[len(ancestors)-1]
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			log.WithError(err).Error("Could not get block root")
+			return nil
+		}
+		if err := s.db.SaveBackfillBlockRoot(s.ctx, root); err != nil {
+			log.WithError(err).Error("Could not save backfill progress")
+			return nil
+		}
+		backfillBlocksCounter.Add(float64(len(ancestors)))
+		log.WithFields(logrus.Fields{
+			"slot":  blk.Block.Slot,
+			"count": len(ancestors),
+		}).Info("Backfilled blocks")
+		endSlot = blk.Block.Slot
+		retryDelay = minRetryDelay
+	}
+	return blk
+}
+
+// oldestBlock returns the oldest block held which is known to descend from genesis, which is
+// the last backfilled block or, if backfill has not started, the checkpoint anchor block. Returns
+// nil for a node which was started from genesis.
+func (s *Service) oldestBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
+	root, err := s.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve backfill block root")
+	}
+	if root == [32]byte{} {
+		root, err = s.db.AnchorBlockRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve anchor block root")
+		}
+		if root == [32]byte{} {
+			return nil, nil
+		}
+	}
+	blk, err := s.db.Block(ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve block")
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, fmt.Errorf("could not find block %#x to backfill from", root)
+	}
+	return blk, nil
+}
+
+// bestPeer returns the peer with the highest finalized epoch which is not known to be bad and
+// has not failed a backfill request, along with the finalized root most peers agree upon.
+func (s *Service) bestPeer(failedPeers map[peer.ID]bool) (peer.ID, []byte, bool) {
+	root, _, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, 0)
+	for _, pid := range peers {
+		if !s.p2p.Peers().IsBad(pid) && !failedPeers[pid] {
+			return pid, root, true
+		}
+	}
+	return "", nil, false
+}
+
+// requestBlocks by range to a specific peer.
+func (s *Service) requestBlocks(ctx context.Context, req *pb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*ethpb.SignedBeaconBlock, error) {
+	if s.blocksRateLimiter.Remaining(pid.String()) < int64(req.Count) {
+		log.WithField("peer", pid).Debug("Slowing down for rate limit")
+		if !wait(ctx, s.blocksRateLimiter.TillEmpty(pid.String())) {
+			return nil, ctx.Err()
+		}
+	}
+	s.blocksRateLimiter.Add(pid.String(), int64(req.Count))
+	log.WithFields(logrus.Fields{
+		"peer":  pid,
+		"start": req.StartSlot,
+		"count": req.Count,
+	}).Debug("Requesting blocks")
+	stream, err := s.p2p.Send(ctx, req, pid)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send request to peer")
+	}
+	defer stream.Close()
+
+	resp := make([]*ethpb.SignedBeaconBlock, 0, req.Count)
+	for {
+		blk, err := prysmsync.ReadChunkedBlock(stream)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read chunked block")
+		}
+		resp = append(resp, blk)
+	}
+	return resp, nil
+}
+
+// ancestors returns the ancestors of the child block found amongst the blocks, newest first.
+// Each block is only accepted if its root matches the parent root of the block after it.
+func ancestors(child *ethpb.SignedBeaconBlock, blocks []*ethpb.SignedBeaconBlock) ([]*ethpb.SignedBeaconBlock, error) {
+	byRoot := make(map[[32]byte]*ethpb.SignedBeaconBlock, len(blocks))
+	for _, blk := range blocks {
+		if blk == nil || blk.Block == nil {
+			continue
+		}
+		root, err := ssz.HashTreeRoot(blk.Block)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get block root")
+		}
+		byRoot[root] = blk
+	}
+
+	var res []*ethpb.SignedBeaconBlock
+	for {
+		parent, ok := byRoot[bytesutil.ToBytes32(child.Block.ParentRoot)]
+		if !ok {
+			return res, nil
+		}
+		res = append(res, parent)
+		child = parent
+	}
+}
+
+// wait for the given duration, returning false if the context is done first.
+func wait(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
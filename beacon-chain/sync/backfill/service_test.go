@@ -0,0 +1,186 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// chain returns blocks at the given slots, each the child of the block before it.
+func chain(t *testing.T, slots []uint64) []*ethpb.SignedBeaconBlock {
+	blocks := make([]*ethpb.SignedBeaconBlock, len(slots))
+	var parentRoot [32]byte
+	for i, slot := range slots {
+		blocks[i] = &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parentRoot[:]}}
+		root, err := ssz.HashTreeRoot(blocks[i].Block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parentRoot = root
+	}
+	return blocks
+}
+
+func TestAncestors(t *testing.T) {
+	blocks := chain(t, []uint64{0, 1, 3, 4, 7})
+	fork := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2, ParentRoot: blocks[1].Block.ParentRoot}}
+
+	// Blocks are matched by root regardless of the order in which they were received.
+	received := []*ethpb.SignedBeaconBlock{blocks[3], fork, blocks[1], blocks[2]}
+	res, err := ancestors(blocks[4], received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []uint64{4, 3, 1}
+	if len(res) != len(wanted) {
+		t.Fatalf("Wanted %d ancestors, received %d", len(wanted), len(res))
+	}
+	for i, blk := range res {
+		if blk.Block.Slot != wanted[i] {
+			t.Errorf("Wanted ancestor %d at slot %d, received %d", i, wanted[i], blk.Block.Slot)
+		}
+	}
+
+	res, err = ancestors(blocks[4], []*ethpb.SignedBeaconBlock{blocks[2], fork})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 0 {
+		t.Errorf("Expected no ancestors when the parent is missing, received %d", len(res))
+	}
+}
+
+func TestOldestBlock(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	ctx := context.Background()
+	s := NewService(ctx, &Config{DB: db})
+
+	blk, err := s.oldestBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk != nil {
+		t.Error("Expected no oldest block in an empty database")
+	}
+
+	blocks := chain(t, []uint64{0, 5, 9})
+	if err := db.SaveBlocks(ctx, blocks); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(blocks[0].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+	blk, err = s.oldestBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk != nil {
+		t.Error("Expected nothing to backfill for a node started from genesis")
+	}
+
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveCheckpointAnchor(ctx, st, blocks[2], 2); err != nil {
+		t.Fatal(err)
+	}
+	blk, err = s.oldestBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk == nil || blk.Block.Slot != 9 {
+		t.Error("Expected backfill to start from the anchor block")
+	}
+
+	backfilledRoot, err := ssz.HashTreeRoot(blocks[1].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBackfillBlockRoot(ctx, backfilledRoot); err != nil {
+		t.Fatal(err)
+	}
+	blk, err = s.oldestBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk == nil || blk.Block.Slot != 5 {
+		t.Error("Expected backfill to resume from the last backfilled block")
+	}
+}
+
+func TestBestPeer_SkipsFailedPeers(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	s := NewService(context.Background(), &Config{P2P: p})
+	pids := []peer.ID{"a", "b"}
+	for i, pid := range pids {
+		p.Peers().Add(pid, nil, network.DirOutbound)
+		p.Peers().SetConnectionState(pid, peers.PeerConnected)
+		p.Peers().SetChainState(pid, &pb.Status{FinalizedRoot: []byte("root"), FinalizedEpoch: uint64(2 - i)})
+	}
+
+	pid, _, ok := s.bestPeer(map[peer.ID]bool{})
+	if !ok || pid != "a" {
+		t.Fatalf("Expected peer a with the highest finalized epoch, received %s", pid)
+	}
+	pid, _, ok = s.bestPeer(map[peer.ID]bool{"a": true})
+	if !ok || pid != "b" {
+		t.Fatalf("Expected failed peer a to be skipped, received %s", pid)
+	}
+	if _, _, ok := s.bestPeer(map[peer.ID]bool{"a": true, "b": true}); ok {
+		t.Error("Expected no peer when every peer has failed")
+	}
+}
+
+func TestRun_SavesGenesisBlockRoot(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	defer dbtest.TeardownDB(t, db)
+	ctx := context.Background()
+	s := NewService(ctx, &Config{DB: db, InitialSync: &mockSync.Sync{IsSyncing: false}})
+
+	blocks := chain(t, []uint64{0, 5})
+	if err := db.SaveBlocks(ctx, blocks); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.HashTreeRoot(blocks[0].Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Backfill was interrupted after saving the genesis block.
+	if err := db.SaveBackfillBlockRoot(ctx, genesisRoot); err != nil {
+		t.Fatal(err)
+	}
+
+	s.run()
+	blk, err := db.GenesisBlock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk == nil || blk.Block.Slot != 0 {
+		t.Error("Expected the genesis block root to be saved once backfill reached genesis")
+	}
+}
+
+func TestWait_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if wait(ctx, time.Hour) {
+		t.Error("Expected wait to return early once the context is done")
+	}
+}
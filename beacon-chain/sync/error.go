@@ -18,9 +18,11 @@ var responseCodeSuccess = byte(0x00)
 var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
 
-func (r *Service) generateErrorResponse(code byte, reason string) ([]byte, error) {
+// generateErrorResponse with the given response code and reason, encoded with the
+// encoding of the stream it is written to.
+func generateErrorResponse(encoding encoder.NetworkEncoding, code byte, reason string) ([]byte, error) {
 	buf := bytes.NewBuffer([]byte{code})
-	if _, err := r.p2p.Encoding().EncodeWithLength(buf, []byte(reason)); err != nil {
+	if _, err := encoding.EncodeWithLength(buf, []byte(reason)); err != nil {
 		return nil, err
 	}
 
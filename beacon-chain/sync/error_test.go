@@ -4,31 +4,30 @@ import (
 	"bytes"
 	"testing"
 
-	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 )
 
 func TestRegularSync_generateErrorResponse(t *testing.T) {
-	r := &Service{
-		p2p: p2ptest.NewTestP2P(t),
-	}
-	data, err := r.generateErrorResponse(responseCodeServerError, "something bad happened")
-	if err != nil {
-		t.Fatal(err)
-	}
+	for _, e := range []*encoder.SszNetworkEncoder{{}, {UseSnappyCompression: true}} {
+		data, err := generateErrorResponse(e, responseCodeServerError, "something bad happened")
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	buf := bytes.NewBuffer(data)
-	b := make([]byte, 1)
-	if _, err := buf.Read(b); err != nil {
-		t.Fatal(err)
-	}
-	if b[0] != responseCodeServerError {
-		t.Errorf("The first byte was not the status code. Got %#x wanted %#x", b, responseCodeServerError)
-	}
-	msg := make([]byte, 0)
-	if err := r.p2p.Encoding().DecodeWithLength(buf, &msg); err != nil {
-		t.Fatal(err)
-	}
-	if string(msg) != "something bad happened" {
-		t.Errorf("Received the wrong message: %v", msg)
+		buf := bytes.NewBuffer(data)
+		b := make([]byte, 1)
+		if _, err := buf.Read(b); err != nil {
+			t.Fatal(err)
+		}
+		if b[0] != responseCodeServerError {
+			t.Errorf("The first byte was not the status code. Got %#x wanted %#x", b, responseCodeServerError)
+		}
+		msg := make([]byte, 0)
+		if err := e.DecodeWithLength(buf, &msg); err != nil {
+			t.Fatal(err)
+		}
+		if string(msg) != "something bad happened" {
+			t.Errorf("Received the wrong message: %v", msg)
+		}
 	}
 }
@@ -0,0 +1,291 @@
+package initialsync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// blockBatchTimeout is the time a peer has to serve a batch of blocks before the batch
+	// is reassigned to another peer.
+	blockBatchTimeout = 10 * time.Second
+	// maxBatchAttempts is the number of peers a batch is requested from before giving up.
+	maxBatchAttempts = 3
+	// throughputWeight is the weight of the latest batch in the moving average of the
+	// throughput of a peer.
+	throughputWeight = 0.3
+)
+
+var errNoPeersAvailable = errors.New("no peers available to request blocks from")
+
+// blocksBatch is a range of slots requested as a whole from a single peer, along with
+// the blocks the peer returned for it.
+type blocksBatch struct {
+	start  uint64
+	count  uint64
+	pid    peer.ID
+	blocks []*eth.SignedBeaconBlock
+	err    error
+}
+
+// peerSource returns the root to request blocks for and the peers which can serve the
+// blocks up to the given target epoch.
+type peerSource func(targetEpoch uint64) ([]byte, []peer.ID)
+
+// blocksFetcherConfig is the configuration of a blocks fetcher. The peers default to the
+// ones which agree on the best finalized epoch.
+type blocksFetcherConfig struct {
+	p2p         p2p.P2P
+	rateLimiter *leakybucket.Collector
+	peers       peerSource
+}
+
+// blocksFetcher requests batches of blocks from peers. A batch is assigned to the peer
+// with the fewest batches in flight, preferring the peers which served blocks the fastest,
+// and is reassigned to another peer if the request fails or times out.
+type blocksFetcher struct {
+	ctx         context.Context
+	p2p         p2p.P2P
+	rateLimiter *leakybucket.Collector
+	peers       peerSource
+	rand        *rand.Rand
+	lock        sync.Mutex
+	inFlight    map[peer.ID]int
+	throughput  map[peer.ID]float64 // moving average of blocks per second.
+}
+
+func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetcher {
+	f := &blocksFetcher{
+		ctx:         ctx,
+		p2p:         cfg.p2p,
+		rateLimiter: cfg.rateLimiter,
+		peers:       cfg.peers,
+		rand:        rand.New(rand.NewSource(roughtime.Now().Unix())),
+		inFlight:    make(map[peer.ID]int),
+		throughput:  make(map[peer.ID]float64),
+	}
+	if f.peers == nil {
+		f.peers = f.finalizedPeers
+	}
+	return f
+}
+
+// finalizedPeers returns the best finalized root and the peers at or beyond its epoch, which
+// is at least the target epoch. The target epoch rather than the slots of a batch is used, as
+// the last batch of a sync to the end of a finalized epoch starts in the following epoch.
+func (f *blocksFetcher) finalizedPeers(targetEpoch uint64) ([]byte, []peer.ID) {
+	root, _, peers := f.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, targetEpoch)
+	return root, peers
+}
+
+// fetch requests the blocks of count slots from the start slot, trying up to
+// maxBatchAttempts different peers which can serve blocks up to the target epoch. The
+// error of the last attempt is set in the returned batch if all of them failed.
+func (f *blocksFetcher) fetch(start uint64, count uint64, targetEpoch uint64) *blocksBatch {
+	batch := &blocksBatch{start: start, count: count}
+	tried := make(map[peer.ID]bool)
+	for attempt := 0; attempt < maxBatchAttempts; attempt++ {
+		if err := f.ctx.Err(); err != nil {
+			batch.err = err
+			return batch
+		}
+		root, pid, err := f.selectPeer(targetEpoch, tried)
+		if err != nil {
+			batch.err = err
+			return batch
+		}
+		tried[pid] = true
+		req := &p2ppb.BeaconBlocksByRangeRequest{
+			HeadBlockRoot: root,
+			StartSlot:     start,
+			Count:         count,
+			Step:          1,
+		}
+		blocks, err := f.requestBatch(req, pid)
+		if err != nil {
+			log.WithError(err).WithFields(logrus.Fields{
+				"peer":  pid.Pretty(),
+				"start": start,
+				"count": count,
+			}).Debug("Request failed, reassigning batch to another peer")
+			batch.err = err
+			continue
+		}
+		batch.pid, batch.blocks, batch.err = pid, blocks, nil
+		return batch
+	}
+	return batch
+}
+
+// selectPeer returns the root to request blocks for and the peer, among the ones not
+// tried yet which can serve blocks up to the target epoch, to request a batch from.
+func (f *blocksFetcher) selectPeer(targetEpoch uint64, tried map[peer.ID]bool) ([]byte, peer.ID, error) {
+	root, peers := f.peers(targetEpoch)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	// Shuffle the peers so that ties are broken randomly, to prevent a bad peer from
+	// being assigned the same batches over and over.
+	f.rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	var best peer.ID
+	for _, pid := range peers {
+		if tried[pid] {
+			continue
+		}
+		if best == "" || f.inFlight[pid] < f.inFlight[best] ||
+			(f.inFlight[pid] == f.inFlight[best] && f.throughput[pid] > f.throughput[best]) {
+			best = pid
+		}
+	}
+	if best == "" {
+		return nil, "", errNoPeersAvailable
+	}
+	return root, best, nil
+}
+
+// requestBatch requests a batch of blocks from the peer within blockBatchTimeout, and
+// updates the throughput of the peer.
+func (f *blocksFetcher) requestBatch(req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+	f.lock.Lock()
+	f.inFlight[pid]++
+	f.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(f.ctx, blockBatchTimeout)
+	defer cancel()
+	started := time.Now()
+	blocks, err := f.requestBlocks(ctx, req, pid)
+	if err == nil {
+		err = validateBatch(req, blocks)
+		if err != nil {
+			f.p2p.Peers().IncrementBadResponses(pid)
+		}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.inFlight[pid]--
+	if err != nil {
+		f.throughput[pid] = 0
+		return nil, err
+	}
+	rate := float64(len(blocks)) / time.Since(started).Seconds()
+	f.throughput[pid] = throughputWeight*rate + (1-throughputWeight)*f.throughput[pid]
+	return blocks, nil
+}
+
+// requestBlocks by range to a specific peer.
+func (f *blocksFetcher) requestBlocks(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+	f.waitForRateLimit(pid, req.Count)
+	log.WithFields(logrus.Fields{
+		"peer":  pid,
+		"start": req.StartSlot,
+		"count": req.Count,
+		"step":  req.Step,
+		"head":  fmt.Sprintf("%#x", req.HeadBlockRoot),
+	}).Debug("Requesting blocks")
+	stream, err := f.p2p.Send(ctx, req, pid)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send request to peer")
+	}
+	defer stream.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := stream.SetReadDeadline(deadline); err != nil {
+			log.WithError(err).Debug("Could not set stream read deadline")
+		}
+	}
+
+	resp := make([]*eth.SignedBeaconBlock, 0, req.Count)
+	for {
+		blk, err := prysmsync.ReadChunkedBlock(stream)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read chunked block")
+		}
+		resp = append(resp, blk)
+	}
+
+	return resp, nil
+}
+
+// requestBlocksByRoot requests the blocks with the given roots from a specific peer.
+func (f *blocksFetcher) requestBlocksByRoot(ctx context.Context, roots [][32]byte, pid peer.ID) ([]*eth.SignedBeaconBlock, error) {
+	f.waitForRateLimit(pid, uint64(len(roots)))
+	ctx, cancel := context.WithTimeout(ctx, blockBatchTimeout)
+	defer cancel()
+	log.WithField("peer", pid).WithField("count", len(roots)).Debug("Requesting blocks by root")
+	stream, err := f.p2p.Send(ctx, roots, pid)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send request to peer")
+	}
+	defer stream.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := stream.SetReadDeadline(deadline); err != nil {
+			log.WithError(err).Debug("Could not set stream read deadline")
+		}
+	}
+
+	resp := make([]*eth.SignedBeaconBlock, 0, len(roots))
+	for i := 0; i < len(roots); i++ {
+		blk, err := prysmsync.ReadChunkedBlock(stream)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read chunked block")
+		}
+		resp = append(resp, blk)
+	}
+	return resp, nil
+}
+
+// validateBatch checks that the blocks returned for a request are within its range of
+// slots and in increasing slot order.
+func validateBatch(req *p2ppb.BeaconBlocksByRangeRequest, blocks []*eth.SignedBeaconBlock) error {
+	end := req.StartSlot + req.Count*req.Step
+	var prevSlot uint64
+	for i, blk := range blocks {
+		if blk == nil || blk.Block == nil {
+			return errors.New("received nil block")
+		}
+		slot := blk.Block.Slot
+		if slot < req.StartSlot || slot >= end {
+			return errors.Errorf("received block at slot %d outside of requested range [%d, %d)", slot, req.StartSlot, end)
+		}
+		if i > 0 && slot <= prevSlot {
+			return errors.Errorf("received block at slot %d after block at slot %d", slot, prevSlot)
+		}
+		prevSlot = slot
+	}
+	return nil
+}
+
+// waitForRateLimit waits until count more blocks can be requested from the peer without
+// exceeding the rate limit, and adds them to the blocks requested from it.
+func (f *blocksFetcher) waitForRateLimit(pid peer.ID, count uint64) {
+	if f.rateLimiter.Remaining(pid.String()) < int64(count) {
+		log.WithField("peer", pid).Debug("Slowing down for rate limit")
+		time.Sleep(f.rateLimiter.TillEmpty(pid.String()))
+	}
+	f.rateLimiter.Add(pid.String(), int64(count))
+}
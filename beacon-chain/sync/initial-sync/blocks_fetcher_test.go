@@ -0,0 +1,156 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/peer"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestValidateBatch(t *testing.T) {
+	req := &p2ppb.BeaconBlocksByRangeRequest{StartSlot: 10, Count: 5, Step: 1}
+	blocksAt := func(slots ...uint64) []*eth.SignedBeaconBlock {
+		blocks := make([]*eth.SignedBeaconBlock, len(slots))
+		for i, slot := range slots {
+			blocks[i] = &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: slot}}
+		}
+		return blocks
+	}
+
+	tests := []struct {
+		name    string
+		blocks  []*eth.SignedBeaconBlock
+		wantErr bool
+	}{
+		{name: "no blocks", blocks: nil},
+		{name: "skipped slots", blocks: blocksAt(10, 12, 14)},
+		{name: "before range", blocks: blocksAt(9, 10), wantErr: true},
+		{name: "after range", blocks: blocksAt(13, 15), wantErr: true},
+		{name: "out of order", blocks: blocksAt(12, 11), wantErr: true},
+		{name: "duplicate", blocks: blocksAt(11, 11), wantErr: true},
+		{name: "nil block", blocks: []*eth.SignedBeaconBlock{{}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateBatch(req, tt.blocks); (err != nil) != tt.wantErr {
+				t.Errorf("validateBatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBlocksFetcher_FetchReassignsFailedBatches(t *testing.T) {
+	initializeRootCache(makeSequence(1, 128), t)
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{
+			blocks:         makeSequence(1, 128),
+			finalizedEpoch: 4,
+			headSlot:       128,
+			failureSlots:   makeSequence(1, 64),
+		},
+		{
+			blocks:         makeSequence(1, 128),
+			finalizedEpoch: 4,
+			headSlot:       128,
+		},
+	}, p.Peers())
+
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(10000, 10000, false /* deleteEmptyBuckets */),
+	})
+	batch := fetcher.fetch(1, blockBatchSize, 0 /* targetEpoch */)
+	if batch.err != nil {
+		t.Fatal(batch.err)
+	}
+	if len(batch.blocks) != blockBatchSize {
+		t.Errorf("Wanted %d blocks, got %d", blockBatchSize, len(batch.blocks))
+	}
+	if fetcher.throughput[batch.pid] == 0 {
+		t.Error("Wanted throughput of the serving peer to be recorded")
+	}
+	for pid, throughput := range fetcher.throughput {
+		if pid != batch.pid && throughput != 0 {
+			t.Errorf("Wanted no throughput for the failing peer, got %v", throughput)
+		}
+	}
+	for pid, n := range fetcher.inFlight {
+		if n != 0 {
+			t.Errorf("Wanted no requests in flight to peer %s, got %d", pid, n)
+		}
+	}
+}
+
+func TestBlocksFetcher_SelectPeer(t *testing.T) {
+	initializeRootCache(makeSequence(1, 64), t)
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{finalizedEpoch: 1, headSlot: 64},
+		{finalizedEpoch: 1, headSlot: 64},
+		{finalizedEpoch: 1, headSlot: 64},
+	}, p.Peers())
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(10000, 10000, false /* deleteEmptyBuckets */),
+	})
+	pids := p.Peers().Connected()
+	if len(pids) != 3 {
+		t.Fatalf("Wanted %d connected peers, got %d", 3, len(pids))
+	}
+
+	// The idle peer is preferred over the busier ones, whatever their throughput.
+	fetcher.inFlight[pids[0]] = 1
+	fetcher.inFlight[pids[1]] = 1
+	fetcher.throughput[pids[0]] = 100
+	_, pid, err := fetcher.selectPeer(0, make(map[peer.ID]bool))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != pids[2] {
+		t.Errorf("Wanted idle peer %s, got %s", pids[2], pid)
+	}
+
+	// Among equally busy peers the one with the best throughput is preferred.
+	_, pid, err = fetcher.selectPeer(0, map[peer.ID]bool{pids[2]: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pid != pids[0] {
+		t.Errorf("Wanted fastest peer %s, got %s", pids[0], pid)
+	}
+
+	_, _, err = fetcher.selectPeer(0, map[peer.ID]bool{pids[0]: true, pids[1]: true, pids[2]: true})
+	if err != errNoPeersAvailable {
+		t.Errorf("Wanted error %v, got %v", errNoPeersAvailable, err)
+	}
+}
+
+func TestBlocksFetcher_RequestBlocksByRootIsRateLimited(t *testing.T) {
+	initializeRootCache(makeSequence(1, 64), t)
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{blocks: makeSequence(1, 64), finalizedEpoch: 1, headSlot: 64},
+	}, p.Peers())
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(0.000001, 100, false /* deleteEmptyBuckets */),
+	})
+	pid := p.Peers().Connected()[0]
+
+	roots := [][32]byte{rootCache[1], rootCache[2]}
+	blocks, err := fetcher.requestBlocksByRoot(context.Background(), roots, pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != len(roots) {
+		t.Errorf("Wanted %d blocks, got %d", len(roots), len(blocks))
+	}
+	if remaining := fetcher.rateLimiter.Remaining(pid.String()); remaining > 100-int64(len(roots)) {
+		t.Errorf("Wanted requested blocks to count towards the rate limit, %d remaining", remaining)
+	}
+}
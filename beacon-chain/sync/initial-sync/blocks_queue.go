@@ -0,0 +1,156 @@
+package initialsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// queueMaxPendingBatches is the number of batches requested from peers at the same time.
+	queueMaxPendingBatches = 8
+	// queueMaxCachedBlocks is the number of fetched blocks waiting to be processed above which
+	// no more batches are requested, so that fetching does not outpace block processing.
+	queueMaxCachedBlocks = 2 * queueMaxPendingBatches * blockBatchSize
+	// batchRetryDelay is the time to wait before requesting a batch again after all the
+	// attempts to fetch it failed.
+	batchRetryDelay = time.Second
+	// maxBatchRetries is the number of times a batch is requested again before the queue
+	// gives up, so that the caller can check the peers again.
+	maxBatchRetries = 5
+)
+
+// blocksQueueConfig is the configuration of a blocks queue.
+type blocksQueueConfig struct {
+	fetcher             *blocksFetcher
+	startSlot           uint64
+	highestExpectedSlot uint64
+	// targetEpoch is the epoch the peers serving the batches must have finalized.
+	targetEpoch uint64
+}
+
+// blocksQueue schedules batches of slots up to the highest expected slot on a blocks
+// fetcher, buffers the batches arriving out of order and delivers them in slot order.
+// Batches are only scheduled while the number of blocks waiting to be processed stays
+// below queueMaxCachedBlocks. A batch which cannot be fetched after maxBatchRetries
+// retries stops the queue.
+type blocksQueue struct {
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	fetcher             *blocksFetcher
+	startSlot           uint64
+	highestExpectedSlot uint64
+	targetEpoch         uint64
+	fetched             chan *blocksBatch
+	// batches delivers the fetched batches in slot order, and is closed once all of them
+	// have been delivered or the queue is stopped.
+	batches chan *blocksBatch
+	// err is the reason the queue gave up on fetching batches, which may be read once
+	// batches is closed.
+	err error
+}
+
+func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
+	ctx, cancel := context.WithCancel(ctx)
+	return &blocksQueue{
+		ctx:                 ctx,
+		cancel:              cancel,
+		fetcher:             cfg.fetcher,
+		startSlot:           cfg.startSlot,
+		highestExpectedSlot: cfg.highestExpectedSlot,
+		targetEpoch:         cfg.targetEpoch,
+		fetched:             make(chan *blocksBatch),
+		batches:             make(chan *blocksBatch),
+	}
+}
+
+// start fetching batches in the background.
+func (q *blocksQueue) start() {
+	go q.loop()
+}
+
+// stop fetching batches and closes the batches channel.
+func (q *blocksQueue) stop() {
+	q.cancel()
+}
+
+func (q *blocksQueue) loop() {
+	defer close(q.batches)
+
+	nextStart := q.startSlot   // start slot of the next batch to schedule.
+	nextDeliver := q.startSlot // start slot of the next batch to deliver.
+	pending := 0
+	cachedBlocks := 0
+	fetched := make(map[uint64]*blocksBatch)
+	retries := make(map[uint64]int)
+	var ready []*blocksBatch
+
+	for {
+		for pending < queueMaxPendingBatches && cachedBlocks < queueMaxCachedBlocks && nextStart <= q.highestExpectedSlot {
+			count := mathutil.Min(blockBatchSize, q.highestExpectedSlot-nextStart+1)
+			pending++
+			go q.fetch(nextStart, count, 0)
+			nextStart += count
+		}
+		if len(ready) == 0 && nextDeliver > q.highestExpectedSlot {
+			return
+		}
+
+		// Only deliver when a batch is ready, as sending on a nil channel blocks forever.
+		var out chan<- *blocksBatch
+		var next *blocksBatch
+		if len(ready) > 0 {
+			out = q.batches
+			next = ready[0]
+		}
+		select {
+		case <-q.ctx.Done():
+			return
+		case batch := <-q.fetched:
+			if batch.err != nil {
+				if retries[batch.start] >= maxBatchRetries {
+					q.err = errors.Wrapf(batch.err, "could not fetch batch of %d slots from slot %d", batch.count, batch.start)
+					return
+				}
+				retries[batch.start]++
+				log.WithError(batch.err).WithFields(logrus.Fields{
+					"start": batch.start,
+					"count": batch.count,
+				}).Debug("Could not fetch batch, retrying")
+				go q.fetch(batch.start, batch.count, batchRetryDelay)
+				continue
+			}
+			delete(retries, batch.start)
+			pending--
+			cachedBlocks += len(batch.blocks)
+			fetched[batch.start] = batch
+			for b, ok := fetched[nextDeliver]; ok; b, ok = fetched[nextDeliver] {
+				delete(fetched, nextDeliver)
+				ready = append(ready, b)
+				nextDeliver += b.count
+			}
+		case out <- next:
+			ready = ready[1:]
+			cachedBlocks -= len(next.blocks)
+		}
+	}
+}
+
+// fetch a batch after the given delay, and hand it back to the queue loop.
+func (q *blocksQueue) fetch(start uint64, count uint64, delay time.Duration) {
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-q.ctx.Done():
+			return
+		}
+	}
+	batch := q.fetcher.fetch(start, count, q.targetEpoch)
+	select {
+	case q.fetched <- batch:
+	case <-q.ctx.Done():
+	}
+}
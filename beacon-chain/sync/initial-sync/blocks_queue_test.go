@@ -0,0 +1,160 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+)
+
+func TestBlocksQueue_DeliversBatchesInOrder(t *testing.T) {
+	slots := append(makeSequence(1, 100), makeSequence(300, 640)...)
+	initializeRootCache(slots, t)
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{blocks: slots, finalizedEpoch: 18, headSlot: 640},
+		{blocks: slots, finalizedEpoch: 18, headSlot: 640, failureSlots: makeSequence(400, 410)},
+		{blocks: slots, finalizedEpoch: 18, headSlot: 640},
+		{blocks: slots, finalizedEpoch: 18, headSlot: 640},
+	}, p.Peers())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(10000, 10000, false /* deleteEmptyBuckets */),
+	})
+	queue := newBlocksQueue(ctx, &blocksQueueConfig{
+		fetcher:             fetcher,
+		startSlot:           1,
+		highestExpectedSlot: 608,
+	})
+	queue.start()
+	defer queue.stop()
+
+	nextStart := uint64(1)
+	var received []uint64
+	for batch := range queue.batches {
+		if batch.start != nextStart {
+			t.Fatalf("Wanted batch starting at slot %d, got %d", nextStart, batch.start)
+		}
+		nextStart += batch.count
+		for _, blk := range batch.blocks {
+			received = append(received, blk.Block.Slot)
+		}
+	}
+	if ctx.Err() != nil {
+		t.Fatal("Queue did not deliver all batches in time")
+	}
+	if nextStart != 609 {
+		t.Errorf("Wanted batches up to slot %d, got up to %d", 608, nextStart-1)
+	}
+	want := append(makeSequence(1, 100), makeSequence(300, 608)...)
+	if len(received) != len(want) {
+		t.Fatalf("Wanted %d blocks, got %d", len(want), len(received))
+	}
+	for i := range want {
+		if received[i] != want[i] {
+			t.Fatalf("Wanted block at slot %d in position %d, got %d", want[i], i, received[i])
+		}
+	}
+}
+
+func TestBlocksQueue_StopClosesBatches(t *testing.T) {
+	initializeRootCache(makeSequence(1, 64), t)
+	p := p2pt.NewTestP2P(t)
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(10000, 10000, false /* deleteEmptyBuckets */),
+	})
+	// Without peers no batch can be fetched, so none is delivered before the queue stops.
+	queue := newBlocksQueue(context.Background(), &blocksQueueConfig{
+		fetcher:             fetcher,
+		startSlot:           1,
+		highestExpectedSlot: 64,
+	})
+	queue.start()
+	queue.stop()
+
+	select {
+	case _, ok := <-queue.batches:
+		if ok {
+			t.Error("Wanted no batch to be delivered")
+		}
+	case <-time.After(time.Second):
+		t.Error("Batches channel not closed after stopping the queue")
+	}
+}
+
+func TestBlocksQueue_LastBatchInNextEpoch(t *testing.T) {
+	finalizedEpoch := uint64(4)
+	// The last batch is the single slot starting the epoch after the finalized one, which
+	// the peers have not finalized.
+	highestSlot := helpers.StartSlot(finalizedEpoch + 1)
+	startSlot := highestSlot - 2*blockBatchSize
+	slots := makeSequence(1, highestSlot)
+	initializeRootCache(slots, t)
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{blocks: slots, finalizedEpoch: finalizedEpoch, headSlot: highestSlot},
+		{blocks: slots, finalizedEpoch: finalizedEpoch, headSlot: highestSlot},
+	}, p.Peers())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(10000, 10000, false /* deleteEmptyBuckets */),
+	})
+	queue := newBlocksQueue(ctx, &blocksQueueConfig{
+		fetcher:             fetcher,
+		startSlot:           startSlot,
+		highestExpectedSlot: highestSlot,
+		targetEpoch:         finalizedEpoch,
+	})
+	queue.start()
+	defer queue.stop()
+
+	nextStart := startSlot
+	for batch := range queue.batches {
+		nextStart += batch.count
+	}
+	if ctx.Err() != nil || queue.err != nil {
+		t.Fatalf("Queue did not deliver all batches, got up to slot %d: %v", nextStart-1, queue.err)
+	}
+	if nextStart != highestSlot+1 {
+		t.Errorf("Wanted batches up to slot %d, got up to %d", highestSlot, nextStart-1)
+	}
+}
+
+func TestBlocksQueue_GivesUpOnFailingBatches(t *testing.T) {
+	initializeRootCache(makeSequence(1, 64), t)
+	p := p2pt.NewTestP2P(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*maxBatchRetries*batchRetryDelay)
+	defer cancel()
+	fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: leakybucket.NewCollector(10000, 10000, false /* deleteEmptyBuckets */),
+	})
+	// Without peers every attempt fails, so the queue gives up after its retries.
+	queue := newBlocksQueue(ctx, &blocksQueueConfig{
+		fetcher:             fetcher,
+		startSlot:           1,
+		highestExpectedSlot: 64,
+	})
+	queue.start()
+	defer queue.stop()
+
+	for range queue.batches {
+		t.Error("Wanted no batch to be delivered")
+	}
+	if ctx.Err() != nil {
+		t.Fatal("Queue did not give up in time")
+	}
+	if queue.err == nil {
+		t.Error("Wanted the queue to record why it gave up")
+	}
+}
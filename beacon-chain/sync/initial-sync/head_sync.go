@@ -0,0 +1,58 @@
+package initialsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/paulbellamy/ratecounter"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// syncToHead syncs past the finalized epoch along the head advertised by the most peers,
+// until no peer advertises a head above ours or a round of syncing makes no progress. The
+// peers are selected again for every batch, so that a round stops requesting blocks from
+// peers which disconnected or were penalised, and gives up once none is left.
+func (s *Service) syncToHead(ctx context.Context, genesis time.Time, counter *ratecounter.RateCounter) error {
+	for {
+		headSlot := s.chain.HeadSlot()
+		currentSlot := helpers.SlotsSince(genesis)
+		if headSlot >= currentSlot {
+			return nil
+		}
+		root, slot, peers := s.p2p.Peers().BestHead(params.BeaconConfig().MaxPeersToSync, headSlot)
+		if len(peers) == 0 {
+			log.Debug("No peers advertising a head above ours")
+			return nil
+		}
+		log.WithFields(logrus.Fields{
+			"root":  fmt.Sprintf("%#x", root),
+			"slot":  slot,
+			"peers": len(peers),
+		}).Debug("Syncing to the head advertised by most peers")
+
+		fetcher := newBlocksFetcher(ctx, &blocksFetcherConfig{
+			p2p:         s.p2p,
+			rateLimiter: s.blocksRateLimiter,
+			peers: func(uint64) ([]byte, []peer.ID) {
+				root, _, peers := s.p2p.Peers().BestHead(params.BeaconConfig().MaxPeersToSync, headSlot)
+				return root, peers
+			},
+		})
+		// The peers are selected by their head rather than their finalized epoch.
+		if _, err := s.syncBatches(ctx, fetcher, genesis, 0 /* targetEpoch */, mathutil.Min(slot, currentSlot), counter); err != nil {
+			// Blocks past the finalized epoch may be on a fork which turns out to be invalid,
+			// the remaining blocks are left to regular sync.
+			log.WithError(err).Error("Failed to process block, exiting init sync")
+			return nil
+		}
+		if s.chain.HeadSlot() == headSlot {
+			log.WithField("slot", headSlot).Debug("No progress syncing to head")
+			return nil
+		}
+	}
+}
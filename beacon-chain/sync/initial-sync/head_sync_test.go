@@ -0,0 +1,100 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/paulbellamy/ratecounter"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+func TestSyncToHead_FollowsMostAdvertisedHead(t *testing.T) {
+	initializeRootCache(makeSequence(1, 96), t)
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{blocks: makeSequence(1, 96), finalizedEpoch: 1, headSlot: 96, headRoot: []byte("head a")},
+		{blocks: makeSequence(1, 96), finalizedEpoch: 1, headSlot: 96, headRoot: []byte("head a")},
+		{blocks: makeSequence(1, 96), finalizedEpoch: 1, headSlot: 96, headRoot: []byte("head a")},
+		// A peer advertising a higher head on a fork is outvoted.
+		{blocks: makeSequence(1, 120), finalizedEpoch: 1, headSlot: 120, headRoot: []byte("head b"), forkedPeer: true},
+	}, p.Peers())
+	s, mc, beaconDB := setupHeadSyncService(t, p)
+	defer dbtest.TeardownDB(t, beaconDB)
+
+	counter := ratecounter.NewRateCounter(counterSeconds * time.Second)
+	if err := s.syncToHead(context.Background(), makeGenesisTime(130), counter); err != nil {
+		t.Fatal(err)
+	}
+	if s.chain.HeadSlot() != 96 {
+		t.Errorf("Wanted head slot %d, got %d", 96, s.chain.HeadSlot())
+	}
+	if len(mc.BlocksReceived) != 96 {
+		t.Errorf("Wanted %d blocks processed, got %d", 96, len(mc.BlocksReceived))
+	}
+}
+
+func TestFetchAncestors(t *testing.T) {
+	initializeRootCache(makeSequence(1, 64), t)
+	p := p2pt.NewTestP2P(t)
+	connectPeers(t, p, []*peerData{
+		{blocks: makeSequence(1, 64), finalizedEpoch: 1, headSlot: 64},
+	}, p.Peers())
+	s, _, beaconDB := setupHeadSyncService(t, p)
+	defer dbtest.TeardownDB(t, beaconDB)
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		p2p:         p,
+		rateLimiter: s.blocksRateLimiter,
+	})
+	pid := p.Peers().Connected()[0]
+
+	ancestors, err := s.fetchAncestors(context.Background(), fetcher, makeBlock(10, false), pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ancestors) != 9 {
+		t.Fatalf("Wanted %d ancestors, got %d", 9, len(ancestors))
+	}
+	for i, blk := range ancestors {
+		if blk.Block.Slot != uint64(i+1) {
+			t.Errorf("Wanted ancestor at slot %d in position %d, got %d", i+1, i, blk.Block.Slot)
+		}
+	}
+
+	// Ancestors further than maxAncestorLookups blocks away are not fetched.
+	if _, err := s.fetchAncestors(context.Background(), fetcher, makeBlock(64, false), pid); err == nil {
+		t.Error("Expected error fetching too many ancestors")
+	}
+}
+
+func setupHeadSyncService(t *testing.T, p *p2pt.TestP2P) (*Service, *mock.ChainService, db.Database) {
+	beaconDB := dbtest.SetupDB(t)
+	if err := beaconDB.SaveBlock(context.Background(), &eth.SignedBeaconBlock{Block: &eth.BeaconBlock{Slot: 0}}); err != nil {
+		t.Fatal(err)
+	}
+	st, err := stateTrie.InitializeFromProto(&p2ppb.BeaconState{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot := rootCache[0]
+	mc := &mock.ChainService{
+		State: st,
+		Root:  genesisRoot[:],
+		DB:    beaconDB,
+	}
+	s := &Service{
+		chain:             mc,
+		p2p:               p,
+		db:                beaconDB,
+		chainStarted:      true,
+		blocksRateLimiter: leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksPerSecond, false /* deleteEmptyBuckets */),
+	}
+	return s, mc, beaconDB
+}
@@ -35,6 +35,7 @@ type peerData struct {
 	headSlot       uint64
 	failureSlots   []uint64 // slots at which the peer will return an error
 	forkedPeer     bool
+	headRoot       []byte
 }
 
 func init() {
@@ -293,6 +294,7 @@ func TestRoundRobinSync(t *testing.T) {
 // for each test peer.
 func connectPeers(t *testing.T, host *p2pt.TestP2P, data []*peerData, peerStatus *peers.Status) {
 	const topic = "/eth2/beacon_chain/req/beacon_blocks_by_range/1/ssz"
+	const rootTopic = "/eth2/beacon_chain/req/beacon_blocks_by_root/1/ssz"
 
 	for _, d := range data {
 		peer := p2pt.NewTestP2P(t)
@@ -308,7 +310,7 @@ func connectPeers(t *testing.T, host *p2pt.TestP2P, data []*peerData, peerStatus
 				t.Error(err)
 			}
 
-			requestedBlocks := makeSequence(req.StartSlot, req.StartSlot+(req.Count*req.Step))
+			requestedBlocks := makeSequence(req.StartSlot, req.StartSlot+((req.Count-1)*req.Step))
 
 			// Expected failure range
 			if len(sliceutil.IntersectionUint64(datum.failureSlots, requestedBlocks)) > 0 {
@@ -329,21 +331,7 @@ func connectPeers(t *testing.T, host *p2pt.TestP2P, data []*peerData, peerStatus
 				if (slot-req.StartSlot)%req.Step != 0 {
 					continue
 				}
-				parentRoot := rootCache[parentSlotCache[slot]]
-				blk := &eth.SignedBeaconBlock{
-					Block: &eth.BeaconBlock{
-						Slot:       slot,
-						ParentRoot: parentRoot[:],
-					},
-				}
-				// If forked peer, give a different parent root.
-				if datum.forkedPeer {
-					newRoot := hashutil.Hash(parentRoot[:])
-					blk.Block.ParentRoot = newRoot[:]
-				}
-				ret = append(ret, blk)
-				currRoot, _ := ssz.HashTreeRoot(blk.Block)
-				logrus.Infof("block with slot %d , signing root %#x and parent root %#x", slot, currRoot, parentRoot)
+				ret = append(ret, makeBlock(slot, datum.forkedPeer))
 			}
 
 			if uint64(len(ret)) > req.Count {
@@ -357,20 +345,62 @@ func connectPeers(t *testing.T, host *p2pt.TestP2P, data []*peerData, peerStatus
 			}
 		})
 
+		peer.SetStreamHandler(rootTopic, func(stream network.Stream) {
+			defer stream.Close()
+
+			roots := [][32]byte{}
+			if err := peer.Encoding().DecodeWithLength(stream, &roots); err != nil {
+				t.Error(err)
+			}
+			for _, root := range roots {
+				for _, slot := range datum.blocks {
+					if datum.forkedPeer || rootCache[slot] != root {
+						continue
+					}
+					if err := sync.WriteChunk(stream, peer.Encoding(), makeBlock(slot, false)); err != nil {
+						t.Error(err)
+					}
+				}
+			}
+		})
+
 		peer.Connect(host)
 
 		peerStatus.Add(peer.PeerID(), nil, network.DirOutbound)
 		peerStatus.SetConnectionState(peer.PeerID(), peers.PeerConnected)
+		headRoot := datum.headRoot
+		if headRoot == nil {
+			headRoot = []byte("head_root")
+		}
 		peerStatus.SetChainState(peer.PeerID(), &p2ppb.Status{
 			HeadForkVersion: params.BeaconConfig().GenesisForkVersion,
 			FinalizedRoot:   []byte(fmt.Sprintf("finalized_root %d", datum.finalizedEpoch)),
 			FinalizedEpoch:  datum.finalizedEpoch,
-			HeadRoot:        []byte("head_root"),
+			HeadRoot:        headRoot,
 			HeadSlot:        datum.headSlot,
 		})
 	}
 }
 
+// makeBlock at the given slot, building on the block at the previous slot of the root cache.
+// Blocks of a forked peer build on a different parent.
+func makeBlock(slot uint64, forked bool) *eth.SignedBeaconBlock {
+	parentRoot := rootCache[parentSlotCache[slot]]
+	blk := &eth.SignedBeaconBlock{
+		Block: &eth.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+		},
+	}
+	if forked {
+		newRoot := hashutil.Hash(parentRoot[:])
+		blk.Block.ParentRoot = newRoot[:]
+	}
+	currRoot, _ := ssz.HashTreeRoot(blk.Block)
+	logrus.Infof("block with slot %d , signing root %#x and parent root %#x", slot, currRoot, parentRoot)
+	return blk
+}
+
 // makeGenesisTime where now is the current slot.
 func makeGenesisTime(currentSlot uint64) time.Time {
 	return roughtime.Now().Add(-1 * time.Second * time.Duration(currentSlot) * time.Duration(params.BeaconConfig().SecondsPerSlot))
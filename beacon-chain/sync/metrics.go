@@ -27,6 +27,52 @@ var (
 		},
 		[]string{"topic"},
 	)
+	gossipDuplicateCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_gossip_duplicate_total",
+			Help: "Count of gossip messages ignored as they were already seen.",
+		},
+		[]string{"kind"},
+	)
+	rpcRateLimitedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_rpc_rate_limited_total",
+			Help: "Count of RPC requests refused for exceeding the rate limit budget of the peer.",
+		},
+		[]string{"topic", "budget"},
+	)
+	rpcBudgetUsage = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "p2p_rpc_budget_usage_ratio",
+			Help:    "Share of the rate limit budget of a peer in use after admitting an RPC request.",
+			Buckets: []float64{0.1, 0.25, 0.5, 0.75, 0.9, 1},
+		},
+		[]string{"topic", "budget"},
+	)
+	pendingBlocksCount = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "sync_pending_blocks",
+			Help: "The number of blocks waiting for their parent.",
+		},
+	)
+	pendingBlocksSize = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "sync_pending_blocks_bytes",
+			Help: "The total size of the blocks waiting for their parent.",
+		},
+	)
+	pendingBlocksRefusedCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "sync_pending_blocks_refused_total",
+			Help: "Count of blocks refused as they exceed the size of the pending blocks queue.",
+		},
+	)
+	pendingBlocksEvictedCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "sync_pending_blocks_evicted_total",
+			Help: "Count of pending blocks evicted as their parent could not be found or to make room for new blocks.",
+		},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -0,0 +1,200 @@
+package sync
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+const (
+	// maxPendingBlocksBytes is the maximum total size of the blocks waiting for their parent.
+	maxPendingBlocksBytes = 32 << 20
+	// maxParentLookups is the number of times the parent of a pending block is looked up
+	// without success before the block is evicted.
+	maxParentLookups = 5
+)
+
+// pendingBlock is a block waiting for its parent, along with its root and the number of
+// failed lookups of its parent.
+type pendingBlock struct {
+	root    [32]byte
+	block   *ethpb.SignedBeaconBlock
+	size    int
+	lookups int
+}
+
+// pendingBlocks stores the blocks received before their parent, keyed by root, so that
+// several blocks of the same slot, such as blocks on competing forks, are kept. The total
+// size of the blocks is capped: once full, room is made for new blocks by evicting the
+// blocks whose parent lookups failed the most, oldest first, so that orphan blocks whose
+// parent never resolves cannot hold the queue until finality moves past them.
+type pendingBlocks struct {
+	lock     sync.RWMutex
+	maxBytes int
+	bytes    int
+	blocks   map[[32]byte]*pendingBlock
+}
+
+func newPendingBlocks(maxBytes int) *pendingBlocks {
+	return &pendingBlocks{
+		maxBytes: maxBytes,
+		blocks:   make(map[[32]byte]*pendingBlock),
+	}
+}
+
+// add a block with the given root, evicting other blocks if needed, and returning false if
+// the block alone exceeds the size limit.
+func (p *pendingBlocks) add(root [32]byte, blk *ethpb.SignedBeaconBlock) bool {
+	if blk == nil || blk.Block == nil {
+		return false
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.blocks[root]; ok {
+		return true
+	}
+	size := proto.Size(blk)
+	if size > p.maxBytes {
+		pendingBlocksRefusedCounter.Inc()
+		return false
+	}
+	if p.bytes+size > p.maxBytes {
+		p.evictUnsafe(p.bytes + size - p.maxBytes)
+	}
+	p.blocks[root] = &pendingBlock{root: root, block: blk, size: size}
+	p.bytes += size
+	p.updateMetrics()
+	return true
+}
+
+// has returns whether the block with the given root is pending.
+func (p *pendingBlocks) has(root [32]byte) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	_, ok := p.blocks[root]
+	return ok
+}
+
+// remove the block with the given root.
+func (p *pendingBlocks) remove(root [32]byte) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.removeUnsafe(root)
+	p.updateMetrics()
+}
+
+func (p *pendingBlocks) removeUnsafe(root [32]byte) {
+	if b, ok := p.blocks[root]; ok {
+		p.bytes -= b.size
+		delete(p.blocks, root)
+	}
+}
+
+// parentLookupFailed records a failed lookup of the parent of the block with the given
+// root. Once the parent was looked up maxParentLookups times, the block is evicted along
+// with its descendants, and true is returned.
+func (p *pendingBlocks) parentLookupFailed(root [32]byte) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	b, ok := p.blocks[root]
+	if !ok {
+		return false
+	}
+	b.lookups++
+	if b.lookups < maxParentLookups {
+		return false
+	}
+	evicted := map[[32]byte]bool{root: true}
+	for _, b := range p.sortedUnsafe() {
+		if evicted[b.root] || evicted[bytesutil.ToBytes32(b.block.Block.ParentRoot)] {
+			evicted[b.root] = true
+			p.removeUnsafe(b.root)
+		}
+	}
+	pendingBlocksEvictedCounter.Add(float64(len(evicted)))
+	p.updateMetrics()
+	return true
+}
+
+// evictUnsafe removes blocks until at least size bytes are freed, starting with the blocks
+// whose parent lookups failed the most, and then the oldest ones.
+func (p *pendingBlocks) evictUnsafe(size int) {
+	blocks := p.sortedUnsafe()
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].lookups > blocks[j].lookups
+	})
+	freed := 0
+	for _, b := range blocks {
+		if freed >= size {
+			break
+		}
+		freed += b.size
+		p.removeUnsafe(b.root)
+		pendingBlocksEvictedCounter.Inc()
+	}
+}
+
+// sorted returns the pending blocks in slot order, so that parents come before their
+// children.
+func (p *pendingBlocks) sorted() []*pendingBlock {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.sortedUnsafe()
+}
+
+func (p *pendingBlocks) sortedUnsafe() []*pendingBlock {
+	blocks := make([]*pendingBlock, 0, len(p.blocks))
+	for _, b := range p.blocks {
+		blocks = append(blocks, b)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].block.Block.Slot != blocks[j].block.Block.Slot {
+			return blocks[i].block.Block.Slot < blocks[j].block.Block.Slot
+		}
+		return bytes.Compare(blocks[i].root[:], blocks[j].root[:]) < 0
+	})
+	return blocks
+}
+
+// prune removes the blocks before the given slot, as well as their descendants, which can
+// no longer become part of the canonical chain.
+func (p *pendingBlocks) prune(slot uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pruned := make(map[[32]byte]bool)
+	for _, b := range p.sortedUnsafe() {
+		if b.block.Block.Slot < slot || pruned[bytesutil.ToBytes32(b.block.Block.ParentRoot)] {
+			pruned[b.root] = true
+			p.removeUnsafe(b.root)
+		}
+	}
+	p.updateMetrics()
+}
+
+// clear removes all the pending blocks.
+func (p *pendingBlocks) clear() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.blocks = make(map[[32]byte]*pendingBlock)
+	p.bytes = 0
+	p.updateMetrics()
+}
+
+// len returns the number of pending blocks.
+func (p *pendingBlocks) len() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return len(p.blocks)
+}
+
+func (p *pendingBlocks) updateMetrics() {
+	pendingBlocksCount.Set(float64(len(p.blocks)))
+	pendingBlocksSize.Set(float64(p.bytes))
+}
@@ -3,12 +3,10 @@ package sync
 import (
 	"context"
 	"encoding/hex"
-	"sort"
+	"sync"
 	"time"
 
-	"github.com/pkg/errors"
-	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/go-ssz"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -21,6 +19,13 @@ import (
 
 var processPendingBlocksPeriod = time.Duration(params.BeaconConfig().SecondsPerSlot/3) * time.Second
 
+const (
+	// maxParentRootsPerRequest is the number of missing parents requested by root at once.
+	maxParentRootsPerRequest = 16
+	// parentRequestPeers is the number of peers the missing parents are requested from.
+	parentRequestPeers = 3
+)
+
 // processes pending blocks queue on every processPendingBlocksPeriod
 func (r *Service) processPendingBlocksQueue() {
 	ctx := context.Background()
@@ -35,66 +40,55 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 	defer span.End()
 
 	pids := r.p2p.Peers().Connected()
-	if err := r.validatePendingSlots(); err != nil {
-		return errors.Wrap(err, "could not validate pending slots")
-	}
-	slots := r.sortedPendingSlots()
+	r.prunePendingBlocks()
+	blocks := r.pendingBlocks.sorted()
 
 	span.AddAttributes(
-		trace.Int64Attribute("numSlots", int64(len(slots))),
+		trace.Int64Attribute("numBlocks", int64(len(blocks))),
 		trace.Int64Attribute("numPeers", int64(len(pids))),
 	)
 
-	for _, s := range slots {
-		ctx, span := trace.StartSpan(ctx, "processPendingBlocks.InnerLoop")
-		span.AddAttributes(trace.Int64Attribute("slot", int64(s)))
-
-		r.pendingQueueLock.RLock()
-		b := r.slotToPendingBlocks[uint64(s)]
-		// Skip if block does not exist.
-		if b == nil || b.Block == nil {
-			r.pendingQueueLock.RUnlock()
-			span.End()
+	var missingParents [][32]byte
+	requested := make(map[[32]byte]bool)
+	var lowestSlot uint64
+	for _, pending := range blocks {
+		// Skip the blocks evicted since the queue was read.
+		if !r.pendingBlocks.has(pending.root) {
 			continue
 		}
-		r.pendingQueueLock.RUnlock()
-		inPendingQueue := r.seenPendingBlocks[bytesutil.ToBytes32(b.Block.ParentRoot)]
-
-		inDB := r.db.HasBlock(ctx, bytesutil.ToBytes32(b.Block.ParentRoot))
-		hasPeer := len(pids) != 0
-
-		// Only request for missing parent block if it's not in DB, not in pending cache
-		// and has peer in the peer list.
-		if !inPendingQueue && !inDB && hasPeer {
-			log.WithFields(logrus.Fields{
-				"currentSlot": b.Block.Slot,
-				"parentRoot":  hex.EncodeToString(bytesutil.Trunc(b.Block.ParentRoot)),
-			}).Info("Requesting parent block")
-			req := [][32]byte{bytesutil.ToBytes32(b.Block.ParentRoot)}
-
-			// Start with a random peer to query, but choose the first peer in our unsorted list that claims to
-			// have a head slot newer than the block slot we are requesting.
-			pid := pids[rand.Int()%len(pids)]
-			for _, p := range pids {
-				if cs, _ := r.p2p.Peers().ChainState(p); cs != nil && cs.HeadSlot >= uint64(s) {
-					pid = p
-					break
-				}
+		b := pending.block
+		ctx, span := trace.StartSpan(ctx, "processPendingBlocks.InnerLoop")
+		span.AddAttributes(trace.Int64Attribute("slot", int64(b.Block.Slot)))
+
+		parentRoot := bytesutil.ToBytes32(b.Block.ParentRoot)
+		if !r.db.HasBlock(ctx, parentRoot) {
+			// Blocks whose parent could not be found after several requests are evicted, along
+			// with their descendants, as the parent may never resolve.
+			if !r.pendingBlocks.has(parentRoot) && r.pendingBlocks.parentLookupFailed(pending.root) {
+				log.WithFields(logrus.Fields{
+					"slot":       b.Block.Slot,
+					"parentRoot": hex.EncodeToString(bytesutil.Trunc(b.Block.ParentRoot)),
+				}).Debug("Evicted pending block as its parent could not be found")
+				span.End()
+				continue
 			}
-
-			if err := r.sendRecentBeaconBlocksRequest(ctx, req, pid); err != nil {
-				traceutil.AnnotateError(span, err)
-				log.Errorf("Could not send recent block request: %v", err)
+			// Only request the missing parent block if it is not pending itself, and was not
+			// requested already for another block.
+			if !r.pendingBlocks.has(parentRoot) && !requested[parentRoot] {
+				log.WithFields(logrus.Fields{
+					"currentSlot": b.Block.Slot,
+					"parentRoot":  hex.EncodeToString(bytesutil.Trunc(b.Block.ParentRoot)),
+				}).Info("Requesting parent block")
+				if len(missingParents) == 0 {
+					lowestSlot = b.Block.Slot
+				}
+				requested[parentRoot] = true
+				missingParents = append(missingParents, parentRoot)
 			}
 			span.End()
 			continue
 		}
 
-		if !inDB {
-			span.End()
-			continue
-		}
-
 		if err := r.chain.ReceiveBlockNoPubsub(ctx, b); err != nil {
 			log.Errorf("Could not process block from slot %d: %v", b.Block.Slot, err)
 			traceutil.AnnotateError(span, err)
@@ -105,80 +99,74 @@ func (r *Service) processPendingBlocks(ctx context.Context) error {
 			log.WithError(err).Error("Failed to broadcast block")
 		}
 
-		blkRoot, err := ssz.HashTreeRoot(b.Block)
-		if err != nil {
-			traceutil.AnnotateError(span, err)
-			span.End()
-			return err
-		}
-
-		r.pendingQueueLock.Lock()
-		delete(r.slotToPendingBlocks, uint64(s))
-		delete(r.seenPendingBlocks, blkRoot)
-		r.pendingQueueLock.Unlock()
+		r.pendingBlocks.remove(pending.root)
 
 		log.WithFields(logrus.Fields{
-			"slot":      s,
-			"blockRoot": hex.EncodeToString(bytesutil.Trunc(blkRoot[:])),
+			"slot":      b.Block.Slot,
+			"blockRoot": hex.EncodeToString(bytesutil.Trunc(pending.root[:])),
 		}).Info("Processed pending block and cleared it in cache")
 
 		span.End()
 	}
 
+	if len(missingParents) > 0 && len(pids) > 0 {
+		r.requestMissingParents(ctx, missingParents, lowestSlot, pids)
+	}
 	return nil
 }
 
-func (r *Service) sortedPendingSlots() []int {
-	r.pendingQueueLock.RLock()
-	defer r.pendingQueueLock.RUnlock()
+// requestMissingParents requests the missing parents of pending blocks by root, in batches
+// spread over up to parentRequestPeers peers. Peers which claim to have a head at or beyond
+// the lowest slot of the pending blocks are preferred.
+func (r *Service) requestMissingParents(ctx context.Context, roots [][32]byte, slot uint64, pids []peer.ID) {
+	ctx, span := trace.StartSpan(ctx, "requestMissingParents")
+	defer span.End()
 
-	slots := make([]int, 0, len(r.slotToPendingBlocks))
-	for s := range r.slotToPendingBlocks {
-		slots = append(slots, int(s))
+	var candidates []peer.ID
+	for _, pid := range pids {
+		if cs, _ := r.p2p.Peers().ChainState(pid); cs != nil && cs.HeadSlot >= slot {
+			candidates = append(candidates, pid)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = append(candidates, pids...)
+	}
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > parentRequestPeers {
+		candidates = candidates[:parentRequestPeers]
 	}
-	sort.Ints(slots)
-	return slots
-}
-
-// validatePendingSlots validates the pending blocks
-// by their slot. If they are before the current finalized
-// checkpoint, these blocks are removed from the queue.
-func (r *Service) validatePendingSlots() error {
-	r.pendingQueueLock.Lock()
-	defer r.pendingQueueLock.Unlock()
-	oldBlockRoots := make(map[[32]byte]bool)
 
-	finalizedEpoch := r.chain.FinalizedCheckpt().Epoch
-	for s, b := range r.slotToPendingBlocks {
-		epoch := helpers.SlotToEpoch(s)
-		// remove all descendant blocks of old blocks
-		if oldBlockRoots[bytesutil.ToBytes32(b.Block.ParentRoot)] {
-			root, err := ssz.HashTreeRoot(b.Block)
-			if err != nil {
-				return err
-			}
-			oldBlockRoots[root] = true
-			delete(r.slotToPendingBlocks, s)
-			delete(r.seenPendingBlocks, root)
-			continue
+	var wg sync.WaitGroup
+	for i := 0; i*maxParentRootsPerRequest < len(roots); i++ {
+		batch := roots[i*maxParentRootsPerRequest:]
+		if len(batch) > maxParentRootsPerRequest {
+			batch = batch[:maxParentRootsPerRequest]
 		}
-		// don't process old blocks
-		if finalizedEpoch > 0 && epoch <= finalizedEpoch {
-			blkRoot, err := ssz.HashTreeRoot(b.Block)
-			if err != nil {
-				return err
+		pid := candidates[i%len(candidates)]
+		wg.Add(1)
+		go func(batch [][32]byte, pid peer.ID) {
+			defer wg.Done()
+			if err := r.sendRecentBeaconBlocksRequest(ctx, batch, pid); err != nil {
+				traceutil.AnnotateError(span, err)
+				log.WithField("peer", pid).Errorf("Could not send recent block request: %v", err)
 			}
-			oldBlockRoots[blkRoot] = true
-			delete(r.slotToPendingBlocks, s)
-			delete(r.seenPendingBlocks, blkRoot)
-		}
+		}(batch, pid)
 	}
-	return nil
+	wg.Wait()
+}
+
+// prunePendingBlocks removes the pending blocks which are before the current finalized
+// checkpoint, as well as their descendants.
+func (r *Service) prunePendingBlocks() {
+	finalizedEpoch := r.chain.FinalizedCheckpt().Epoch
+	if finalizedEpoch == 0 {
+		return
+	}
+	r.pendingBlocks.prune(helpers.StartSlot(finalizedEpoch + 1))
 }
 
 func (r *Service) clearPendingSlots() {
-	r.pendingQueueLock.Lock()
-	defer r.pendingQueueLock.Unlock()
-	r.slotToPendingBlocks = make(map[uint64]*ethpb.SignedBeaconBlock)
-	r.seenPendingBlocks = make(map[[32]byte]bool)
+	r.pendingBlocks.clear()
 }
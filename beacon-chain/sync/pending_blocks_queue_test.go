@@ -38,8 +38,7 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 				Epoch: 0,
 			},
 		},
-		slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:   make(map[[32]byte]bool),
+		pendingBlocks: newPendingBlocks(maxPendingBlocksBytes),
 	}
 
 	b0 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{}}
@@ -55,36 +54,28 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks1(t *testing.T) {
 	b1 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, ParentRoot: b0Root[:]}}
 	b1Root, _ := ssz.HashTreeRoot(b1.Block)
 	b2 := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 2, ParentRoot: b1Root[:]}}
-	b2Root, _ := ssz.HashTreeRoot(b1.Block)
+	b2Root, _ := ssz.HashTreeRoot(b2.Block)
 
 	// Add b2 to the cache
-	r.slotToPendingBlocks[b2.Block.Slot] = b2
-	r.seenPendingBlocks[b2Root] = true
+	r.pendingBlocks.add(b2Root, b2)
 
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 1 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 
 	// Add b1 to the cache
-	r.slotToPendingBlocks[b1.Block.Slot] = b1
-	r.seenPendingBlocks[b1Root] = true
+	r.pendingBlocks.add(b1Root, b1)
 	if err := r.db.SaveBlock(context.Background(), b1); err != nil {
 		t.Fatal(err)
 	}
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 0 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 }
 
@@ -126,8 +117,8 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 0,
 			},
-		}, slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks: make(map[[32]byte]bool),
+		},
+		pendingBlocks: newPendingBlocks(maxPendingBlocksBytes),
 	}
 	p1.Peers().Add(p2.PeerID(), nil, network.DirOutbound)
 	p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
@@ -154,40 +145,30 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 	b4 := &ethpb.BeaconBlock{Slot: 4, ParentRoot: b3Root[:]}
 	b4Root, _ := ssz.HashTreeRoot(b4)
 
-	r.slotToPendingBlocks[b4.Slot] = &ethpb.SignedBeaconBlock{Block: b4}
-	r.seenPendingBlocks[b4Root] = true
-	r.slotToPendingBlocks[b5.Slot] = &ethpb.SignedBeaconBlock{Block: b5}
-	r.seenPendingBlocks[b5Root] = true
+	r.pendingBlocks.add(b4Root, &ethpb.SignedBeaconBlock{Block: b4})
+	r.pendingBlocks.add(b5Root, &ethpb.SignedBeaconBlock{Block: b5})
 
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 2 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 2 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 2 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 
 	// Add b3 to the cache
-	r.slotToPendingBlocks[b3.Slot] = &ethpb.SignedBeaconBlock{Block: b3}
-	r.seenPendingBlocks[b3Root] = true
+	r.pendingBlocks.add(b3Root, &ethpb.SignedBeaconBlock{Block: b3})
 	if err := r.db.SaveBlock(context.Background(), &ethpb.SignedBeaconBlock{Block: b3}); err != nil {
 		t.Fatal(err)
 	}
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 1 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 1 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 
 	// Add b2 to the cache
-	r.slotToPendingBlocks[b2.Slot] = &ethpb.SignedBeaconBlock{Block: b2}
-	r.seenPendingBlocks[b2Root] = true
+	r.pendingBlocks.add(b2Root, &ethpb.SignedBeaconBlock{Block: b2})
 
 	if err := r.db.SaveBlock(context.Background(), &ethpb.SignedBeaconBlock{Block: b2}); err != nil {
 		t.Fatal(err)
@@ -195,12 +176,8 @@ func TestRegularSyncBeaconBlockSubscriber_ProcessPendingBlocks2(t *testing.T) {
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	t.Log(r.seenPendingBlocks)
-	if len(r.seenPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 0 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 }
 
@@ -221,8 +198,8 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 			FinalizedCheckPoint: &ethpb.Checkpoint{
 				Epoch: 1,
 			},
-		}, slotToPendingBlocks: make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks: make(map[[32]byte]bool),
+		},
+		pendingBlocks: newPendingBlocks(maxPendingBlocksBytes),
 	}
 	p1.Peers().Add(p1.PeerID(), nil, network.DirOutbound)
 	p1.Peers().SetConnectionState(p1.PeerID(), peers.PeerConnected)
@@ -249,22 +226,15 @@ func TestRegularSyncBeaconBlockSubscriber_PruneOldPendingBlocks(t *testing.T) {
 	b4 := &ethpb.BeaconBlock{Slot: 4, ParentRoot: b3Root[:]}
 	b4Root, _ := ssz.HashTreeRoot(b4)
 
-	r.slotToPendingBlocks[b2.Slot] = &ethpb.SignedBeaconBlock{Block: b2}
-	r.seenPendingBlocks[b2Root] = true
-	r.slotToPendingBlocks[b3.Slot] = &ethpb.SignedBeaconBlock{Block: b3}
-	r.seenPendingBlocks[b3Root] = true
-	r.slotToPendingBlocks[b4.Slot] = &ethpb.SignedBeaconBlock{Block: b4}
-	r.seenPendingBlocks[b4Root] = true
-	r.slotToPendingBlocks[b5.Slot] = &ethpb.SignedBeaconBlock{Block: b5}
-	r.seenPendingBlocks[b5Root] = true
+	r.pendingBlocks.add(b2Root, &ethpb.SignedBeaconBlock{Block: b2})
+	r.pendingBlocks.add(b3Root, &ethpb.SignedBeaconBlock{Block: b3})
+	r.pendingBlocks.add(b4Root, &ethpb.SignedBeaconBlock{Block: b4})
+	r.pendingBlocks.add(b5Root, &ethpb.SignedBeaconBlock{Block: b5})
 
 	if err := r.processPendingBlocks(context.Background()); err != nil {
 		t.Fatal(err)
 	}
-	if len(r.slotToPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for slot to pending blocks cache: got %d", len(r.slotToPendingBlocks))
-	}
-	if len(r.seenPendingBlocks) != 0 {
-		t.Errorf("Incorrect size for seen pending block: got %d", len(r.seenPendingBlocks))
+	if r.pendingBlocks.len() != 0 {
+		t.Errorf("Incorrect size for pending blocks cache: got %d", r.pendingBlocks.len())
 	}
 }
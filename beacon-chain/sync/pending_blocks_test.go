@@ -0,0 +1,159 @@
+package sync
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+)
+
+func pendingBlockAt(t *testing.T, slot uint64, parentRoot []byte, graffiti string) ([32]byte, *ethpb.SignedBeaconBlock) {
+	blk := &ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot,
+			Body:       &ethpb.BeaconBlockBody{Graffiti: []byte(graffiti)},
+		},
+	}
+	root, err := ssz.HashTreeRoot(blk.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return root, blk
+}
+
+func TestPendingBlocks_MultipleBlocksPerSlot(t *testing.T) {
+	p := newPendingBlocks(maxPendingBlocksBytes)
+	rootA, blkA := pendingBlockAt(t, 5, []byte("parent"), "a")
+	rootB, blkB := pendingBlockAt(t, 5, []byte("parent"), "b")
+	rootC, blkC := pendingBlockAt(t, 3, []byte("parent"), "c")
+	for root, blk := range map[[32]byte]*ethpb.SignedBeaconBlock{rootA: blkA, rootB: blkB, rootC: blkC} {
+		if !p.add(root, blk) {
+			t.Fatalf("Could not add block at slot %d", blk.Block.Slot)
+		}
+	}
+	if p.len() != 3 {
+		t.Fatalf("Wanted %d pending blocks, got %d", 3, p.len())
+	}
+	sorted := p.sorted()
+	if sorted[0].root != rootC {
+		t.Error("Wanted block with the lowest slot first")
+	}
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].block.Block.Slot < sorted[i-1].block.Block.Slot {
+			t.Errorf("Pending blocks not sorted by slot: %d after %d", sorted[i].block.Block.Slot, sorted[i-1].block.Block.Slot)
+		}
+	}
+
+	p.remove(rootA)
+	if p.has(rootA) || !p.has(rootB) {
+		t.Error("Wanted only the removed block of the slot to be removed")
+	}
+}
+
+func TestPendingBlocks_EvictsBlocksOverLimit(t *testing.T) {
+	rootA, blkA := pendingBlockAt(t, 1, []byte("parent"), "a")
+	rootB, blkB := pendingBlockAt(t, 2, []byte("parent"), "b")
+	p := newPendingBlocks(proto.Size(blkA) + proto.Size(blkB) - 1)
+	if !p.add(rootA, blkA) {
+		t.Fatal("Could not add first block")
+	}
+	if !p.add(rootB, blkB) {
+		t.Fatal("Could not add block over the size limit")
+	}
+	if p.has(rootA) || !p.has(rootB) {
+		t.Error("Wanted the oldest block to be evicted")
+	}
+	if p.bytes != proto.Size(blkB) {
+		t.Errorf("Wanted %d bytes of pending blocks, got %d", proto.Size(blkB), p.bytes)
+	}
+
+	_, large := pendingBlockAt(t, 3, []byte("parent"), string(make([]byte, p.maxBytes)))
+	if p.add([32]byte{'l'}, large) {
+		t.Error("Wanted block larger than the size limit to be refused")
+	}
+	if !p.has(rootB) {
+		t.Error("Wanted queued block to be kept when refusing a block")
+	}
+}
+
+func TestPendingBlocks_AcceptsBlocksWhenFullOfUnresolvableBlocks(t *testing.T) {
+	// Fill the queue with blocks whose parent is unknown.
+	var junk [][32]byte
+	p := newPendingBlocks(maxPendingBlocksBytes)
+	for i := 0; i < 64; i++ {
+		root, blk := pendingBlockAt(t, 100, []byte("unknown"), fmt.Sprintf("junk %02d", i))
+		if !p.add(root, blk) {
+			t.Fatal("Could not add block")
+		}
+		junk = append(junk, root)
+	}
+	p.maxBytes = p.bytes
+	// The parents of the queued blocks keep failing to resolve.
+	for _, root := range junk {
+		for i := 0; i < maxParentLookups-1; i++ {
+			p.parentLookupFailed(root)
+		}
+	}
+
+	root, blk := pendingBlockAt(t, 200, []byte("parent"), "real 01")
+	if !p.add(root, blk) || !p.has(root) {
+		t.Fatal("Wanted block to be accepted once the queue is full of unresolvable blocks")
+	}
+	if p.len() != len(junk) {
+		t.Errorf("Wanted a single block to be evicted, got %d pending blocks", p.len())
+	}
+	if p.bytes > p.maxBytes {
+		t.Errorf("Pending blocks exceed size limit: %d > %d", p.bytes, p.maxBytes)
+	}
+
+	// Blocks with failed parent lookups are evicted before newer blocks.
+	otherRoot, otherBlk := pendingBlockAt(t, 200, []byte("parent"), "real 02")
+	if !p.add(otherRoot, otherBlk) || !p.has(root) || p.len() != len(junk) {
+		t.Error("Wanted a block with failed parent lookups to be evicted rather than a new block")
+	}
+}
+
+func TestPendingBlocks_ParentLookupFailedEvictsDescendants(t *testing.T) {
+	p := newPendingBlocks(maxPendingBlocksBytes)
+	orphanRoot, orphanBlk := pendingBlockAt(t, 10, []byte("unknown"), "")
+	childRoot, childBlk := pendingBlockAt(t, 11, orphanRoot[:], "")
+	otherRoot, otherBlk := pendingBlockAt(t, 10, []byte("other"), "")
+	p.add(orphanRoot, orphanBlk)
+	p.add(childRoot, childBlk)
+	p.add(otherRoot, otherBlk)
+
+	for i := 0; i < maxParentLookups-1; i++ {
+		if p.parentLookupFailed(orphanRoot) {
+			t.Fatalf("Block evicted after %d failed parent lookups", i+1)
+		}
+	}
+	if !p.parentLookupFailed(orphanRoot) {
+		t.Fatalf("Wanted block to be evicted after %d failed parent lookups", maxParentLookups)
+	}
+	if p.len() != 1 || !p.has(otherRoot) {
+		t.Errorf("Wanted only the block not descending from the evicted block to be kept, got %d blocks", p.len())
+	}
+}
+
+func TestPendingBlocks_PruneRemovesDescendants(t *testing.T) {
+	p := newPendingBlocks(maxPendingBlocksBytes)
+	oldRoot, oldBlk := pendingBlockAt(t, 10, []byte("parent"), "")
+	childRoot, childBlk := pendingBlockAt(t, 70, oldRoot[:], "")
+	grandchildRoot, grandchildBlk := pendingBlockAt(t, 71, childRoot[:], "")
+	otherRoot, otherBlk := pendingBlockAt(t, 70, []byte("other"), "")
+	p.add(oldRoot, oldBlk)
+	p.add(childRoot, childBlk)
+	p.add(grandchildRoot, grandchildBlk)
+	p.add(otherRoot, otherBlk)
+
+	p.prune(64)
+	if p.len() != 1 || !p.has(otherRoot) {
+		t.Errorf("Wanted only the block not descending from a pruned block to be kept, got %d blocks", p.len())
+	}
+	if p.bytes != proto.Size(otherBlk) {
+		t.Errorf("Wanted %d bytes of pending blocks, got %d", proto.Size(otherBlk), p.bytes)
+	}
+}
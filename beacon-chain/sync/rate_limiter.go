@@ -0,0 +1,129 @@
+package sync
+
+import (
+	"errors"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+)
+
+const (
+	requestsBudget = "requests"
+	blocksBudget   = "blocks"
+)
+
+var errRateLimited = errors.New(rateLimitedError)
+
+// RateLimits are the budgets of the requests a peer may make to the node. Every budget
+// is a token bucket which refills at a steady rate up to its burst capacity.
+type RateLimits struct {
+	// RequestsPerSecond is the rate at which the request budget of a peer refills, for
+	// each RPC topic.
+	RequestsPerSecond float64
+	// RequestsBurst is the number of requests a peer may make at once on an RPC topic.
+	RequestsBurst int64
+	// BlocksPerSecond is the rate at which the budget of blocks a peer may request
+	// refills, shared by all the block RPC topics.
+	BlocksPerSecond float64
+	// BlocksBurst is the number of blocks a peer may request at once.
+	BlocksBurst int64
+}
+
+// DefaultRateLimits returns the rate limits used when none are configured.
+func DefaultRateLimits() *RateLimits {
+	return &RateLimits{
+		RequestsPerSecond: 5,
+		RequestsBurst:     50,
+		BlocksPerSecond:   allowedBlocksPerSecond,
+		BlocksBurst:       allowedBlocksBurst,
+	}
+}
+
+// rateLimiter tracks the budgets of every peer, with a separate request budget for
+// each topic of p2p.RPCTopicMappings and a budget for the volume of blocks served.
+type rateLimiter struct {
+	limits   *RateLimits
+	requests map[string]*leakybucket.Collector
+	blocks   *leakybucket.Collector
+}
+
+func newRateLimiter(limits *RateLimits) *rateLimiter {
+	if limits == nil {
+		limits = DefaultRateLimits()
+	}
+	l := &rateLimiter{
+		limits:   limits,
+		requests: make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings)),
+		blocks:   leakybucket.NewCollector(limits.BlocksPerSecond, limits.BlocksBurst, false /* deleteEmptyBuckets */),
+	}
+	for topic := range p2p.RPCTopicMappings {
+		l.requests[topic] = leakybucket.NewCollector(limits.RequestsPerSecond, limits.RequestsBurst, false /* deleteEmptyBuckets */)
+	}
+	return l
+}
+
+// validateRequest takes a request of the peer on the given topic out of its budget, or
+// returns errRateLimited if the budget is exhausted. Topics outside of
+// p2p.RPCTopicMappings are not limited.
+func (l *rateLimiter) validateRequest(topic string, pid peer.ID) error {
+	collector, ok := l.requests[topic]
+	if !ok {
+		return nil
+	}
+	return take(collector, topic, requestsBudget, pid, 1, l.limits.RequestsBurst)
+}
+
+// validateBlocks takes the given number of requested blocks out of the budget of the
+// peer, or returns errRateLimited if the budget does not cover them.
+func (l *rateLimiter) validateBlocks(topic string, pid peer.ID, count uint64) error {
+	// The count is controlled by the peer, so it is bounded before it is converted to the
+	// signed amounts of the bucket, where a large count would become negative.
+	if count > uint64(l.limits.BlocksBurst) {
+		rpcRateLimitedCounter.WithLabelValues(topic, blocksBudget).Inc()
+		return errRateLimited
+	}
+	return take(l.blocks, topic, blocksBudget, pid, int64(count), l.limits.BlocksBurst)
+}
+
+// remainingBlocks returns the number of blocks the peer may still request.
+func (l *rateLimiter) remainingBlocks(pid peer.ID) int64 {
+	return l.blocks.Remaining(pid.String())
+}
+
+func take(collector *leakybucket.Collector, topic string, budget string, pid peer.ID, amount int64, capacity int64) error {
+	key := pid.String()
+	if amount > collector.Remaining(key) {
+		rpcRateLimitedCounter.WithLabelValues(topic, budget).Inc()
+		return errRateLimited
+	}
+	collector.Add(key, amount)
+	if capacity > 0 {
+		rpcBudgetUsage.WithLabelValues(topic, budget).Observe(1 - float64(collector.Remaining(key))/float64(capacity))
+	}
+	return nil
+}
+
+// rejectRateLimited responds to a request which exceeded the budget of the peer, and
+// counts it against the peer's score.
+func (r *Service) rejectRateLimited(stream network.Stream) {
+	pid := stream.Conn().RemotePeer()
+	r.p2p.Peers().IncrementBadResponses(pid)
+	if r.p2p.Peers().IsBad(pid) {
+		log.WithField("peer", pid).Debug("Disconnecting bad peer")
+		defer func() {
+			if err := r.p2p.Disconnect(pid); err != nil {
+				log.WithError(err).Error("Failed to disconnect peer")
+			}
+		}()
+	}
+	resp, err := generateErrorResponse(streamEncoding(stream), responseCodeInvalidRequest, rateLimitedError)
+	if err != nil {
+		log.WithError(err).Error("Failed to generate a response error")
+		return
+	}
+	if _, err := stream.Write(resp); err != nil {
+		log.WithError(err).Errorf("Failed to write to stream")
+	}
+}
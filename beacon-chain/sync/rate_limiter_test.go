@@ -0,0 +1,189 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// testRateLimits are budgets large enough to never limit the requests made in tests.
+func testRateLimits() *RateLimits {
+	return &RateLimits{
+		RequestsPerSecond: 10000,
+		RequestsBurst:     10000,
+		BlocksPerSecond:   10000,
+		BlocksBurst:       10000,
+	}
+}
+
+func TestRateLimiter_ValidateRequest(t *testing.T) {
+	l := newRateLimiter(&RateLimits{
+		RequestsPerSecond: 0.01,
+		RequestsBurst:     2,
+		BlocksPerSecond:   0.01,
+		BlocksBurst:       10,
+	})
+	pid := peer.ID("a")
+	topic := "/eth2/beacon_chain/req/status/1"
+
+	for i := 0; i < 2; i++ {
+		if err := l.validateRequest(topic, pid); err != nil {
+			t.Fatalf("Unexpected error for request %d: %v", i, err)
+		}
+	}
+	if err := l.validateRequest(topic, pid); err != errRateLimited {
+		t.Errorf("Wanted error %v, got %v", errRateLimited, err)
+	}
+	// Budgets are separate for every topic and peer.
+	if err := l.validateRequest("/eth2/beacon_chain/req/ping/1", pid); err != nil {
+		t.Errorf("Unexpected error for another topic: %v", err)
+	}
+	if err := l.validateRequest(topic, peer.ID("b")); err != nil {
+		t.Errorf("Unexpected error for another peer: %v", err)
+	}
+	// Topics outside of the RPC topic mappings are not limited.
+	for i := 0; i < 5; i++ {
+		if err := l.validateRequest("/testing/foobar/1", pid); err != nil {
+			t.Fatalf("Unexpected error for an unknown topic: %v", err)
+		}
+	}
+}
+
+func TestRateLimiter_ValidateBlocks(t *testing.T) {
+	l := newRateLimiter(&RateLimits{
+		RequestsPerSecond: 0.01,
+		RequestsBurst:     2,
+		BlocksPerSecond:   0.01,
+		BlocksBurst:       10,
+	})
+	pid := peer.ID("a")
+
+	if err := l.validateBlocks(blocksByRangeTopic, pid, 8); err != nil {
+		t.Fatal(err)
+	}
+	if l.remainingBlocks(pid) != 2 {
+		t.Errorf("Wanted %d remaining blocks, got %d", 2, l.remainingBlocks(pid))
+	}
+	// The blocks budget is shared by the block topics.
+	if err := l.validateBlocks(blocksByRootTopic, pid, 3); err != errRateLimited {
+		t.Errorf("Wanted error %v, got %v", errRateLimited, err)
+	}
+	if l.remainingBlocks(pid) != 2 {
+		t.Errorf("Rejected request took %d blocks out of the budget", 2-l.remainingBlocks(pid))
+	}
+}
+
+func TestRateLimiter_ValidateBlocksRejectsOverflowingCount(t *testing.T) {
+	l := newRateLimiter(&RateLimits{
+		RequestsPerSecond: 0.01,
+		RequestsBurst:     2,
+		BlocksPerSecond:   0.01,
+		BlocksBurst:       10,
+	})
+	pid := peer.ID("a")
+
+	if err := l.validateBlocks(blocksByRangeTopic, pid, 8); err != nil {
+		t.Fatal(err)
+	}
+	// A count which is negative as a signed integer must not refill the budget.
+	if err := l.validateBlocks(blocksByRangeTopic, pid, 1<<63+1); err != errRateLimited {
+		t.Errorf("Wanted error %v, got %v", errRateLimited, err)
+	}
+	if l.remainingBlocks(pid) != 2 {
+		t.Errorf("Wanted %d remaining blocks, got %d", 2, l.remainingBlocks(pid))
+	}
+}
+
+func TestBeaconBlocksRPCHandler_RejectsOverflowingCount(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := &Service{p2p: p1, rateLimiter: newRateLimiter(testRateLimits())}
+	pcl := protocol.ID("/testing")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.Host.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		code, errMsg, err := ReadStatusCode(stream, p2.Encoding())
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if code != responseCodeInvalidRequest || errMsg != rateLimitedError {
+			t.Errorf("Wanted response code %d with message %q, got %d with %q", responseCodeInvalidRequest, rateLimitedError, code, errMsg)
+		}
+	})
+	stream, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), pcl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The end slot of the request wraps around to just before its start slot.
+	req := &pb.BeaconBlocksByRangeRequest{StartSlot: 100, Step: 2, Count: 1<<63 + 1}
+	before := r.rateLimiter.remainingBlocks(p2.Host.ID())
+	if err := r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream); err != errRateLimited {
+		t.Errorf("Wanted error %v, got %v", errRateLimited, err)
+	}
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+	if after := r.rateLimiter.remainingBlocks(p2.Host.ID()); after != before {
+		t.Errorf("Wanted %d remaining blocks, got %d", before, after)
+	}
+}
+
+func TestRegisterRPC_RejectsRateLimitedRequests(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := &Service{
+		ctx: context.Background(),
+		p2p: p1,
+		rateLimiter: newRateLimiter(&RateLimits{
+			RequestsPerSecond: 0.01,
+			RequestsBurst:     1,
+			BlocksPerSecond:   0.01,
+			BlocksBurst:       1,
+		}),
+	}
+	handler := func(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+		_, err := stream.Write([]byte{responseCodeSuccess})
+		return err
+	}
+	r.registerRPC("/eth2/beacon_chain/req/ping/1", &pb.Ping{}, handler)
+
+	stream, err := p2.Send(context.Background(), &pb.Ping{SeqNumber: 1}, p1.Host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSuccess(t, r, stream)
+
+	stream, err = p2.Send(context.Background(), &pb.Ping{SeqNumber: 1}, p1.Host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, errMsg, err := ReadStatusCode(stream, p2.Encoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != responseCodeInvalidRequest || errMsg != rateLimitedError {
+		t.Errorf("Wanted response code %d with message %q, got %d with %q", responseCodeInvalidRequest, rateLimitedError, code, errMsg)
+	}
+	badResponses, err := p1.Peers().BadResponses(p2.Host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if badResponses != 1 {
+		t.Errorf("Wanted %d bad response, got %d", 1, badResponses)
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
@@ -23,6 +25,9 @@ const ttfbTimeout = 5 * time.Second
 // be 1048576 bytes or 1 MiB.
 const maxChunkSize = 1 << 20
 
+const blocksByRangeTopic = "/eth2/beacon_chain/req/beacon_blocks_by_range/1"
+const blocksByRootTopic = "/eth2/beacon_chain/req/beacon_blocks_by_root/1"
+
 // rpcHandler is responsible for handling and responding to any incoming message.
 // This method may return an error to internal monitoring, but the error will
 // not be relayed to the peer.
@@ -41,20 +46,40 @@ func (r *Service) registerRPCHandlers() {
 		r.goodbyeRPCHandler,
 	)
 	r.registerRPC(
-		"/eth2/beacon_chain/req/beacon_blocks_by_range/1",
+		blocksByRangeTopic,
 		&pb.BeaconBlocksByRangeRequest{},
 		r.beaconBlocksByRangeRPCHandler,
 	)
 	r.registerRPC(
-		"/eth2/beacon_chain/req/beacon_blocks_by_root/1",
+		blocksByRootTopic,
 		[][32]byte{},
 		r.beaconBlocksRootRPCHandler,
 	)
+	r.registerRPC(
+		"/eth2/beacon_chain/req/ping/1",
+		&pb.Ping{},
+		r.pingHandler,
+	)
+	r.registerRPC(
+		p2p.RPCMetaDataTopic,
+		new(interface{}),
+		r.metaDataHandler,
+	)
 }
 
-// registerRPC for a given topic with an expected protobuf message type.
-func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler) {
-	topic += r.p2p.Encoding().ProtocolSuffix()
+// registerRPC for a given topic with an expected protobuf message type. The topic is
+// registered for each of the req/resp encodings, so that peers can negotiate the
+// encoding of each stream.
+func (r *Service) registerRPC(baseTopic string, base interface{}, handle rpcHandler) {
+	for _, suffix := range encoder.RPCProtocolSuffixes {
+		r.registerRPCProtocol(baseTopic, baseTopic+suffix, base, handle)
+	}
+}
+
+// registerRPCProtocol registers the handler of a topic for the protocol ID of one of
+// its encodings.
+func (r *Service) registerRPCProtocol(baseTopic string, topic string, base interface{}, handle rpcHandler) {
+	encoding := encoder.ForProtocol(topic)
 	log := log.WithField("topic", topic)
 	r.p2p.SetStreamHandler(topic, func(stream network.Stream) {
 		ctx, cancel := context.WithTimeout(context.Background(), ttfbTimeout)
@@ -74,13 +99,30 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 		// Increment message received counter.
 		messageReceivedCounter.WithLabelValues(topic).Inc()
 
+		if err := r.rateLimiter.validateRequest(baseTopic, stream.Conn().RemotePeer()); err != nil {
+			log.Debug("Rejecting rate limited request")
+			r.rejectRateLimited(stream)
+			traceutil.AnnotateError(span, err)
+			return
+		}
+
+		// Metadata requests have no request body to decode.
+		if baseTopic == p2p.RPCMetaDataTopic {
+			if err := handle(ctx, nil, stream); err != nil {
+				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
+				log.WithError(err).Error("Failed to handle p2p RPC")
+				traceutil.AnnotateError(span, err)
+			}
+			return
+		}
+
 		// Given we have an input argument that can be pointer or [][32]byte, this gives us
 		// a way to check for its reflect.Kind and based on the result, we can decode
 		// accordingly.
 		t := reflect.TypeOf(base)
 		if t.Kind() == reflect.Ptr {
 			msg := reflect.New(t.Elem())
-			if err := r.p2p.Encoding().DecodeWithLength(stream, msg.Interface()); err != nil {
+			if err := encoding.DecodeWithLength(stream, msg.Interface()); err != nil {
 				log.WithError(err).Error("Failed to decode stream message")
 				traceutil.AnnotateError(span, err)
 				return
@@ -94,7 +136,7 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 			}
 		} else {
 			msg := reflect.New(t)
-			if err := r.p2p.Encoding().DecodeWithLength(stream, msg.Interface()); err != nil {
+			if err := encoding.DecodeWithLength(stream, msg.Interface()); err != nil {
 				log.WithError(err).Error("Failed to decode stream message")
 				traceutil.AnnotateError(span, err)
 				return
@@ -110,3 +152,8 @@ func (r *Service) registerRPC(topic string, base interface{}, handle rpcHandler)
 
 	})
 }
+
+// streamEncoding returns the encoding negotiated for a req/resp stream.
+func streamEncoding(stream network.Stream) encoder.NetworkEncoding {
+	return encoder.ForProtocol(string(stream.Protocol()))
+}
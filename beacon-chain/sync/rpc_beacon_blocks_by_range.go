@@ -27,7 +27,7 @@ func (r *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 
 	startSlot := m.StartSlot
 	endSlot := startSlot + (m.Step * (m.Count - 1))
-	remainingBucketCapacity := r.blocksRateLimiter.Remaining(stream.Conn().RemotePeer().String())
+	remainingBucketCapacity := r.rateLimiter.remainingBlocks(stream.Conn().RemotePeer())
 
 	span.AddAttributes(
 		trace.Int64Attribute("start", int64(startSlot)),
@@ -38,28 +38,17 @@ func (r *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 		trace.Int64Attribute("remaining_capacity", remainingBucketCapacity),
 	)
 
-	if m.Count > uint64(remainingBucketCapacity) {
-		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
-		if r.p2p.Peers().IsBad(stream.Conn().RemotePeer()) {
-			log.Debug("Disconnecting bad peer")
-			defer r.p2p.Disconnect(stream.Conn().RemotePeer())
-		}
-		resp, err := r.generateErrorResponse(responseCodeInvalidRequest, rateLimitedError)
-		if err != nil {
-			log.WithError(err).Error("Failed to generate a response error")
-		} else {
-			if _, err := stream.Write(resp); err != nil {
-				log.WithError(err).Errorf("Failed to write to stream")
-			}
-		}
-		return errors.New(rateLimitedError)
+	if err := r.rateLimiter.validateBlocks(blocksByRangeTopic, stream.Conn().RemotePeer(), m.Count); err != nil {
+		r.rejectRateLimited(stream)
+		traceutil.AnnotateError(span, err)
+		return err
 	}
 
-	r.blocksRateLimiter.Add(stream.Conn().RemotePeer().String(), int64(m.Count))
-
 	// TODO(3147): Update this with reasonable constraints.
-	if endSlot-startSlot > 1000 || m.Step == 0 {
-		resp, err := r.generateErrorResponse(responseCodeInvalidRequest, "invalid range or step")
+	// The range is checked without computing it first, as the fields of the request may
+	// overflow it.
+	if m.Step == 0 || m.Count == 0 || m.Count-1 > 1000/m.Step || endSlot < startSlot {
+		resp, err := generateErrorResponse(streamEncoding(stream), responseCodeInvalidRequest, "invalid range or step")
 		if err != nil {
 			log.WithError(err).Error("Failed to generate a response error")
 		} else {
@@ -73,7 +62,7 @@ func (r *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 	}
 
 	var errResponse = func() {
-		resp, err := r.generateErrorResponse(responseCodeServerError, genericError)
+		resp, err := generateErrorResponse(streamEncoding(stream), responseCodeServerError, genericError)
 		if err != nil {
 			log.WithError(err).Error("Failed to generate a response error")
 		} else {
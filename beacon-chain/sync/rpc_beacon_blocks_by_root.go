@@ -9,6 +9,8 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
 )
 
 // sendRecentBeaconBlocksRequest sends a recent beacon blocks request to a peer to get
@@ -21,8 +23,12 @@ func (r *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 	if err != nil {
 		return err
 	}
+	requested := make(map[[32]byte]bool, len(blockRoots))
+	for _, root := range blockRoots {
+		requested[root] = true
+	}
 	for i := 0; i < len(blockRoots); i++ {
-		blk, err := ReadChunkedBlock(stream, r.p2p)
+		blk, err := ReadChunkedBlock(stream)
 		if err == io.EOF {
 			break
 		}
@@ -35,17 +41,21 @@ func (r *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 		if err != nil {
 			return err
 		}
-		r.pendingQueueLock.Lock()
-		r.slotToPendingBlocks[blk.Block.Slot] = blk
-		r.seenPendingBlocks[blkRoot] = true
-		r.pendingQueueLock.Unlock()
-
+		if !requested[blkRoot] {
+			r.p2p.Peers().IncrementBadResponses(id)
+			return errors.Errorf("received block with root %#x which was not requested", blkRoot)
+		}
+		if !r.pendingBlocks.add(blkRoot, blk) {
+			log.WithField("slot", blk.Block.Slot).Debug("Pending blocks queue is full, dropping block")
+		}
 	}
 	return nil
 }
 
 // beaconBlocksRootRPCHandler looks up the request blocks from the database from the given block roots.
 func (r *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, span := trace.StartSpan(ctx, "sync.BeaconBlocksRootHandler")
+	defer span.End()
 	defer stream.Close()
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -54,7 +64,7 @@ func (r *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 
 	blockRoots := msg.([][32]byte)
 	if len(blockRoots) == 0 {
-		resp, err := r.generateErrorResponse(responseCodeInvalidRequest, "no block roots provided in request")
+		resp, err := generateErrorResponse(streamEncoding(stream), responseCodeInvalidRequest, "no block roots provided in request")
 		if err != nil {
 			log.WithError(err).Error("Failed to generate a response error")
 		} else {
@@ -62,33 +72,23 @@ func (r *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 				log.WithError(err).Errorf("Failed to write to stream")
 			}
 		}
-		return errors.New("no block roots provided")
+		err := errors.New("no block roots provided")
+		traceutil.AnnotateError(span, err)
+		return err
 	}
 
-	if int64(len(blockRoots)) > r.blocksRateLimiter.Remaining(stream.Conn().RemotePeer().String()) {
-		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
-		if r.p2p.Peers().IsBad(stream.Conn().RemotePeer()) {
-			log.Debug("Disconnecting bad peer")
-			defer r.p2p.Disconnect(stream.Conn().RemotePeer())
-		}
-		resp, err := r.generateErrorResponse(responseCodeInvalidRequest, rateLimitedError)
-		if err != nil {
-			log.WithError(err).Error("Failed to generate a response error")
-		} else {
-			if _, err := stream.Write(resp); err != nil {
-				log.WithError(err).Errorf("Failed to write to stream")
-			}
-		}
-		return errors.New(rateLimitedError)
+	if err := r.rateLimiter.validateBlocks(blocksByRootTopic, stream.Conn().RemotePeer(), uint64(len(blockRoots))); err != nil {
+		r.rejectRateLimited(stream)
+		traceutil.AnnotateError(span, err)
+		return err
 	}
 
-	r.blocksRateLimiter.Add(stream.Conn().RemotePeer().String(), int64(len(blockRoots)))
-
 	for _, root := range blockRoots {
 		blk, err := r.db.Block(ctx, root)
 		if err != nil {
 			log.WithError(err).Error("Failed to fetch block")
-			resp, err := r.generateErrorResponse(responseCodeServerError, genericError)
+			traceutil.AnnotateError(span, err)
+			resp, err := generateErrorResponse(streamEncoding(stream), responseCodeServerError, genericError)
 			if err != nil {
 				log.WithError(err).Error("Failed to generate a response error")
 			} else {
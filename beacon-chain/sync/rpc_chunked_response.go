@@ -6,7 +6,6 @@ import (
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 )
 
@@ -15,7 +14,7 @@ import (
 // response_chunk ::= | <result> | <encoding-dependent-header> | <encoded-payload>
 func (r *Service) chunkWriter(stream libp2pcore.Stream, msg interface{}) error {
 	setStreamWriteDeadline(stream, defaultWriteDuration)
-	return WriteChunk(stream, r.p2p.Encoding(), msg)
+	return WriteChunk(stream, streamEncoding(stream), msg)
 }
 
 // WriteChunk object to stream.
@@ -30,19 +29,20 @@ func WriteChunk(stream libp2pcore.Stream, encoding encoder.NetworkEncoding, msg
 
 // ReadChunkedBlock handles each response chunk that is sent by the
 // peer and converts it into a beacon block.
-func ReadChunkedBlock(stream libp2pcore.Stream, p2p p2p.P2P) (*eth.SignedBeaconBlock, error) {
+func ReadChunkedBlock(stream libp2pcore.Stream) (*eth.SignedBeaconBlock, error) {
 	blk := &eth.SignedBeaconBlock{}
-	if err := readResponseChunk(stream, p2p, blk); err != nil {
+	if err := readResponseChunk(stream, blk); err != nil {
 		return nil, err
 	}
 	return blk, nil
 }
 
 // readResponseChunk reads the response from the stream and decodes it into the
-// provided message type.
-func readResponseChunk(stream libp2pcore.Stream, p2p p2p.P2P, to interface{}) error {
+// provided message type, using the encoding negotiated for the stream.
+func readResponseChunk(stream libp2pcore.Stream, to interface{}) error {
 	setStreamReadDeadline(stream, 10*time.Second)
-	code, errMsg, err := ReadStatusCode(stream, p2p.Encoding())
+	encoding := streamEncoding(stream)
+	code, errMsg, err := ReadStatusCode(stream, encoding)
 	if err != nil {
 		return err
 	}
@@ -50,5 +50,5 @@ func readResponseChunk(stream libp2pcore.Stream, p2p p2p.P2P, to interface{}) er
 	if code != 0 {
 		return errors.New(errMsg)
 	}
-	return p2p.Encoding().DecodeWithMaxLength(stream, to, maxChunkSize)
+	return encoding.DecodeWithMaxLength(stream, to, maxChunkSize)
 }
@@ -0,0 +1,51 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// metaDataHandler reads the incoming metadata rpc request from the peer and responds with
+// the metadata of the node.
+func (r *Service) metaDataHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer stream.Close()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	setRPCStreamDeadlines(stream)
+
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	_, err := streamEncoding(stream).EncodeWithLength(stream, r.p2p.Metadata())
+	return err
+}
+
+// sendMetaDataRequest requests the metadata of a peer and caches it in the peer status.
+func (r *Service) sendMetaDataRequest(ctx context.Context, id peer.ID) (*pb.MetaData, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	stream, err := r.p2p.Send(ctx, new(interface{}), id)
+	if err != nil {
+		return nil, err
+	}
+	code, errMsg, err := ReadStatusCode(stream, streamEncoding(stream))
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
+		return nil, errors.New(errMsg)
+	}
+	msg := &pb.MetaData{}
+	if err := streamEncoding(stream).DecodeWithLength(stream, msg); err != nil {
+		return nil, err
+	}
+	r.p2p.Peers().SetMetadata(id, msg)
+	return msg, nil
+}
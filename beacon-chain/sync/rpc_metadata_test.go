@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestMetaDataRPCHandler_ReceivesMetadata(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	if len(p1.Host.Network().Peers()) != 1 {
+		t.Error("Expected peers to be connected")
+	}
+	p1.LocalMetadata = &pb.MetaData{
+		SeqNumber: 2,
+		Attnets:   []byte{'A', 'B', 0, 0, 0, 0, 0, 0},
+	}
+
+	r := &Service{
+		p2p: p1,
+	}
+
+	// Setup streams
+	pcl := protocol.ID("/testing")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.Host.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, r, stream)
+		out := &pb.MetaData{}
+		if err := r.p2p.Encoding().DecodeWithLength(stream, out); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(out, p1.LocalMetadata) {
+			t.Errorf("Did not receive expected message. Got %+v wanted %+v", out, p1.LocalMetadata)
+		}
+	})
+	stream1, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), pcl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.metaDataHandler(context.Background(), nil, stream1); err != nil {
+		t.Errorf("Unxpected error: %v", err)
+	}
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestMetaDataRPCHandler_SendsMetadata(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	if len(p1.Host.Network().Peers()) != 1 {
+		t.Error("Expected peers to be connected")
+	}
+	p2.LocalMetadata = &pb.MetaData{
+		SeqNumber: 5,
+		Attnets:   []byte{'C', 'D', 0, 0, 0, 0, 0, 0},
+	}
+
+	r := &Service{
+		p2p: p1,
+	}
+	r2 := &Service{
+		p2p: p2,
+	}
+
+	// Setup streams
+	pcl := protocol.ID("/eth2/beacon_chain/req/metadata/1/ssz")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.Host.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		if err := r2.metaDataHandler(context.Background(), nil, stream); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	metaData, err := r.sendMetaDataRequest(context.Background(), p2.Host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(metaData, p2.LocalMetadata) {
+		t.Errorf("Did not receive expected message. Got %+v wanted %+v", metaData, p2.LocalMetadata)
+	}
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	cached, err := p1.Peers().Metadata(p2.Host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(cached, p2.LocalMetadata) {
+		t.Errorf("Did not cache peer metadata. Got %+v wanted %+v", cached, p2.LocalMetadata)
+	}
+}
@@ -0,0 +1,101 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	libp2pcore "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+)
+
+// maintainPeerPings pings connected peers regularly, which keeps the connections alive and
+// refreshes the metadata of peers whose metadata has changed.
+func (r *Service) maintainPeerPings() {
+	// Run four times per epoch.
+	interval := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch/4) * time.Second
+	runutil.RunEvery(r.ctx, interval, func() {
+		for _, pid := range r.p2p.Peers().Connected() {
+			go func(id peer.ID) {
+				if err := r.sendPingRequest(r.ctx, id); err != nil {
+					log.WithField("peer", id).WithError(err).Debug("Failed to ping peer")
+				}
+			}(pid)
+		}
+	})
+}
+
+// pingHandler reads the incoming ping rpc message from the peer and responds with the
+// sequence number of our metadata. The metadata of the peer is requested if its sequence
+// number is newer than the one we know of.
+func (r *Service) pingHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	defer stream.Close()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	setRPCStreamDeadlines(stream)
+
+	m, ok := msg.(*pb.Ping)
+	if !ok {
+		return fmt.Errorf("wrong message type for ping, got %T", msg)
+	}
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	if _, err := streamEncoding(stream).EncodeWithLength(stream, &pb.Ping{SeqNumber: r.p2p.MetadataSeq()}); err != nil {
+		return err
+	}
+
+	id := stream.Conn().RemotePeer()
+	if r.metadataOutdated(id, m.SeqNumber) {
+		// The request outlives the handler, so it does not use the handler context.
+		go func() {
+			if _, err := r.sendMetaDataRequest(r.ctx, id); err != nil {
+				log.WithField("peer", id).WithError(err).Debug("Failed to request peer metadata")
+			}
+		}()
+	}
+	return nil
+}
+
+// sendPingRequest pings a peer with the sequence number of our metadata, and requests the
+// metadata of the peer if its sequence number is newer than the one we know of.
+func (r *Service) sendPingRequest(ctx context.Context, id peer.ID) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	stream, err := r.p2p.Send(ctx, &pb.Ping{SeqNumber: r.p2p.MetadataSeq()}, id)
+	if err != nil {
+		return err
+	}
+	code, errMsg, err := ReadStatusCode(stream, streamEncoding(stream))
+	if err != nil {
+		return err
+	}
+	if code != 0 {
+		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
+		return errors.New(errMsg)
+	}
+	msg := &pb.Ping{}
+	if err := streamEncoding(stream).DecodeWithLength(stream, msg); err != nil {
+		return err
+	}
+	if r.metadataOutdated(id, msg.SeqNumber) {
+		_, err := r.sendMetaDataRequest(ctx, id)
+		return err
+	}
+	return nil
+}
+
+// metadataOutdated returns whether the cached metadata of the peer is older than the given
+// sequence number, or not known at all.
+func (r *Service) metadataOutdated(id peer.ID, seq uint64) bool {
+	metaData, err := r.p2p.Peers().Metadata(id)
+	if err != nil || metaData == nil {
+		return true
+	}
+	return metaData.SeqNumber < seq
+}
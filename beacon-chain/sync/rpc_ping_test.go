@@ -0,0 +1,125 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestPingRPCHandler_ReceivesPing(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	if len(p1.Host.Network().Peers()) != 1 {
+		t.Error("Expected peers to be connected")
+	}
+	p1.LocalMetadata = &pb.MetaData{
+		SeqNumber: 2,
+		Attnets:   []byte{'A', 'B', 0, 0, 0, 0, 0, 0},
+	}
+	// The metadata of the peer is already known, so no metadata request is made.
+	p1.Peers().Add(p2.Host.ID(), nil, network.DirOutbound)
+	p1.Peers().SetMetadata(p2.Host.ID(), &pb.MetaData{SeqNumber: 3})
+
+	r := &Service{
+		p2p: p1,
+		ctx: context.Background(),
+	}
+
+	// Setup streams
+	pcl := protocol.ID("/testing")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.Host.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, r, stream)
+		out := &pb.Ping{}
+		if err := r.p2p.Encoding().DecodeWithLength(stream, out); err != nil {
+			t.Fatal(err)
+		}
+		if out.SeqNumber != 2 {
+			t.Errorf("Wanted sequence number %d, got %d", 2, out.SeqNumber)
+		}
+	})
+	stream1, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), pcl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.pingHandler(context.Background(), &pb.Ping{SeqNumber: 3}, stream1); err != nil {
+		t.Errorf("Unxpected error: %v", err)
+	}
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
+func TestPingRPCRequest_RequestsOutdatedMetadata(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	if len(p1.Host.Network().Peers()) != 1 {
+		t.Error("Expected peers to be connected")
+	}
+	p2.LocalMetadata = &pb.MetaData{
+		SeqNumber: 4,
+		Attnets:   []byte{'C', 'D', 0, 0, 0, 0, 0, 0},
+	}
+	p1.Peers().Add(p2.Host.ID(), nil, network.DirOutbound)
+	p1.Peers().SetMetadata(p2.Host.ID(), &pb.MetaData{SeqNumber: 1})
+
+	r := &Service{
+		p2p: p1,
+		ctx: context.Background(),
+	}
+	r2 := &Service{
+		p2p: p2,
+		ctx: context.Background(),
+	}
+	// Peer 2 already knows the metadata of peer 1.
+	p2.Peers().Add(p1.Host.ID(), nil, network.DirInbound)
+	p2.Peers().SetMetadata(p1.Host.ID(), p1.Metadata())
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	p2.Host.SetStreamHandler(protocol.ID("/eth2/beacon_chain/req/ping/1/ssz"), func(stream network.Stream) {
+		defer wg.Done()
+		out := &pb.Ping{}
+		if err := r2.p2p.Encoding().DecodeWithLength(stream, out); err != nil {
+			t.Fatal(err)
+		}
+		if err := r2.pingHandler(context.Background(), out, stream); err != nil {
+			t.Fatal(err)
+		}
+	})
+	p2.Host.SetStreamHandler(protocol.ID("/eth2/beacon_chain/req/metadata/1/ssz"), func(stream network.Stream) {
+		defer wg.Done()
+		if err := r2.metaDataHandler(context.Background(), nil, stream); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if err := r.sendPingRequest(context.Background(), p2.Host.ID()); err != nil {
+		t.Fatal(err)
+	}
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive streams within 1 sec")
+	}
+
+	metaData, err := p1.Peers().Metadata(p2.Host.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metaData.SeqNumber != 4 {
+		t.Errorf("Wanted refreshed metadata with sequence number %d, got %d", 4, metaData.SeqNumber)
+	}
+}
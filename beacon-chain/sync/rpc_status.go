@@ -89,7 +89,7 @@ func (r *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 		return err
 	}
 
-	code, errMsg, err := ReadStatusCode(stream, r.p2p.Encoding())
+	code, errMsg, err := ReadStatusCode(stream, streamEncoding(stream))
 	if err != nil {
 		return err
 	}
@@ -100,7 +100,7 @@ func (r *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 	}
 
 	msg := &pb.Status{}
-	if err := r.p2p.Encoding().DecodeWithLength(stream, msg); err != nil {
+	if err := streamEncoding(stream).DecodeWithLength(stream, msg); err != nil {
 		return err
 	}
 	r.p2p.Peers().SetChainState(stream.Conn().RemotePeer(), msg)
@@ -130,7 +130,7 @@ func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 		log.WithField("peer", stream.Conn().RemotePeer()).Debug("Invalid fork version from peer")
 		r.p2p.Peers().IncrementBadResponses(stream.Conn().RemotePeer())
 		originalErr := err
-		resp, err := r.generateErrorResponse(responseCodeInvalidRequest, err.Error())
+		resp, err := generateErrorResponse(streamEncoding(stream), responseCodeInvalidRequest, err.Error())
 		if err != nil {
 			log.WithError(err).Error("Failed to generate a response error")
 		} else {
@@ -166,7 +166,7 @@ func (r *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
 		log.WithError(err).Error("Failed to write to stream")
 	}
-	_, err = r.p2p.Encoding().EncodeWithLength(stream, resp)
+	_, err = streamEncoding(stream).EncodeWithLength(stream, resp)
 
 	return err
 }
@@ -9,6 +9,7 @@ import (
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/testing"
@@ -41,8 +42,9 @@ func expectResetStream(t *testing.T, r *Service, stream network.Stream) {
 func TestRegisterRPC_ReceivesValidMessage(t *testing.T) {
 	p2p := p2ptest.NewTestP2P(t)
 	r := &Service{
-		ctx: context.Background(),
-		p2p: p2p,
+		ctx:         context.Background(),
+		p2p:         p2p,
+		rateLimiter: newRateLimiter(testRateLimits()),
 	}
 
 	var wg sync.WaitGroup
@@ -65,3 +67,45 @@ func TestRegisterRPC_ReceivesValidMessage(t *testing.T) {
 		t.Fatal("Did not receive RPC in 1 second")
 	}
 }
+
+func TestRegisterRPC_ReceivesSnappyMessage(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	r := &Service{
+		ctx:         context.Background(),
+		p2p:         p2,
+		rateLimiter: newRateLimiter(testRateLimits()),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	topic := "/testing/foobar/1"
+	handler := func(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+		m := msg.(*pb.TestSimpleMessage)
+		if !bytes.Equal(m.Foo, []byte("foo")) {
+			t.Errorf("Unexpected incoming message: %+v", m)
+		}
+		if string(stream.Protocol()) != topic+encoder.ProtocolSuffixSSZSnappy {
+			t.Errorf("Unexpected stream protocol: %s", stream.Protocol())
+		}
+		wg.Done()
+
+		return nil
+	}
+	r.registerRPC(topic, &pb.TestSimpleMessage{}, handler)
+
+	stream, err := p1.Host.NewStream(context.Background(), p2.Host.ID(), protocol.ID(topic+encoder.ProtocolSuffixSSZSnappy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	e := &encoder.SszNetworkEncoder{UseSnappyCompression: true}
+	if _, err := e.EncodeWithLength(stream, &pb.TestSimpleMessage{Foo: []byte("foo")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if testutil.WaitTimeout(&wg, time.Second) {
+		t.Fatal("Did not receive RPC in 1 second")
+	}
+}
@@ -0,0 +1,88 @@
+package sync
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// seenEpochs is the number of epochs an accepted block or attestation is remembered for.
+// Blocks and attestations older than that are no longer propagated, so their validation
+// rejects them anyway.
+const seenEpochs = 2
+
+// Kinds of gossip messages tracked in the seen cache.
+const (
+	seenBlock            = "block"
+	seenAttestation      = "attestation"
+	seenAggregate        = "aggregate"
+	seenExit             = "exit"
+	seenProposerSlashing = "proposer_slashing"
+	seenAttesterSlashing = "attester_slashing"
+)
+
+// seenCache tracks the gossip messages accepted recently, keyed by the kind of the message
+// and the fields identifying it semantically, such as the validator index and epoch of a
+// voluntary exit. Unlike the pubsub message ID, which is derived from the raw message
+// data, this catches differently encoded or signed copies of the same operation, so that
+// duplicates are ignored before being validated, processed and broadcast again.
+//
+// Voluntary exits and slashings stay valid until they are included in a block, however
+// long that takes, so they are remembered for good rather than for seenEpochs. They are
+// keyed by validator index and only accepted for validators which are not exited or
+// slashed yet, so their number grows with the validator registry, not with time.
+type seenCache struct {
+	cache *cache.Cache
+}
+
+func newSeenCache() *seenCache {
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+	return &seenCache{
+		cache: cache.New(seenEpochs*epochDuration, epochDuration),
+	}
+}
+
+// hasSeen returns whether messages of the given kind were already accepted for all the
+// given IDs. A message covering several IDs, such as an attester slashing slashing several
+// validators, is only a duplicate if none of its IDs is new.
+func (c *seenCache) hasSeen(kind string, ids ...string) bool {
+	if len(ids) == 0 {
+		return false
+	}
+	for _, id := range ids {
+		if _, ok := c.cache.Get(kind + "/" + id); !ok {
+			return false
+		}
+	}
+	gossipDuplicateCounter.WithLabelValues(kind).Inc()
+	return true
+}
+
+// markSeen records that a message of the given kind was accepted for the given IDs.
+func (c *seenCache) markSeen(kind string, ids ...string) {
+	for _, id := range ids {
+		c.cache.Set(kind+"/"+id, true, seenExpiration(kind))
+	}
+}
+
+// seenExpiration returns how long a message of the given kind is remembered for.
+func seenExpiration(kind string) time.Duration {
+	switch kind {
+	case seenExit, seenProposerSlashing, seenAttesterSlashing:
+		return cache.NoExpiration
+	default:
+		return cache.DefaultExpiration
+	}
+}
+
+// seenID joins the fields identifying a message into the ID of the message in the seen cache.
+func seenID(fields ...uint64) string {
+	ids := make([]string, len(fields))
+	for i, f := range fields {
+		ids[i] = strconv.FormatUint(f, 10)
+	}
+	return strings.Join(ids, "/")
+}
@@ -0,0 +1,60 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+func TestSeenCache(t *testing.T) {
+	c := newSeenCache()
+	if c.hasSeen(seenExit, seenID(1, 2)) {
+		t.Error("Wanted exit not to be seen before being marked")
+	}
+	c.markSeen(seenExit, seenID(1, 2))
+	if !c.hasSeen(seenExit, seenID(1, 2)) {
+		t.Error("Wanted exit to be seen")
+	}
+	// IDs are scoped to the kind of message.
+	if c.hasSeen(seenProposerSlashing, seenID(1, 2)) {
+		t.Error("Wanted proposer slashing not to be seen")
+	}
+	if c.hasSeen(seenExit, seenID(12)) {
+		t.Error("Wanted exit with different fields not to be seen")
+	}
+}
+
+func TestSeenCache_MultipleIDs(t *testing.T) {
+	c := newSeenCache()
+	c.markSeen(seenAttesterSlashing, seenID(1), seenID(2))
+	if !c.hasSeen(seenAttesterSlashing, seenID(1), seenID(2)) {
+		t.Error("Wanted slashing of seen validators to be seen")
+	}
+	if c.hasSeen(seenAttesterSlashing, seenID(1), seenID(3)) {
+		t.Error("Wanted slashing of a new validator not to be seen")
+	}
+	if c.hasSeen(seenAttesterSlashing) {
+		t.Error("Wanted slashing without validators not to be seen")
+	}
+}
+
+func TestSeenCache_OperationsDoNotExpire(t *testing.T) {
+	c := &seenCache{cache: cache.New(time.Millisecond, 0)}
+	c.markSeen(seenBlock, seenID(1))
+	c.markSeen(seenAttestation, seenID(1))
+	c.markSeen(seenExit, seenID(1, 2))
+	c.markSeen(seenProposerSlashing, seenID(1))
+	c.markSeen(seenAttesterSlashing, seenID(1))
+	time.Sleep(10 * time.Millisecond)
+
+	if c.hasSeen(seenBlock, seenID(1)) || c.hasSeen(seenAttestation, seenID(1)) {
+		t.Error("Wanted blocks and attestations to expire")
+	}
+	if !c.hasSeen(seenExit, seenID(1, 2)) {
+		t.Error("Wanted exit not to expire before being included")
+	}
+	if !c.hasSeen(seenProposerSlashing, seenID(1)) || !c.hasSeen(seenAttesterSlashing, seenID(1)) {
+		t.Error("Wanted slashings not to expire before being included")
+	}
+}
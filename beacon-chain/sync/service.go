@@ -4,10 +4,10 @@ import (
 	"context"
 	"sync"
 
-	"github.com/kevinms/leakybucket-go"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
@@ -23,13 +23,15 @@ const allowedBlocksBurst = 10 * allowedBlocksPerSecond
 
 // Config to set up the regular sync service.
 type Config struct {
-	P2P           p2p.P2P
-	DB            db.NoHeadAccessDatabase
-	AttPool       attestations.Pool
-	ExitPool      *voluntaryexits.Pool
-	Chain         blockchainService
-	InitialSync   Checker
-	StateNotifier statefeed.Notifier
+	P2P               p2p.P2P
+	DB                db.NoHeadAccessDatabase
+	AttPool           attestations.Pool
+	ExitPool          *voluntaryexits.Pool
+	Chain             blockchainService
+	InitialSync       Checker
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
+	RateLimits        *RateLimits
 }
 
 // This defines the interface for interacting with block chain service
@@ -54,11 +56,12 @@ func NewRegularSync(cfg *Config) *Service {
 		exitPool:             cfg.ExitPool,
 		chain:                cfg.Chain,
 		initialSync:          cfg.InitialSync,
-		slotToPendingBlocks:  make(map[uint64]*ethpb.SignedBeaconBlock),
-		seenPendingBlocks:    make(map[[32]byte]bool),
+		pendingBlocks:        newPendingBlocks(maxPendingBlocksBytes),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.AggregateAttestationAndProof),
 		stateNotifier:        cfg.StateNotifier,
-		blocksRateLimiter:    leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */),
+		operationNotifier:    cfg.OperationNotifier,
+		rateLimiter:          newRateLimiter(cfg.RateLimits),
+		seen:                 newSeenCache(),
 	}
 
 	r.registerRPCHandlers()
@@ -77,16 +80,16 @@ type Service struct {
 	attPool              attestations.Pool
 	exitPool             *voluntaryexits.Pool
 	chain                blockchainService
-	slotToPendingBlocks  map[uint64]*ethpb.SignedBeaconBlock
-	seenPendingBlocks    map[[32]byte]bool
+	pendingBlocks        *pendingBlocks
 	blkRootToPendingAtts map[[32]byte][]*ethpb.AggregateAttestationAndProof
 	pendingAttsLock      sync.RWMutex
-	pendingQueueLock     sync.RWMutex
 	chainStarted         bool
 	initialSync          Checker
 	validateBlockLock    sync.RWMutex
 	stateNotifier        statefeed.Notifier
-	blocksRateLimiter    *leakybucket.Collector
+	operationNotifier    opfeed.Notifier
+	rateLimiter          *rateLimiter
+	seen                 *seenCache
 }
 
 // Start the regular sync service.
@@ -96,6 +99,7 @@ func (r *Service) Start() {
 	r.processPendingBlocksQueue()
 	r.processPendingAttsQueue()
 	r.maintainPeerStatuses()
+	r.maintainPeerPings()
 	r.resyncIfBehind()
 }
 
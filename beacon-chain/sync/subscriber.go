@@ -9,10 +9,13 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared/messagehandler"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
@@ -20,18 +23,43 @@ import (
 
 const pubsubMessageTimeout = 30 * time.Second
 
+// aggregatorSubnetLookahead is the number of slots before an aggregation duty at which
+// the node joins the subnet of the duty, giving it time to find peers on the subnet.
+const aggregatorSubnetLookahead = 2
+
 // subHandler represents handler for a given subscription.
 type subHandler func(context.Context, proto.Message) error
 
+// validationResult is the outcome of validating a gossip message.
+type validationResult int
+
+const (
+	// validationAccept means the message is valid and is relayed to our peers.
+	validationAccept validationResult = iota
+	// validationIgnore means the message is dropped because of the peer it came from, for
+	// example because it is stale or already known, which slightly lowers the score of the peer.
+	validationIgnore
+	// validationIgnoreLocal means the message is dropped because of the local state of the
+	// node, such as initial sync or a database error, which is not held against the peer it
+	// came from.
+	validationIgnoreLocal
+	// validationReject means the message is invalid, which lowers the score of the peer it
+	// came from.
+	validationReject
+)
+
+// validator validates a gossip message received from a peer.
+type validator func(context.Context, peer.ID, *pubsub.Message) validationResult
+
 // noopValidator is a no-op that only decodes the message, but does not check its contents.
-func (r *Service) noopValidator(ctx context.Context, _ peer.ID, msg *pubsub.Message) bool {
+func (r *Service) noopValidator(ctx context.Context, _ peer.ID, msg *pubsub.Message) validationResult {
 	m, err := r.decodePubsubMessage(msg)
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
-		return false
+		return validationReject
 	}
 	msg.ValidatorData = m
-	return true
+	return validationAccept
 }
 
 // Register PubSub subscribers
@@ -87,9 +115,8 @@ func (r *Service) registerSubscribers() {
 		r.validateAttesterSlashing,
 		r.attesterSlashingSubscriber,
 	)
-	r.subscribeDynamic(
+	r.subscribeDynamicWithSubnets(
 		"/eth2/committee_index%d_beacon_attestation",
-		r.currentCommitteeIndex,                     /* determineSubsLen */
 		r.validateCommitteeIndexBeaconAttestation,   /* validator */
 		r.committeeIndexBeaconAttestationSubscriber, /* message handler */
 	)
@@ -97,19 +124,19 @@ func (r *Service) registerSubscribers() {
 
 // subscribe to a given topic with a given validator and subscription handler.
 // The base protobuf message is used to initialize new messages for decoding.
-func (r *Service) subscribe(topic string, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
+func (r *Service) subscribe(topic string, validate validator, handle subHandler) *pubsub.Subscription {
 	base := p2p.GossipTopicMappings[topic]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
 	}
-	return r.subscribeWithBase(base, topic, validator, handle)
+	return r.subscribeWithBase(base, topic, validate, handle)
 }
 
-func (r *Service) subscribeWithBase(base proto.Message, topic string, validator pubsub.Validator, handle subHandler) *pubsub.Subscription {
+func (r *Service) subscribeWithBase(base proto.Message, topic string, validate validator, handle subHandler) *pubsub.Subscription {
 	topic += r.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 
-	if err := r.p2p.PubSub().RegisterTopicValidator(wrapAndReportValidation(topic, validator)); err != nil {
+	if err := r.p2p.PubSub().RegisterTopicValidator(r.wrapAndReportValidation(topic, validate)); err != nil {
 		log.WithError(err).Error("Failed to register validator")
 	}
 
@@ -174,60 +201,99 @@ func (r *Service) subscribeWithBase(base proto.Message, topic string, validator
 	return sub
 }
 
-// Wrap the pubsub validator with a metric monitoring function. This function increments the
-// appropriate counter if the particular message fails to validate.
-func wrapAndReportValidation(topic string, v pubsub.Validator) (string, pubsub.Validator) {
+// Wrap the validator into a pubsub validator with a metric monitoring function. This function
+// increments the appropriate counter if the particular message fails to validate, and records
+// the outcome of the validation in the score of the peer the message came from. Pubsub only
+// validates the first copy of a message it receives, so accepted messages were first seen
+// from that peer.
+func (r *Service) wrapAndReportValidation(topic string, v validator) (string, pubsub.Validator) {
 	return topic, func(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
 		defer messagehandler.HandlePanic(ctx, msg)
 		ctx, _ = context.WithTimeout(ctx, pubsubMessageTimeout)
 		messageReceivedCounter.WithLabelValues(topic).Inc()
-		b := v(ctx, pid, msg)
-		if !b {
+		result := v(ctx, pid, msg)
+		if result != validationAccept {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
 		}
-		return b
+		if pid == r.p2p.PeerID() {
+			return result == validationAccept
+		}
+		switch result {
+		case validationAccept:
+			r.p2p.Peers().IncrementFirstSeenGossip(pid)
+		case validationIgnore:
+			// Peers cannot be told apart while syncing, as most messages are ignored for
+			// referring to blocks the node does not have yet.
+			if !r.initialSync.Syncing() {
+				r.p2p.Peers().IncrementIgnoredGossip(pid)
+			}
+		case validationReject:
+			r.p2p.Peers().IncrementInvalidGossip(pid)
+		}
+		return result == validationAccept
 	}
 }
 
-// subscribe to a dynamically increasing index of topics. This method expects a fmt compatible
-// string for the topic name and a maxID to represent the number of subscribed topics that should be
-// maintained. As the state feed emits a newly updated state, the maxID function will be called to
-// determine the appropriate number of topics. This method supports only sequential number ranges
-// for topics.
-func (r *Service) subscribeDynamic(topicFormat string, determineSubsLen func() int, validate pubsub.Validator, handle subHandler) {
+// subscribe to the attestation subnets the node needs, which are the long lived random
+// subnets of the attached validators and the subnets of their upcoming aggregation
+// duties. The subscriptions are updated every slot and whenever the state feed emits an
+// event, leaving subnets which are no longer needed.
+func (r *Service) subscribeDynamicWithSubnets(topicFormat string, validate validator, handle subHandler) {
 	base := p2p.GossipTopicMappings[topicFormat]
 	if base == nil {
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topicFormat))
 	}
 
-	var subscriptions []*pubsub.Subscription
+	subscriptions := make(map[uint64]*pubsub.Subscription)
 
 	stateChannel := make(chan *feed.Event, 1)
 	stateSub := r.stateNotifier.StateFeed().Subscribe(stateChannel)
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
 	go func() {
+		defer ticker.Stop()
 		for {
 			select {
 			case <-r.ctx.Done():
 				stateSub.Unsubscribe()
 				return
 			case <-stateChannel:
-				// Update topic count.
-				wantedSubs := determineSubsLen()
-				// Resize as appropriate.
-				if len(subscriptions) > wantedSubs { // Reduce topics
-					var cancelSubs []*pubsub.Subscription
-					subscriptions, cancelSubs = subscriptions[:wantedSubs-1], subscriptions[wantedSubs:]
-					for i, sub := range cancelSubs {
-						sub.Cancel()
-						r.p2p.PubSub().UnregisterTopicValidator(fmt.Sprintf(topicFormat, i+wantedSubs))
-					}
-				} else if len(subscriptions) < wantedSubs { // Increase topics
-					for i := len(subscriptions); i < wantedSubs; i++ {
-						sub := r.subscribeWithBase(base, fmt.Sprintf(topicFormat, i), validate, handle)
-						subscriptions = append(subscriptions, sub)
-					}
+			case <-ticker.C:
+			}
+			wanted := r.wantedSubnets()
+			for id, sub := range subscriptions {
+				if wanted[id] {
+					continue
+				}
+				sub.Cancel()
+				topic := fmt.Sprintf(topicFormat, id) + r.p2p.Encoding().ProtocolSuffix()
+				if err := r.p2p.PubSub().UnregisterTopicValidator(topic); err != nil {
+					log.WithError(err).WithField("topic", topic).Error("Failed to unregister validator")
 				}
+				delete(subscriptions, id)
 			}
+			for id := range wanted {
+				if _, ok := subscriptions[id]; !ok {
+					subscriptions[id] = r.subscribeWithBase(base, fmt.Sprintf(topicFormat, id), validate, handle)
+				}
+			}
+			// Advertise the long lived subnets as soon as they change.
+			r.p2p.RefreshENR()
 		}
 	}()
 }
+
+// wantedSubnets returns the attestation subnets the node should be subscribed to at the
+// current slot.
+func (r *Service) wantedSubnets() map[uint64]bool {
+	wanted := make(map[uint64]bool)
+	for _, id := range cache.SubnetIDs.GetAllSubnets() {
+		wanted[id] = true
+	}
+	currentSlot := helpers.SlotsSince(r.chain.GenesisTime())
+	for slot := currentSlot; slot <= currentSlot+aggregatorSubnetLookahead; slot++ {
+		for _, id := range cache.SubnetIDs.GetAggregatorSubnetIDs(slot) {
+			wanted[id] = true
+		}
+	}
+	return wanted
+}
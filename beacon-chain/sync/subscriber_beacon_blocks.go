@@ -45,10 +45,9 @@ func (r *Service) beaconBlockSubscriber(ctx context.Context, msg proto.Message)
 
 	// Handle block when the parent is unknown
 	if !r.db.HasBlock(ctx, bytesutil.ToBytes32(block.ParentRoot)) {
-		r.pendingQueueLock.Lock()
-		r.slotToPendingBlocks[block.Slot] = signed
-		r.seenPendingBlocks[blockRoot] = true
-		r.pendingQueueLock.Unlock()
+		if !r.pendingBlocks.add(blockRoot, signed) {
+			log.WithField("slot", block.Slot).Debug("Pending blocks queue is full, dropping block")
+		}
 		return nil
 	}
 
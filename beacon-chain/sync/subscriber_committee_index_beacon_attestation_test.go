@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -52,6 +53,8 @@ func TestService_committeeIndexBeaconAttestationSubscriber_ValidMessage(t *testi
 		stateNotifier: (&mock.ChainService{}).StateNotifier(),
 		initialSync:   &mockSync.Sync{IsSyncing: false},
 	}
+	// Join the subnet of the attestation through the long lived subnets of a validator.
+	cache.SubnetIDs.AddPersistentCommittee([]byte("subscriber"), []uint64{0}, time.Minute)
 	r.registerSubscribers()
 	r.stateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.Initialized,
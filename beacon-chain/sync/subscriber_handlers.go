@@ -5,6 +5,8 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 )
 
 func (r *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message) error {
@@ -12,16 +14,38 @@ func (r *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message
 	if err != nil {
 		return err
 	}
-	r.exitPool.InsertVoluntaryExit(ctx, s, msg.(*ethpb.SignedVoluntaryExit))
+	exit := msg.(*ethpb.SignedVoluntaryExit)
+	r.exitPool.InsertVoluntaryExit(ctx, s, exit)
+
+	// Broadcast the voluntary exit on a feed to notify other services in the beacon node
+	// of a received voluntary exit.
+	r.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{
+			Exit: exit,
+		},
+	})
 	return nil
 }
 
 func (r *Service) attesterSlashingSubscriber(ctx context.Context, msg proto.Message) error {
 	// TODO(#3259): Requires handlers in operations service to be implemented.
+	r.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.AttesterSlashingReceived,
+		Data: &opfeed.AttesterSlashingReceivedData{
+			AttesterSlashing: msg.(*ethpb.AttesterSlashing),
+		},
+	})
 	return nil
 }
 
 func (r *Service) proposerSlashingSubscriber(ctx context.Context, msg proto.Message) error {
 	// TODO(#3259): Requires handlers in operations service to be implemented.
+	r.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.ProposerSlashingReceived,
+		Data: &opfeed.ProposerSlashingReceivedData{
+			ProposerSlashing: msg.(*ethpb.ProposerSlashing),
+		},
+	})
 	return nil
 }
@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
@@ -17,6 +20,7 @@ import (
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
@@ -114,3 +118,88 @@ func TestSubscribe_HandlesPanic(t *testing.T) {
 		t.Fatal("Did not receive PubSub in 1 second")
 	}
 }
+
+func TestWantedSubnets_IncludesUpcomingAggregatorSubnets(t *testing.T) {
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	r := Service{
+		chain: &mockChain.ChainService{Genesis: time.Now().Add(-10 * secondsPerSlot)},
+	}
+	cache.SubnetIDs.AddPersistentCommittee([]byte("wanted-subnets"), []uint64{30}, time.Minute)
+	cache.SubnetIDs.AddAggregatorSubnetID(9, 40)
+	cache.SubnetIDs.AddAggregatorSubnetID(11, 41)
+	cache.SubnetIDs.AddAggregatorSubnetID(20, 42)
+
+	wanted := r.wantedSubnets()
+	if !wanted[30] {
+		t.Error("Expected long lived subnet to be wanted")
+	}
+	if !wanted[41] {
+		t.Error("Expected subnet of upcoming aggregation duty to be wanted")
+	}
+	if wanted[40] || wanted[42] {
+		t.Errorf("Expected subnets of past and distant aggregation duties not to be wanted, got %v", wanted)
+	}
+}
+
+func TestWrapAndReportValidation_ScoresPeers(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := Service{
+		ctx:         context.Background(),
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: false},
+	}
+	pid := peer.ID("gossiper")
+	results := []validationResult{validationAccept, validationIgnore, validationIgnoreLocal, validationReject, validationReject}
+	for _, want := range results {
+		_, v := r.wrapAndReportValidation("/testing", func(context.Context, peer.ID, *pubsub.Message) validationResult {
+			return want
+		})
+		if v(context.Background(), pid, &pubsub.Message{}) != (want == validationAccept) {
+			t.Errorf("Wrong pubsub validation for result %d", want)
+		}
+	}
+
+	breakdown, err := p.Peers().ScoreBreakdown(pid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakdown.FirstSeenGossip <= 0 {
+		t.Errorf("Wanted first seen gossip reward, got %v", breakdown.FirstSeenGossip)
+	}
+	if breakdown.IgnoredGossip >= 0 {
+		t.Errorf("Wanted ignored gossip penalty, got %v", breakdown.IgnoredGossip)
+	}
+	if breakdown.InvalidGossip >= 2*breakdown.IgnoredGossip {
+		t.Errorf("Wanted invalid gossip penalty larger than ignored gossip penalty, got %v", breakdown.InvalidGossip)
+	}
+}
+
+func TestWrapAndReportValidation_SyncingNodeDoesNotPenalizePeers(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := Service{
+		ctx:         context.Background(),
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: true},
+	}
+	pid := peer.ID("gossiper")
+	_, v := r.wrapAndReportValidation("/testing", r.validateBeaconBlockPubSub)
+	_, ignore := r.wrapAndReportValidation("/testing", func(context.Context, peer.ID, *pubsub.Message) validationResult {
+		return validationIgnore
+	})
+	// Enough ignored messages to make the peer bad if they were all held against it.
+	for i := 0; i < 200; i++ {
+		if v(context.Background(), pid, &pubsub.Message{}) {
+			t.Fatal("Wanted blocks to be ignored while syncing")
+		}
+		if ignore(context.Background(), pid, &pubsub.Message{}) {
+			t.Fatal("Wanted ignored message not to be relayed")
+		}
+	}
+
+	if p.Peers().IsBad(pid) {
+		t.Error("Wanted peer not to be bad while the node is syncing")
+	}
+	if score := p.Peers().Score(pid); score != 0 {
+		t.Errorf("Wanted peer score %v while the node is syncing, got %v", 0.0, score)
+	}
+}
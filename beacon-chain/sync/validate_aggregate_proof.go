@@ -24,9 +24,9 @@ import (
 
 // validateAggregateAndProof verifies the aggregated signature and the selection proof is valid before forwarding to the
 // network and downstream services.
-func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateAggregateAndProof")
@@ -35,40 +35,51 @@ func (r *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 	// To process the following it requires the recent blocks to be present in the database, so we'll skip
 	// validating or processing aggregated attestations until fully synced.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnoreLocal
 	}
 
 	raw, err := r.decodePubsubMessage(msg)
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 	m, ok := raw.(*ethpb.AggregateAttestationAndProof)
 	if !ok {
-		return false
+		return validationReject
+	}
+	if m.Aggregate == nil || m.Aggregate.Data == nil || m.Aggregate.Data.Target == nil {
+		return validationReject
+	}
+
+	// Only the first aggregate of an aggregator for a target epoch is propagated.
+	aggregateID := seenID(m.AggregatorIndex, m.Aggregate.Data.Target.Epoch)
+	if r.seen.hasSeen(seenAggregate, aggregateID) {
+		return validationIgnore
 	}
 
 	// Verify aggregate attestation has not already been seen via aggregate gossip, within a block, or through the creation locally.
 	seen, err := r.attPool.HasAggregatedAttestation(m.Aggregate)
 	if err != nil {
+		// The pool failing is not the fault of the peer.
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationIgnoreLocal
 	}
 	if seen {
-		return false
+		return validationIgnore
 	}
 
-	if !r.validateAggregatedAtt(ctx, m) {
-		return false
+	if result := r.validateAggregatedAtt(ctx, m); result != validationAccept {
+		return result
 	}
 
+	r.seen.markSeen(seenAggregate, aggregateID)
 	msg.ValidatorData = m
 
-	return true
+	return validationAccept
 }
 
-func (r *Service) validateAggregatedAtt(ctx context.Context, a *ethpb.AggregateAttestationAndProof) bool {
+func (r *Service) validateAggregatedAtt(ctx context.Context, a *ethpb.AggregateAttestationAndProof) validationResult {
 	ctx, span := trace.StartSpan(ctx, "sync.validateAggregatedAtt")
 	defer span.End()
 
@@ -78,21 +89,21 @@ func (r *Service) validateAggregatedAtt(ctx context.Context, a *ethpb.AggregateA
 	if !r.db.HasBlock(ctx, bytesutil.ToBytes32(a.Aggregate.Data.BeaconBlockRoot)) {
 		// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
 		r.savePendingAtt(a)
-		return false
+		return validationIgnoreLocal
 	}
 
 	// Verify attestation slot is within the last ATTESTATION_PROPAGATION_SLOT_RANGE slots.
 	currentSlot := uint64(roughtime.Now().Unix()-r.chain.GenesisTime().Unix()) / params.BeaconConfig().SecondsPerSlot
 	if attSlot > currentSlot || currentSlot > attSlot+params.BeaconConfig().AttestationPropagationSlotRange {
 		traceutil.AnnotateError(span, fmt.Errorf("attestation slot out of range %d <= %d <= %d", attSlot, currentSlot, attSlot+params.BeaconConfig().AttestationPropagationSlotRange))
-		return false
+		return validationIgnore
 
 	}
 
 	s, err := r.chain.HeadState(ctx)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationIgnoreLocal
 	}
 
 	// Only advance state if different epoch as the committee can only change on an epoch transition.
@@ -100,29 +111,29 @@ func (r *Service) validateAggregatedAtt(ctx context.Context, a *ethpb.AggregateA
 		s, err = state.ProcessSlots(ctx, s, helpers.StartSlot(helpers.SlotToEpoch(attSlot)))
 		if err != nil {
 			traceutil.AnnotateError(span, err)
-			return false
+			return validationIgnoreLocal
 		}
 	}
 
 	// Verify validator index is within the aggregate's committee.
 	if err := validateIndexInCommittee(ctx, s, a.Aggregate, a.AggregatorIndex); err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate index in committee"))
-		return false
+		return validationReject
 	}
 
 	// Verify selection proof reflects to the right validator and signature is valid.
 	if err := validateSelection(ctx, s, a.Aggregate.Data, a.AggregatorIndex, a.SelectionProof); err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", a.AggregatorIndex))
-		return false
+		return validationReject
 	}
 
 	// Verify aggregated attestation has a valid signature.
 	if err := blocks.VerifyAttestation(ctx, s, a.Aggregate); err != nil {
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 
-	return true
+	return validationAccept
 }
 
 // This validates the aggregator's index in state is within the attesting indices of the attestation.
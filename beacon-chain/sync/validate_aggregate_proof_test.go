@@ -129,6 +129,7 @@ func TestValidateAggregateAndProof_NoBlock(t *testing.T) {
 
 	r := &Service{
 		p2p:                  p,
+		seen:                 newSeenCache(),
 		db:                   db,
 		initialSync:          &mockSync.Sync{IsSyncing: false},
 		attPool:              attestations.NewPool(),
@@ -149,7 +150,7 @@ func TestValidateAggregateAndProof_NoBlock(t *testing.T) {
 		},
 	}
 
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) == validationAccept {
 		t.Error("Expected validate to fail")
 	}
 }
@@ -187,6 +188,7 @@ func TestValidateAggregateAndProof_NotWithinSlotRange(t *testing.T) {
 	}
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		db:          db,
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
@@ -208,7 +210,7 @@ func TestValidateAggregateAndProof_NotWithinSlotRange(t *testing.T) {
 		},
 	}
 
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) == validationAccept {
 		t.Error("Expected validate to fail")
 	}
 
@@ -227,7 +229,7 @@ func TestValidateAggregateAndProof_NotWithinSlotRange(t *testing.T) {
 			},
 		},
 	}
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) == validationAccept {
 		t.Error("Expected validate to fail")
 	}
 }
@@ -266,6 +268,7 @@ func TestValidateAggregateAndProof_ExistedInPool(t *testing.T) {
 	r := &Service{
 		attPool:     attestations.NewPool(),
 		p2p:         p,
+		seen:        newSeenCache(),
 		db:          db,
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
@@ -289,7 +292,7 @@ func TestValidateAggregateAndProof_ExistedInPool(t *testing.T) {
 	if err := r.attPool.SaveBlockAttestation(att); err != nil {
 		t.Fatal(err)
 	}
-	if r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) == validationAccept {
 		t.Error("Expected validate to fail")
 	}
 }
@@ -354,6 +357,7 @@ func TestValidateAggregateAndProof_CanValidate(t *testing.T) {
 	}
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		db:          db,
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
@@ -378,7 +382,7 @@ func TestValidateAggregateAndProof_CanValidate(t *testing.T) {
 		},
 	}
 
-	if !r.validateAggregateAndProof(context.Background(), "", msg) {
+	if r.validateAggregateAndProof(context.Background(), "", msg) != validationAccept {
 		t.Fatal("Validated status is false")
 	}
 
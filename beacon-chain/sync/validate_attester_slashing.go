@@ -9,22 +9,23 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
 
 // Clients who receive an attester slashing on this topic MUST validate the conditions within VerifyAttesterSlashing before
 // forwarding it across the network.
-func (r *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	// Validation runs on publish (not just subscriptions), so we should approve any message from
 	// ourselves.
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 
 	// The head state will be too far away to validate any slashing.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnoreLocal
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateAttesterSlashing")
@@ -34,35 +35,50 @@ func (r *Service) validateAttesterSlashing(ctx context.Context, pid peer.ID, msg
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 	slashing, ok := m.(*ethpb.AttesterSlashing)
 	if !ok {
-		return false
+		return validationReject
+	}
+	if slashing.Attestation_1 == nil || slashing.Attestation_2 == nil {
+		return validationReject
+	}
+
+	// A slashing is only propagated if it slashes at least one validator not slashed by
+	// the slashings seen before.
+	slashedIndices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	slashedIDs := make([]string, len(slashedIndices))
+	for i, idx := range slashedIndices {
+		slashedIDs[i] = seenID(idx)
+	}
+	if r.seen.hasSeen(seenAttesterSlashing, slashedIDs...) {
+		return validationIgnore
 	}
 
 	// Retrieve head state, advance state to the epoch slot used specified in slashing message.
 	s, err := r.chain.HeadState(ctx)
 	if err != nil {
-		return false
+		return validationIgnoreLocal
 	}
 	slashSlot := slashing.Attestation_1.Data.Target.Epoch * params.BeaconConfig().SlotsPerEpoch
 	if s.Slot() < slashSlot {
 		if ctx.Err() != nil {
-			return false
+			return validationIgnoreLocal
 		}
 
 		var err error
 		s, err = state.ProcessSlots(ctx, s, slashSlot)
 		if err != nil {
-			return false
+			return validationIgnoreLocal
 		}
 	}
 
 	if err := blocks.VerifyAttesterSlashing(ctx, s, slashing); err != nil {
-		return false
+		return validationReject
 	}
 
+	r.seen.markSeen(seenAttesterSlashing, slashedIDs...)
 	msg.ValidatorData = slashing // Used in downstream subscriber
-	return true
+	return validationAccept
 }
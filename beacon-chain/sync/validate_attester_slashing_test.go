@@ -92,6 +92,7 @@ func TestValidateAttesterSlashing_ValidSlashing(t *testing.T) {
 
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		chain:       &mock.ChainService{State: s},
 		initialSync: &mockSync.Sync{IsSyncing: false},
 	}
@@ -109,7 +110,7 @@ func TestValidateAttesterSlashing_ValidSlashing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateAttesterSlashing(ctx, "foobar", msg)
+	valid := r.validateAttesterSlashing(ctx, "foobar", msg) == validationAccept
 
 	if !valid {
 		t.Error("Failed Validation")
@@ -130,6 +131,7 @@ func TestValidateAttesterSlashing_ContextTimeout(t *testing.T) {
 
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		chain:       &mock.ChainService{State: state},
 		initialSync: &mockSync.Sync{IsSyncing: false},
 	}
@@ -147,7 +149,7 @@ func TestValidateAttesterSlashing_ContextTimeout(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateAttesterSlashing(ctx, "", msg)
+	valid := r.validateAttesterSlashing(ctx, "", msg) == validationAccept
 
 	if valid {
 		t.Error("slashing from the far distant future should have timed out and returned false")
@@ -162,6 +164,7 @@ func TestValidateAttesterSlashing_Syncing(t *testing.T) {
 
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		chain:       &mock.ChainService{State: s},
 		initialSync: &mockSync.Sync{IsSyncing: true},
 	}
@@ -178,7 +181,7 @@ func TestValidateAttesterSlashing_Syncing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateAttesterSlashing(ctx, "", msg)
+	valid := r.validateAttesterSlashing(ctx, "", msg) == validationAccept
 	if valid {
 		t.Error("Passed validation")
 	}
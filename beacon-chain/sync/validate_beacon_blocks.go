@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
@@ -16,16 +17,16 @@ import (
 // validateBeaconBlockPubSub checks that the incoming block has a valid BLS signature.
 // Blocks that have already been seen are ignored. If the BLS signature is any valid signature,
 // this method rebroadcasts the message.
-func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	// Validation runs on publish (not just subscriptions), so we should approve any message from
 	// ourselves.
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 
 	// We should not attempt to process blocks until fully synced, but propagation is OK.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnoreLocal
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateBeaconBlockPubSub")
@@ -35,7 +36,7 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 
 	r.validateBlockLock.Lock()
@@ -43,35 +44,40 @@ func (r *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 
 	blk, ok := m.(*ethpb.SignedBeaconBlock)
 	if !ok {
-		return false
+		return validationReject
 	}
 
 	blockRoot, err := ssz.HashTreeRoot(blk.Block)
 	if err != nil {
-		return false
+		return validationReject
 	}
 
-	r.pendingQueueLock.RLock()
-	if r.seenPendingBlocks[blockRoot] {
-		r.pendingQueueLock.RUnlock()
-		return false
+	// Blocks do not carry the index of their proposer, which is costly to compute, so blocks
+	// are tracked by root.
+	blockID := fmt.Sprintf("%#x", blockRoot)
+	if r.seen.hasSeen(seenBlock, blockID) {
+		return validationIgnore
+	}
+
+	if r.pendingBlocks.has(blockRoot) {
+		return validationIgnore
 	}
-	r.pendingQueueLock.RUnlock()
 
 	if err := helpers.VerifySlotTime(uint64(r.chain.GenesisTime().Unix()), blk.Block.Slot); err != nil {
 		log.WithError(err).WithField("blockSlot", blk.Block.Slot).Warn("Rejecting incoming block.")
-		return false
+		return validationIgnore
 	}
 
 	if r.chain.FinalizedCheckpt().Epoch > helpers.SlotToEpoch(blk.Block.Slot) {
 		log.Debug("Block older than finalized checkpoint received,rejecting it")
-		return false
+		return validationIgnore
 	}
 
 	if _, err = bls.SignatureFromBytes(blk.Signature); err != nil {
-		return false
+		return validationReject
 	}
 
+	r.seen.markSeen(seenBlock, blockID)
 	msg.ValidatorData = blk // Used in downstream subscriber
-	return true
+	return validationAccept
 }
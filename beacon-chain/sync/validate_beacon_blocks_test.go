@@ -41,6 +41,7 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 	r := &Service{
 		db:          db,
 		p2p:         p,
+		seen:        newSeenCache(),
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
 			FinalizedCheckPoint: &ethpb.Checkpoint{
@@ -60,7 +61,7 @@ func TestValidateBeaconBlockPubSub_InvalidSignature(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 
 	if result {
 		t.Error("Expected false result, got true")
@@ -86,6 +87,7 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 	r := &Service{
 		db:          db,
 		p2p:         p,
+		seen:        newSeenCache(),
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain:       &mock.ChainService{Genesis: time.Now()},
 	}
@@ -103,7 +105,7 @@ func TestValidateBeaconBlockPubSub_BlockAlreadyPresentInDB(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 
 	if result {
 		t.Error("Expected false result, got true")
@@ -131,6 +133,7 @@ func TestValidateBeaconBlockPubSub_ValidSignature(t *testing.T) {
 	r := &Service{
 		db:          db,
 		p2p:         p,
+		seen:        newSeenCache(),
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{Genesis: time.Now(),
 			FinalizedCheckPoint: &ethpb.Checkpoint{
@@ -150,7 +153,7 @@ func TestValidateBeaconBlockPubSub_ValidSignature(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 	if !result {
 		t.Error("Expected true result, got false")
 	}
@@ -181,6 +184,7 @@ func TestValidateBeaconBlockPubSub_Syncing(t *testing.T) {
 	r := &Service{
 		db:          db,
 		p2p:         p,
+		seen:        newSeenCache(),
 		initialSync: &mockSync.Sync{IsSyncing: true},
 		chain: &mock.ChainService{
 			Genesis: time.Now(),
@@ -201,7 +205,7 @@ func TestValidateBeaconBlockPubSub_Syncing(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 	if result {
 		t.Error("Expected false result, got true")
 	}
@@ -228,6 +232,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		db:          db,
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain:       &mock.ChainService{Genesis: time.Now()},
@@ -245,7 +250,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromFuture(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 	if result {
 		t.Error("Expected false result, got true")
 	}
@@ -274,6 +279,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 	r := &Service{
 		db:          db,
 		p2p:         p,
+		seen:        newSeenCache(),
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		chain: &mock.ChainService{
 			Genesis: time.Unix(genesisTime.Unix()-1000, 0),
@@ -294,7 +300,7 @@ func TestValidateBeaconBlockPubSub_RejectBlocksFromThePast(t *testing.T) {
 			},
 		},
 	}
-	result := r.validateBeaconBlockPubSub(ctx, "", m)
+	result := r.validateBeaconBlockPubSub(ctx, "", m) == validationAccept
 
 	if result {
 		t.Error("Expected false result, got true")
@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -24,14 +25,14 @@ import (
 // - The block being voted for (attestation.data.beacon_block_root) passes validation.
 // - attestation.data.slot is within the last ATTESTATION_PROPAGATION_SLOT_RANGE slots (attestation.data.slot + ATTESTATION_PROPAGATION_SLOT_RANGE >= current_slot >= attestation.data.slot).
 // - The signature of attestation is valid.
-func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	if pid == s.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 	// Attestation processing requires the target block to be present in the database, so we'll skip
 	// validating or processing attestations until fully synced.
 	if s.initialSync.Syncing() {
-		return false
+		return validationIgnoreLocal
 	}
 	ctx, span := trace.StartSpan(ctx, "sync.validateCommitteeIndexBeaconAttestation")
 	defer span.End()
@@ -45,24 +46,38 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 	// Restore topic.
 	msg.TopicIDs[0] = originalTopic
 
 	att, ok := m.(*eth.Attestation)
 	if !ok {
-		return false
+		return validationReject
 	}
 
 	// The attestation's committee index (attestation.data.index) is for the correct subnet.
 	if !strings.HasPrefix(originalTopic, fmt.Sprintf(format, att.Data.CommitteeIndex)) {
-		return false
+		return validationReject
 	}
 
 	// Attestation must be unaggregated.
 	if att.AggregationBits == nil || att.AggregationBits.Count() != 1 {
-		return false
+		return validationReject
+	}
+
+	// Only the first copy of an attestation is propagated. The validator is identified by its
+	// position in the committee, which avoids computing the committee. As the signature is not
+	// verified against the validator, the data root and signature are part of the ID, so that
+	// a forged attestation for the same position cannot censor the real one.
+	dataRoot, err := ssz.HashTreeRoot(att.Data)
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return validationReject
+	}
+	attID := fmt.Sprintf("%s/%#x/%#x", seenID(att.Data.Slot, att.Data.CommitteeIndex, aggregationBit(att)), dataRoot, att.Signature)
+	if s.seen.hasSeen(seenAttestation, attID) {
+		return validationIgnore
 	}
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE.
@@ -70,22 +85,33 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	upper := att.Data.Slot + params.BeaconConfig().AttestationPropagationSlotRange
 	lower := att.Data.Slot
 	if currentSlot > upper || currentSlot < lower {
-		return false
+		return validationIgnore
 	}
 
 	// Verify the block being voted is in DB. The block should have passed validation if it's in the DB.
 	if !s.db.HasBlock(ctx, bytesutil.ToBytes32(att.Data.BeaconBlockRoot)) {
 		// A node doesn't have the block, it'll request from peer while saving the pending attestation to a queue.
 		s.savePendingAtt(&eth.AggregateAttestationAndProof{Aggregate: att})
-		return false
+		return validationIgnoreLocal
 	}
 
 	// Attestation's signature is a valid BLS signature.
 	if _, err := bls.SignatureFromBytes(att.Signature); err != nil {
-		return false
+		return validationReject
 	}
 
+	s.seen.markSeen(seenAttestation, attID)
 	msg.ValidatorData = att
 
-	return true
+	return validationAccept
+}
+
+// aggregationBit returns the index of the first participant of an attestation in its committee.
+func aggregationBit(att *eth.Attestation) uint64 {
+	for i := uint64(0); i < att.AggregationBits.Len(); i++ {
+		if att.AggregationBits.BitAt(i) {
+			return i
+		}
+	}
+	return 0
 }
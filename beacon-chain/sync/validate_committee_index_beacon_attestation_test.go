@@ -27,6 +27,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	s := &Service{
 		initialSync: &mockSync.Sync{IsSyncing: false},
 		p2p:         p,
+		seen:        newSeenCache(),
 		db:          db,
 		chain: &mockChain.ChainService{
 			Genesis: time.Now().Add(time.Duration(-64*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second), // 64 slots ago
@@ -70,6 +71,34 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 			topic: "/eth2/committee_index1_beacon_attestation",
 			want:  true,
 		},
+		{
+			name: "already seen",
+			msg: &ethpb.Attestation{
+				AggregationBits: bitfield.Bitlist{0b1010},
+				Data: &ethpb.AttestationData{
+					BeaconBlockRoot: validBlockRoot[:],
+					CommitteeIndex:  1,
+					Slot:            63,
+				},
+				Signature: validSig,
+			},
+			topic: "/eth2/committee_index1_beacon_attestation",
+			want:  false,
+		},
+		{
+			name: "same position with another signature",
+			msg: &ethpb.Attestation{
+				AggregationBits: bitfield.Bitlist{0b1010},
+				Data: &ethpb.AttestationData{
+					BeaconBlockRoot: validBlockRoot[:],
+					CommitteeIndex:  1,
+					Slot:            63,
+				},
+				Signature: bls.RandKey().Sign([]byte("bar"), 0).Marshal(),
+			},
+			topic: "/eth2/committee_index1_beacon_attestation",
+			want:  true,
+		},
 		{
 			name: "wrong committee index",
 			msg: &ethpb.Attestation{
@@ -105,7 +134,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				Data: &ethpb.AttestationData{
 					BeaconBlockRoot: []byte("missing"),
 					CommitteeIndex:  1,
-					Slot:            63,
+					Slot:            62,
 				},
 				Signature: validSig,
 			},
@@ -119,7 +148,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 				Data: &ethpb.AttestationData{
 					BeaconBlockRoot: validBlockRoot[:],
 					CommitteeIndex:  1,
-					Slot:            63,
+					Slot:            62,
 				},
 				Signature: []byte("bad"),
 			},
@@ -141,7 +170,7 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 					TopicIDs: []string{tt.topic},
 				},
 			}
-			if s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, m) != tt.want {
+			if (s.validateCommitteeIndexBeaconAttestation(ctx, "" /*peerID*/, m) == validationAccept) != tt.want {
 				t.Errorf("Did not received wanted validation. Got %v, wanted %v", !tt.want, tt.want)
 			}
 			if tt.want && m.ValidatorData == nil {
@@ -14,16 +14,16 @@ import (
 
 // Clients who receive a proposer slashing on this topic MUST validate the conditions within VerifyProposerSlashing before
 // forwarding it across the network.
-func (r *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	// Validation runs on publish (not just subscriptions), so we should approve any message from
 	// ourselves.
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 
 	// The head state will be too far away to validate any slashing.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnoreLocal
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateProposerSlashing")
@@ -33,35 +33,42 @@ func (r *Service) validateProposerSlashing(ctx context.Context, pid peer.ID, msg
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 
 	slashing, ok := m.(*ethpb.ProposerSlashing)
 	if !ok {
-		return false
+		return validationReject
+	}
+
+	// Only the first slashing of a proposer is propagated.
+	slashingID := seenID(slashing.ProposerIndex)
+	if r.seen.hasSeen(seenProposerSlashing, slashingID) {
+		return validationIgnore
 	}
 
 	// Retrieve head state, advance state to the epoch slot used specified in slashing message.
 	s, err := r.chain.HeadState(ctx)
 	if err != nil {
-		return false
+		return validationIgnoreLocal
 	}
 	slashSlot := slashing.Header_1.Header.Slot
 	if s.Slot() < slashSlot {
 		if ctx.Err() != nil {
-			return false
+			return validationIgnoreLocal
 		}
 		var err error
 		s, err = state.ProcessSlots(ctx, s, slashSlot)
 		if err != nil {
-			return false
+			return validationIgnoreLocal
 		}
 	}
 
 	if err := blocks.VerifyProposerSlashing(s, slashing); err != nil {
-		return false
+		return validationReject
 	}
 
+	r.seen.markSeen(seenProposerSlashing, slashingID)
 	msg.ValidatorData = slashing // Used in downstream subscriber
-	return true
+	return validationAccept
 }
@@ -127,6 +127,7 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		chain:       &mock.ChainService{State: s},
 		initialSync: &mockSync.Sync{IsSyncing: false},
 	}
@@ -144,7 +145,7 @@ func TestValidateProposerSlashing_ValidSlashing(t *testing.T) {
 		},
 	}
 
-	valid := r.validateProposerSlashing(ctx, "", m)
+	valid := r.validateProposerSlashing(ctx, "", m) == validationAccept
 	if !valid {
 		t.Error("Failed validation")
 	}
@@ -164,6 +165,7 @@ func TestValidateProposerSlashing_ContextTimeout(t *testing.T) {
 
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		chain:       &mock.ChainService{State: state},
 		initialSync: &mockSync.Sync{IsSyncing: false},
 	}
@@ -180,7 +182,7 @@ func TestValidateProposerSlashing_ContextTimeout(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateProposerSlashing(ctx, "", m)
+	valid := r.validateProposerSlashing(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("slashing from the far distant future should have timed out and returned false")
 	}
@@ -194,6 +196,7 @@ func TestValidateProposerSlashing_Syncing(t *testing.T) {
 
 	r := &Service{
 		p2p:         p,
+		seen:        newSeenCache(),
 		chain:       &mock.ChainService{State: s},
 		initialSync: &mockSync.Sync{IsSyncing: true},
 	}
@@ -210,7 +213,7 @@ func TestValidateProposerSlashing_Syncing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateProposerSlashing(ctx, "", m)
+	valid := r.validateProposerSlashing(ctx, "", m) == validationAccept
 
 	if valid {
 		t.Error("Did not fail validation")
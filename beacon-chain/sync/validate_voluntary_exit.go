@@ -14,16 +14,16 @@ import (
 
 // Clients who receive a voluntary exit on this topic MUST validate the conditions within process_voluntary_exit before
 // forwarding it across the network.
-func (r *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *pubsub.Message) bool {
+func (r *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *pubsub.Message) validationResult {
 	// Validation runs on publish (not just subscriptions), so we should approve any message from
 	// ourselves.
 	if pid == r.p2p.PeerID() {
-		return true
+		return validationAccept
 	}
 
 	// The head state will be too far away to validate any voluntary exit.
 	if r.initialSync.Syncing() {
-		return false
+		return validationIgnoreLocal
 	}
 
 	ctx, span := trace.StartSpan(ctx, "sync.validateVoluntaryExit")
@@ -33,32 +33,39 @@ func (r *Service) validateVoluntaryExit(ctx context.Context, pid peer.ID, msg *p
 	if err != nil {
 		log.WithError(err).Error("Failed to decode message")
 		traceutil.AnnotateError(span, err)
-		return false
+		return validationReject
 	}
 
 	exit, ok := m.(*ethpb.SignedVoluntaryExit)
 	if !ok {
-		return false
+		return validationReject
+	}
+
+	// Only the first exit of a validator for an epoch is propagated.
+	exitID := seenID(exit.Exit.ValidatorIndex, exit.Exit.Epoch)
+	if r.seen.hasSeen(seenExit, exitID) {
+		return validationIgnore
 	}
 
 	s, err := r.chain.HeadState(ctx)
 	if err != nil {
-		return false
+		return validationIgnoreLocal
 	}
 
 	exitedEpochSlot := exit.Exit.Epoch * params.BeaconConfig().SlotsPerEpoch
 	if int(exit.Exit.ValidatorIndex) >= s.NumValidators() {
-		return false
+		return validationReject
 	}
 	val, err := s.ValidatorAtIndex(exit.Exit.ValidatorIndex)
 	if err != nil {
-		return false
+		return validationReject
 	}
 	if err := blocks.VerifyExit(val, exitedEpochSlot, s.Fork(), exit); err != nil {
-		return false
+		return validationReject
 	}
 
+	r.seen.markSeen(seenExit, exitID)
 	msg.ValidatorData = exit // Used in downstream subscriber
 
-	return true
+	return validationAccept
 }
@@ -85,7 +85,8 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 	exit, s := setupValidExit(t)
 
 	r := &Service{
-		p2p: p,
+		p2p:  p,
+		seen: newSeenCache(),
 		chain: &mock.ChainService{
 			State: s,
 		},
@@ -104,7 +105,7 @@ func TestValidateVoluntaryExit_ValidExit(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateVoluntaryExit(ctx, "", m)
+	valid := r.validateVoluntaryExit(ctx, "", m) == validationAccept
 	if !valid {
 		t.Error("Failed validation")
 	}
@@ -121,7 +122,8 @@ func TestValidateVoluntaryExit_ValidExit_Syncing(t *testing.T) {
 	exit, s := setupValidExit(t)
 
 	r := &Service{
-		p2p: p,
+		p2p:  p,
+		seen: newSeenCache(),
 		chain: &mock.ChainService{
 			State: s,
 		},
@@ -139,7 +141,7 @@ func TestValidateVoluntaryExit_ValidExit_Syncing(t *testing.T) {
 			},
 		},
 	}
-	valid := r.validateVoluntaryExit(ctx, "", m)
+	valid := r.validateVoluntaryExit(ctx, "", m) == validationAccept
 	if valid {
 		t.Error("Validation should have failed")
 	}
@@ -60,6 +60,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.MaxGoroutines,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
+			cmd.ChainConfigFileFlag,
 		},
 	},
 	{
@@ -84,6 +85,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Web3ProviderFlag,
 			flags.RPCHost,
 			flags.RPCPort,
+			flags.AdminRPCPort,
 			flags.RPCMaxPageSize,
 			flags.CertFlag,
 			flags.KeyFlag,
@@ -104,6 +106,11 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.EnableUPnPFlag,
 			cmd.P2PEncoding,
 			flags.MinSyncPeers,
+			flags.RPCRequestsPerSecondFlag,
+			flags.RPCRequestsBurstFlag,
+			flags.RPCBlocksPerSecondFlag,
+			flags.RPCBlocksBurstFlag,
+			flags.DBMigrationDryRunFlag,
 		},
 	},
 	{
@@ -125,6 +132,16 @@ var appHelpFlagGroups = []flagGroup{
 			flags.InteropNumValidatorsFlag,
 		},
 	},
+	{
+		Name: "checkpoint",
+		Flags: []cli.Flag{
+			flags.CheckpointStateFlag,
+			flags.CheckpointBlockFlag,
+			flags.CheckpointProviderFlag,
+			flags.CheckpointCertFlag,
+			flags.BackfillBlocksFlag,
+		},
+	},
 	{
 		Name: "archive",
 		Flags: []cli.Flag{
@@ -132,6 +149,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ArchiveValidatorSetChangesFlag,
 			flags.ArchiveBlocksFlag,
 			flags.ArchiveAttestationsFlag,
+			flags.SlotsPerArchivePointFlag,
 		},
 	},
 }
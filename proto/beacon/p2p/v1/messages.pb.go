@@ -174,38 +174,146 @@ func (m *BeaconBlocksByRangeRequest) GetStep() uint64 {
 	return 0
 }
 
+type Ping struct {
+	SeqNumber            uint64   `protobuf:"varint,1,opt,name=seq_number,json=seqNumber,proto3" json:"seq_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ping) Reset()         { *m = Ping{} }
+func (m *Ping) String() string { return proto.CompactTextString(m) }
+func (*Ping) ProtoMessage()    {}
+func (*Ping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{2}
+}
+func (m *Ping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Ping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ping.Merge(m, src)
+}
+func (m *Ping) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ping) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ping proto.InternalMessageInfo
+
+func (m *Ping) GetSeqNumber() uint64 {
+	if m != nil {
+		return m.SeqNumber
+	}
+	return 0
+}
+
+type MetaData struct {
+	SeqNumber            uint64   `protobuf:"varint,1,opt,name=seq_number,json=seqNumber,proto3" json:"seq_number,omitempty"`
+	Attnets              []byte   `protobuf:"bytes,2,opt,name=attnets,proto3" json:"attnets,omitempty" ssz-size:"8"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetaData) Reset()         { *m = MetaData{} }
+func (m *MetaData) String() string { return proto.CompactTextString(m) }
+func (*MetaData) ProtoMessage()    {}
+func (*MetaData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{3}
+}
+func (m *MetaData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetaData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetaData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetaData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaData.Merge(m, src)
+}
+func (m *MetaData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetaData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaData proto.InternalMessageInfo
+
+func (m *MetaData) GetSeqNumber() uint64 {
+	if m != nil {
+		return m.SeqNumber
+	}
+	return 0
+}
+
+func (m *MetaData) GetAttnets() []byte {
+	if m != nil {
+		return m.Attnets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Status)(nil), "ethereum.beacon.p2p.v1.Status")
 	proto.RegisterType((*BeaconBlocksByRangeRequest)(nil), "ethereum.beacon.p2p.v1.BeaconBlocksByRangeRequest")
+	proto.RegisterType((*Ping)(nil), "ethereum.beacon.p2p.v1.Ping")
+	proto.RegisterType((*MetaData)(nil), "ethereum.beacon.p2p.v1.MetaData")
 }
 
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_a1d590cda035b632) }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x6e, 0xb2, 0x40,
-	0x10, 0xc7, 0xb3, 0x7e, 0x68, 0x3e, 0x37, 0xfa, 0xf9, 0xb9, 0x69, 0x1a, 0x62, 0x53, 0x35, 0x5c,
-	0xea, 0x45, 0x88, 0xda, 0x43, 0xdb, 0xf4, 0x44, 0xd2, 0x3e, 0x00, 0x26, 0xbd, 0x12, 0xc0, 0x11,
-	0x88, 0xc8, 0x50, 0x76, 0x31, 0xa9, 0x4f, 0xd3, 0xc7, 0xe9, 0xb1, 0x4f, 0x60, 0x1a, 0x1f, 0xc1,
-	0x43, 0xcf, 0x0d, 0x83, 0xa9, 0x27, 0x6f, 0xbb, 0x33, 0xbf, 0xf9, 0xfd, 0x19, 0x96, 0x1b, 0x59,
-	0x8e, 0x0a, 0x2d, 0x1f, 0xbc, 0x00, 0x53, 0x2b, 0x9b, 0x66, 0xd6, 0x66, 0x62, 0xad, 0x41, 0x4a,
-	0x2f, 0x04, 0x69, 0x52, 0x53, 0x5c, 0x82, 0x8a, 0x20, 0x87, 0x62, 0x6d, 0x56, 0x98, 0x99, 0x4d,
-	0x33, 0x73, 0x33, 0xe9, 0x8d, 0xc3, 0x58, 0x45, 0x85, 0x6f, 0x06, 0xb8, 0xb6, 0x42, 0x0c, 0xd1,
-	0x22, 0xdc, 0x2f, 0x96, 0x74, 0xab, 0xc4, 0xe5, 0xa9, 0xd2, 0x18, 0xdf, 0x8c, 0x37, 0xe6, 0xca,
-	0x53, 0x85, 0x14, 0x8f, 0xbc, 0x1b, 0x81, 0xb7, 0x70, 0x97, 0x98, 0xaf, 0xdc, 0x0d, 0xe4, 0x32,
-	0xc6, 0x54, 0x67, 0x43, 0x36, 0x6a, 0xd9, 0xff, 0x0f, 0xbb, 0x41, 0x4b, 0xca, 0xed, 0x58, 0xc6,
-	0x5b, 0x78, 0x30, 0x6e, 0x0d, 0xa7, 0x53, 0xa2, 0xcf, 0x98, 0xaf, 0x5e, 0x2a, 0x50, 0xdc, 0xf1,
-	0x7f, 0xcb, 0x38, 0xf5, 0x92, 0x78, 0x0b, 0x0b, 0x37, 0x47, 0x54, 0x7a, 0x8d, 0x46, 0xbb, 0x87,
-	0xdd, 0xa0, 0x7d, 0x1a, 0x9d, 0x4d, 0x0d, 0xa7, 0xfd, 0x0b, 0x3a, 0x88, 0x4a, 0xdc, 0xf0, 0xce,
-	0x69, 0x12, 0x32, 0x0c, 0x22, 0xfd, 0xcf, 0x90, 0x8d, 0x34, 0xe7, 0x24, 0x7c, 0x2a, 0xab, 0xc2,
-	0xe4, 0x4d, 0xfa, 0x40, 0xb2, 0x6b, 0xe7, 0xec, 0x7f, 0x4b, 0x86, 0xc4, 0x57, 0x47, 0x5e, 0x26,
-	0xa8, 0xf4, 0x3a, 0x29, 0xa9, 0x39, 0x4f, 0x50, 0x19, 0xef, 0x8c, 0xf7, 0x6c, 0xfa, 0x73, 0x76,
-	0x82, 0xc1, 0x4a, 0xda, 0x6f, 0x8e, 0x97, 0x86, 0xe0, 0xc0, 0x6b, 0x01, 0x52, 0x89, 0x7b, 0x4e,
-	0x1b, 0xba, 0x7e, 0xd9, 0xac, 0x12, 0xd9, 0xd9, 0x7d, 0x4a, 0x92, 0x2c, 0x14, 0x7b, 0xcd, 0xb9,
-	0x54, 0x5e, 0xae, 0xaa, 0xdc, 0x1a, 0xe5, 0x36, 0xa9, 0x52, 0x06, 0x8b, 0x0b, 0x5e, 0x0f, 0xb0,
-	0x48, 0xd5, 0x71, 0xc9, 0xea, 0x22, 0x04, 0xd7, 0xa4, 0x82, 0x8c, 0xd6, 0xd2, 0x1c, 0x3a, 0xdb,
-	0xad, 0x8f, 0x7d, 0x9f, 0x7d, 0xee, 0xfb, 0xec, 0x6b, 0xdf, 0x67, 0x7e, 0x83, 0x1e, 0x6c, 0xf6,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x12, 0xe0, 0xa8, 0xc9, 0x1d, 0x02, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb5, 0xc1, 0x2d, 0xed, 0x2a, 0xa5, 0x74, 0x85, 0x90, 0x55, 0x44, 0x5a, 0xad, 0x84,
+	0xa8, 0x90, 0x6a, 0xab, 0x29, 0x87, 0x82, 0x38, 0x59, 0xc0, 0x0d, 0x84, 0x5c, 0xc1, 0xd5, 0x5a,
+	0xbb, 0x13, 0xc7, 0x4a, 0xb2, 0xe3, 0xec, 0x8e, 0x23, 0x91, 0xa7, 0xe1, 0x71, 0x38, 0xf2, 0x04,
+	0x15, 0xca, 0x23, 0xf4, 0xc0, 0x19, 0x79, 0x1c, 0xc8, 0x85, 0x88, 0xdb, 0xee, 0xcc, 0x37, 0xdf,
+	0xef, 0xb1, 0x2d, 0x75, 0xed, 0x90, 0x30, 0xce, 0xc1, 0x14, 0x68, 0xe3, 0x7a, 0x58, 0xc7, 0x8b,
+	0x8b, 0x78, 0x06, 0xde, 0x9b, 0x12, 0x7c, 0xc4, 0x4d, 0xf5, 0x18, 0x68, 0x0c, 0x0e, 0x9a, 0x59,
+	0xd4, 0x61, 0x51, 0x3d, 0xac, 0xa3, 0xc5, 0xc5, 0xf1, 0x79, 0x59, 0xd1, 0xb8, 0xc9, 0xa3, 0x02,
+	0x67, 0x71, 0x89, 0x25, 0xc6, 0x8c, 0xe7, 0xcd, 0x88, 0x6f, 0x9d, 0xb8, 0x3d, 0x75, 0x1a, 0xfd,
+	0x4b, 0xc8, 0xdd, 0x6b, 0x32, 0xd4, 0x78, 0xf5, 0x46, 0x1e, 0x8d, 0xc1, 0xdc, 0x64, 0x23, 0x74,
+	0x93, 0x6c, 0x01, 0xce, 0x57, 0x68, 0x43, 0x71, 0x2a, 0xce, 0xfa, 0xc9, 0xc3, 0xbb, 0xdb, 0x93,
+	0xbe, 0xf7, 0xcb, 0x73, 0x5f, 0x2d, 0xe1, 0xb5, 0x7e, 0xa9, 0xd3, 0xc3, 0x16, 0x7d, 0x8f, 0x6e,
+	0xf2, 0xa5, 0x03, 0xd5, 0x95, 0x7c, 0x30, 0xaa, 0xac, 0x99, 0x56, 0x4b, 0xb8, 0xc9, 0x1c, 0x22,
+	0x85, 0x3d, 0x1e, 0x3d, 0xba, 0xbb, 0x3d, 0x39, 0xd8, 0x8c, 0x5e, 0x0e, 0x75, 0x7a, 0xf0, 0x17,
+	0x4c, 0x11, 0x49, 0x3d, 0x97, 0x87, 0x9b, 0x49, 0xa8, 0xb1, 0x18, 0x87, 0xf7, 0x4e, 0xc5, 0x59,
+	0x90, 0x6e, 0x84, 0xef, 0xda, 0xaa, 0x8a, 0xe4, 0x3e, 0x3f, 0x20, 0xdb, 0x83, 0x6d, 0xf6, 0xbd,
+	0x96, 0x61, 0xf1, 0x93, 0x35, 0xef, 0xa7, 0x48, 0xe1, 0x0e, 0x2b, 0xb9, 0x79, 0x3d, 0x45, 0xd2,
+	0xdf, 0x84, 0x3c, 0x4e, 0xf8, 0xcd, 0x25, 0x53, 0x2c, 0x26, 0x3e, 0xf9, 0x9a, 0x1a, 0x5b, 0x42,
+	0x0a, 0xf3, 0x06, 0x3c, 0xa9, 0x57, 0x92, 0x37, 0xcc, 0xf2, 0xb6, 0xd9, 0x25, 0x8a, 0xad, 0xfb,
+	0xb4, 0x24, 0x5b, 0x38, 0xf6, 0xa9, 0x94, 0x9e, 0x8c, 0xa3, 0x2e, 0xb7, 0xc7, 0xb9, 0xfb, 0x5c,
+	0x69, 0x83, 0xd5, 0x23, 0xb9, 0x53, 0x60, 0x63, 0x69, 0xbd, 0x64, 0x77, 0x51, 0x4a, 0x06, 0x9e,
+	0xa0, 0xe6, 0xb5, 0x82, 0x94, 0xcf, 0xfa, 0x99, 0x0c, 0x3e, 0x55, 0xb6, 0x64, 0x21, 0xcc, 0x33,
+	0xdb, 0xcc, 0x72, 0x70, 0xa1, 0x58, 0x0b, 0x61, 0xfe, 0x91, 0x0b, 0xfa, 0xb3, 0xdc, 0xfb, 0x00,
+	0x64, 0xde, 0x1a, 0x32, 0xff, 0x41, 0xd5, 0x0b, 0x79, 0xdf, 0x10, 0x59, 0x20, 0x1f, 0xf6, 0xfe,
+	0xf5, 0x61, 0xaf, 0x74, 0xfa, 0x07, 0x48, 0xfa, 0xdf, 0x57, 0x03, 0xf1, 0x63, 0x35, 0x10, 0x3f,
+	0x57, 0x03, 0x91, 0xef, 0xf2, 0xef, 0x72, 0xf9, 0x7b, 0x00, 0xc5, 0xea, 0xfc, 0xed, 0x9b, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Status) Marshal() (dAtA []byte, err error) {
@@ -315,6 +423,77 @@ func (m *BeaconBlocksByRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *Ping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Ping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SeqNumber != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.SeqNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Attnets) > 0 {
+		i -= len(m.Attnets)
+		copy(dAtA[i:], m.Attnets)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Attnets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SeqNumber != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.SeqNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessages(v)
 	base := offset
@@ -381,6 +560,40 @@ func (m *BeaconBlocksByRangeRequest) Size() (n int) {
 	return n
 }
 
+func (m *Ping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeqNumber != 0 {
+		n += 1 + sovMessages(uint64(m.SeqNumber))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetaData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeqNumber != 0 {
+		n += 1 + sovMessages(uint64(m.SeqNumber))
+	}
+	l = len(m.Attnets)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovMessages(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -726,6 +939,186 @@ func (m *BeaconBlocksByRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Ping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNumber", wireType)
+			}
+			m.SeqNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetaData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetaData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNumber", wireType)
+			}
+			m.SeqNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attnets", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attnets = append(m.Attnets[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attnets == nil {
+				m.Attnets = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
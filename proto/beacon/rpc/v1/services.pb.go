@@ -450,6 +450,124 @@ func (m *AggregationResponse) GetRoot() []byte {
 	return nil
 }
 
+type CommitteeSubnetsSubscribeRequest struct {
+	Subscriptions        []*CommitteeSubnetSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) Reset()         { *m = CommitteeSubnetsSubscribeRequest{} }
+func (m *CommitteeSubnetsSubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitteeSubnetsSubscribeRequest) ProtoMessage()    {}
+func (*CommitteeSubnetsSubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{6}
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeSubnetsSubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeSubnetsSubscribeRequest.Merge(m, src)
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeSubnetsSubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeSubnetsSubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeSubnetsSubscribeRequest proto.InternalMessageInfo
+
+func (m *CommitteeSubnetsSubscribeRequest) GetSubscriptions() []*CommitteeSubnetSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+type CommitteeSubnetSubscription struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,3,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	IsAggregator         bool     `protobuf:"varint,4,opt,name=is_aggregator,json=isAggregator,proto3" json:"is_aggregator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitteeSubnetSubscription) Reset()         { *m = CommitteeSubnetSubscription{} }
+func (m *CommitteeSubnetSubscription) String() string { return proto.CompactTextString(m) }
+func (*CommitteeSubnetSubscription) ProtoMessage()    {}
+func (*CommitteeSubnetSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{7}
+}
+func (m *CommitteeSubnetSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeSubnetSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeSubnetSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeSubnetSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeSubnetSubscription.Merge(m, src)
+}
+func (m *CommitteeSubnetSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeSubnetSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeSubnetSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeSubnetSubscription proto.InternalMessageInfo
+
+func (m *CommitteeSubnetSubscription) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *CommitteeSubnetSubscription) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *CommitteeSubnetSubscription) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *CommitteeSubnetSubscription) GetIsAggregator() bool {
+	if m != nil {
+		return m.IsAggregator
+	}
+	return false
+}
+
 type ValidatorPerformanceRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
@@ -462,7 +580,7 @@ func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanc
 func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPerformanceRequest) ProtoMessage()    {}
 func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{6}
+	return fileDescriptor_9eb4e94b85965285, []int{8}
 }
 func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +638,7 @@ func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerforman
 func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorPerformanceResponse) ProtoMessage()    {}
 func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{7}
+	return fileDescriptor_9eb4e94b85965285, []int{9}
 }
 func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +713,7 @@ func (m *ValidatorActivationRequest) Reset()         { *m = ValidatorActivationR
 func (m *ValidatorActivationRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationRequest) ProtoMessage()    {}
 func (*ValidatorActivationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{8}
+	return fileDescriptor_9eb4e94b85965285, []int{10}
 }
 func (m *ValidatorActivationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,7 +761,7 @@ func (m *ValidatorActivationResponse) Reset()         { *m = ValidatorActivation
 func (m *ValidatorActivationResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationResponse) ProtoMessage()    {}
 func (*ValidatorActivationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{9}
+	return fileDescriptor_9eb4e94b85965285, []int{11}
 }
 func (m *ValidatorActivationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +817,7 @@ func (m *ValidatorActivationResponse_Status) Reset()         { *m = ValidatorAct
 func (m *ValidatorActivationResponse_Status) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationResponse_Status) ProtoMessage()    {}
 func (*ValidatorActivationResponse_Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{9, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{11, 0}
 }
 func (m *ValidatorActivationResponse_Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +871,7 @@ func (m *ExitedValidatorsRequest) Reset()         { *m = ExitedValidatorsRequest
 func (m *ExitedValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*ExitedValidatorsRequest) ProtoMessage()    {}
 func (*ExitedValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{10}
+	return fileDescriptor_9eb4e94b85965285, []int{12}
 }
 func (m *ExitedValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +918,7 @@ func (m *ExitedValidatorsResponse) Reset()         { *m = ExitedValidatorsRespon
 func (m *ExitedValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*ExitedValidatorsResponse) ProtoMessage()    {}
 func (*ExitedValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{11}
+	return fileDescriptor_9eb4e94b85965285, []int{13}
 }
 func (m *ExitedValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,7 +966,7 @@ func (m *ChainStartResponse) Reset()         { *m = ChainStartResponse{} }
 func (m *ChainStartResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainStartResponse) ProtoMessage()    {}
 func (*ChainStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{12}
+	return fileDescriptor_9eb4e94b85965285, []int{14}
 }
 func (m *ChainStartResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +1020,7 @@ func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
 func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexRequest) ProtoMessage()    {}
 func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{13}
+	return fileDescriptor_9eb4e94b85965285, []int{15}
 }
 func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1067,7 @@ func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{}
 func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexResponse) ProtoMessage()    {}
 func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{14}
+	return fileDescriptor_9eb4e94b85965285, []int{16}
 }
 func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -997,7 +1115,7 @@ func (m *AssignmentRequest) Reset()         { *m = AssignmentRequest{} }
 func (m *AssignmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AssignmentRequest) ProtoMessage()    {}
 func (*AssignmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{15}
+	return fileDescriptor_9eb4e94b85965285, []int{17}
 }
 func (m *AssignmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1051,7 +1169,7 @@ func (m *AssignmentResponse) Reset()         { *m = AssignmentResponse{} }
 func (m *AssignmentResponse) String() string { return proto.CompactTextString(m) }
 func (*AssignmentResponse) ProtoMessage()    {}
 func (*AssignmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{16}
+	return fileDescriptor_9eb4e94b85965285, []int{18}
 }
 func (m *AssignmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,7 +1223,7 @@ func (m *AssignmentResponse_ValidatorAssignment) Reset() {
 func (m *AssignmentResponse_ValidatorAssignment) String() string { return proto.CompactTextString(m) }
 func (*AssignmentResponse_ValidatorAssignment) ProtoMessage()    {}
 func (*AssignmentResponse_ValidatorAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{16, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{18, 0}
 }
 func (m *AssignmentResponse_ValidatorAssignment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1191,7 +1309,7 @@ func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse
 func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatusResponse) ProtoMessage()    {}
 func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{17}
+	return fileDescriptor_9eb4e94b85965285, []int{19}
 }
 func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1267,7 +1385,7 @@ func (m *DomainRequest) Reset()         { *m = DomainRequest{} }
 func (m *DomainRequest) String() string { return proto.CompactTextString(m) }
 func (*DomainRequest) ProtoMessage()    {}
 func (*DomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{18}
+	return fileDescriptor_9eb4e94b85965285, []int{20}
 }
 func (m *DomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1439,7 @@ func (m *DomainResponse) Reset()         { *m = DomainResponse{} }
 func (m *DomainResponse) String() string { return proto.CompactTextString(m) }
 func (*DomainResponse) ProtoMessage()    {}
 func (*DomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{19}
+	return fileDescriptor_9eb4e94b85965285, []int{21}
 }
 func (m *DomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1368,7 +1486,7 @@ func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
 func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockTreeResponse) ProtoMessage()    {}
 func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20}
+	return fileDescriptor_9eb4e94b85965285, []int{22}
 }
 func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,7 +1536,7 @@ func (m *BlockTreeResponse_TreeNode) Reset()         { *m = BlockTreeResponse_Tr
 func (m *BlockTreeResponse_TreeNode) String() string { return proto.CompactTextString(m) }
 func (*BlockTreeResponse_TreeNode) ProtoMessage()    {}
 func (*BlockTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{22, 0}
 }
 func (m *BlockTreeResponse_TreeNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,7 +1605,7 @@ func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
 func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
 func (*TreeBlockSlotRequest) ProtoMessage()    {}
 func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{21}
+	return fileDescriptor_9eb4e94b85965285, []int{23}
 }
 func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1539,6 +1657,8 @@ func init() {
 	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
 	proto.RegisterType((*AggregationRequest)(nil), "ethereum.beacon.rpc.v1.AggregationRequest")
 	proto.RegisterType((*AggregationResponse)(nil), "ethereum.beacon.rpc.v1.AggregationResponse")
+	proto.RegisterType((*CommitteeSubnetsSubscribeRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeSubnetsSubscribeRequest")
+	proto.RegisterType((*CommitteeSubnetSubscription)(nil), "ethereum.beacon.rpc.v1.CommitteeSubnetSubscription")
 	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
 	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
 	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
@@ -1563,113 +1683,119 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
 
 var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
-	0x15, 0x0f, 0x25, 0x59, 0xab, 0x7d, 0x92, 0x65, 0x7a, 0xec, 0xb5, 0x15, 0xed, 0x9f, 0xb8, 0x4c,
-	0xd2, 0xd8, 0x5b, 0x44, 0xb2, 0x95, 0x60, 0xd1, 0x26, 0x48, 0x03, 0xd9, 0xe2, 0xda, 0x82, 0x17,
-	0xb2, 0x42, 0x69, 0xed, 0x14, 0x39, 0x10, 0x23, 0x6a, 0x2c, 0x11, 0x91, 0x38, 0x34, 0x39, 0x12,
-	0xe2, 0x4b, 0x81, 0x5e, 0x5a, 0xf4, 0xd6, 0x1e, 0x8a, 0x1e, 0x8b, 0x7e, 0x84, 0xa2, 0x87, 0x7e,
-	0x85, 0x1c, 0xfb, 0x01, 0x7a, 0x28, 0xf6, 0x93, 0x04, 0x9c, 0x19, 0x52, 0xd4, 0x3f, 0x5b, 0xce,
-	0x8d, 0xf3, 0xde, 0xfb, 0xbd, 0xbf, 0x33, 0x6f, 0xde, 0x10, 0x34, 0xd7, 0xa3, 0x8c, 0x96, 0x3b,
-	0x04, 0x5b, 0xd4, 0x29, 0x7b, 0xae, 0x55, 0x1e, 0x1f, 0x95, 0x7d, 0xe2, 0x8d, 0x6d, 0x8b, 0xf8,
-	0x25, 0xce, 0x44, 0x3b, 0x84, 0xf5, 0x89, 0x47, 0x46, 0xc3, 0x92, 0x10, 0x2b, 0x79, 0xae, 0x55,
-	0x1a, 0x1f, 0x15, 0x9f, 0xf6, 0x28, 0xed, 0x0d, 0x48, 0x99, 0x4b, 0x75, 0x46, 0xd7, 0x65, 0x32,
-	0x74, 0xd9, 0xad, 0x00, 0x15, 0x3f, 0x20, 0xac, 0x5f, 0x1e, 0x1f, 0xe1, 0x81, 0xdb, 0xc7, 0x47,
-	0x52, 0xbf, 0xd9, 0x19, 0x50, 0xeb, 0x7b, 0x29, 0xf0, 0x62, 0x4a, 0x00, 0x33, 0x46, 0x7c, 0x86,
-	0x99, 0x4d, 0x1d, 0xc1, 0xd7, 0x2c, 0xc8, 0x1d, 0x07, 0xe2, 0x06, 0xb9, 0x19, 0x11, 0x9f, 0x21,
-	0x04, 0x29, 0x7f, 0x40, 0x59, 0x41, 0xd9, 0x53, 0xf6, 0x53, 0x06, 0xff, 0x46, 0x1f, 0xc2, 0xba,
-	0x87, 0x9d, 0x2e, 0xa6, 0xa6, 0x47, 0xc6, 0x04, 0x0f, 0x0a, 0x89, 0x3d, 0x65, 0x3f, 0x67, 0xe4,
-	0x04, 0xd1, 0xe0, 0x34, 0x54, 0x84, 0x4c, 0xcf, 0xc3, 0xd7, 0xd7, 0x36, 0xb3, 0x0b, 0x49, 0xce,
-	0x8f, 0xd6, 0xda, 0x21, 0x6c, 0x34, 0x3d, 0xea, 0x52, 0x9f, 0x18, 0xc4, 0x77, 0xa9, 0xe3, 0x13,
-	0xf4, 0x1c, 0x80, 0xbb, 0x69, 0x7a, 0x54, 0x5a, 0xcb, 0x19, 0x8f, 0x39, 0xc5, 0xa0, 0x94, 0x69,
-	0x7f, 0x56, 0x00, 0x55, 0x27, 0xce, 0x86, 0xde, 0x3d, 0x07, 0x70, 0x47, 0x9d, 0x81, 0x6d, 0x99,
-	0xdf, 0x93, 0xdb, 0x10, 0x25, 0x28, 0xe7, 0xe4, 0x16, 0xed, 0xc2, 0x23, 0x97, 0x5a, 0x66, 0xc7,
-	0x66, 0xd2, 0xc5, 0xb4, 0x4b, 0xad, 0x63, 0x7b, 0x12, 0x55, 0x32, 0x16, 0xd5, 0x27, 0xb0, 0x61,
-	0xd1, 0xe1, 0xd0, 0x66, 0x8c, 0x10, 0xd3, 0x76, 0xba, 0xe4, 0x87, 0x42, 0x8a, 0xb3, 0xf3, 0x11,
-	0xb9, 0x1e, 0x50, 0xb5, 0x8f, 0x20, 0x2f, 0x5c, 0x89, 0x9c, 0x47, 0x90, 0x8a, 0xb9, 0xcd, 0xbf,
-	0xb5, 0xbf, 0x07, 0x1e, 0xf7, 0x7a, 0x1e, 0xe9, 0x4d, 0x79, 0xbc, 0x28, 0x9f, 0x0b, 0x2c, 0x27,
-	0x16, 0x59, 0x9e, 0x09, 0x37, 0x39, 0x1b, 0xee, 0xc7, 0x90, 0x0f, 0xf4, 0x99, 0xbe, 0xdd, 0x73,
-	0x30, 0x1b, 0x79, 0x84, 0x07, 0x90, 0x33, 0xd6, 0x03, 0x6a, 0x2b, 0x24, 0x6a, 0x07, 0xb0, 0x35,
-	0xe5, 0xd8, 0x1d, 0x41, 0x18, 0xf0, 0xf4, 0x12, 0x0f, 0xec, 0x2e, 0x66, 0xd4, 0x6b, 0x12, 0xef,
-	0x9a, 0x7a, 0x43, 0xec, 0x58, 0xe4, 0xae, 0x60, 0x3e, 0x80, 0xec, 0xc4, 0x47, 0xbf, 0x90, 0xd8,
-	0x4b, 0xee, 0xe7, 0x0c, 0x88, 0x9c, 0xf4, 0xb5, 0xbf, 0x25, 0xe0, 0xd9, 0x62, 0xa5, 0xd2, 0x91,
-	0x22, 0x64, 0x3a, 0x78, 0x10, 0x90, 0xfc, 0x82, 0xb2, 0x97, 0xdc, 0x4f, 0x19, 0xd1, 0x1a, 0x1d,
-	0x80, 0xca, 0x28, 0xc3, 0x03, 0x73, 0x1c, 0x6a, 0xf0, 0x65, 0xae, 0x36, 0x38, 0x3d, 0x52, 0xec,
-	0xa3, 0x57, 0xb0, 0x2b, 0x44, 0xb1, 0xc5, 0xec, 0x31, 0x89, 0x23, 0x44, 0xd9, 0x9f, 0x70, 0x76,
-	0x95, 0x73, 0x63, 0xb8, 0x4f, 0x01, 0x0d, 0x6d, 0xdf, 0xb7, 0x9d, 0x5e, 0x1c, 0x92, 0xe2, 0x71,
-	0x6c, 0x4a, 0x4e, 0x4c, 0xfc, 0x14, 0xf6, 0xf0, 0x98, 0x78, 0xb8, 0x47, 0xe6, 0x0c, 0x99, 0xd2,
-	0xed, 0xc2, 0xda, 0x9e, 0xb2, 0x9f, 0x30, 0x9e, 0x4b, 0xb9, 0x19, 0x8b, 0xc7, 0x42, 0x48, 0xfb,
-	0x0a, 0x8a, 0x11, 0x8d, 0x8b, 0x4c, 0xed, 0x9b, 0x99, 0xb4, 0x2a, 0x73, 0x69, 0xfd, 0x47, 0x22,
-	0x56, 0xab, 0x38, 0x5e, 0x66, 0xf5, 0x15, 0x3c, 0xc1, 0x82, 0x4a, 0xba, 0xe6, 0x9c, 0xaa, 0xe3,
-	0x44, 0x41, 0x31, 0xb6, 0x22, 0x81, 0x66, 0xa4, 0x17, 0x5d, 0x42, 0x26, 0x38, 0x74, 0x23, 0x9f,
-	0x88, 0x62, 0x66, 0x2b, 0x5f, 0x94, 0x16, 0x77, 0xa6, 0xd2, 0x1d, 0xe6, 0x4b, 0x2d, 0xae, 0xc3,
-	0x88, 0x74, 0x15, 0x5d, 0x48, 0x0b, 0xda, 0x7d, 0x87, 0xf8, 0x14, 0xd2, 0x02, 0xc4, 0x0b, 0x9d,
-	0xad, 0x94, 0xef, 0x35, 0x2f, 0x6d, 0x49, 0xd3, 0x86, 0x84, 0x6b, 0x5f, 0xc0, 0xae, 0xfe, 0x83,
-	0xcd, 0x48, 0x77, 0x52, 0xbd, 0x95, 0xb3, 0xfb, 0x25, 0x14, 0xe6, 0xb1, 0x32, 0xb3, 0xf7, 0x82,
-	0xbf, 0x01, 0x74, 0xd2, 0xc7, 0xb6, 0xd3, 0x62, 0xd8, 0x9b, 0x34, 0x8d, 0x02, 0x3c, 0xf2, 0x03,
-	0x02, 0xe9, 0xf2, 0x98, 0x33, 0x46, 0xb8, 0x44, 0xbf, 0x80, 0x5c, 0x8f, 0x38, 0xc4, 0xb7, 0x7d,
-	0x93, 0xd9, 0x43, 0x22, 0x37, 0x78, 0x56, 0xd2, 0xda, 0xf6, 0x90, 0x68, 0xaf, 0xe0, 0x49, 0xe4,
-	0x09, 0xef, 0x0d, 0xab, 0x75, 0x44, 0xad, 0x04, 0x3b, 0xb3, 0x38, 0xe9, 0xce, 0x36, 0xac, 0x89,
-	0xd6, 0x23, 0x0e, 0xb3, 0x58, 0x68, 0x6f, 0x61, 0xb3, 0xea, 0x07, 0xfd, 0x64, 0x48, 0x1c, 0x16,
-	0xcb, 0x16, 0x71, 0xa9, 0xd5, 0x37, 0xb9, 0xc3, 0x12, 0x00, 0x9c, 0xc4, 0x43, 0xbc, 0xbf, 0x07,
-	0xfc, 0x25, 0x09, 0x28, 0xae, 0x57, 0xfa, 0x70, 0x03, 0xdb, 0x93, 0xc3, 0x83, 0x23, 0x3e, 0x4f,
-	0x69, 0xb6, 0xf2, 0xdb, 0x65, 0x85, 0x9f, 0xd7, 0x14, 0xdb, 0x8a, 0x13, 0xde, 0xd6, 0x78, 0x9e,
-	0x58, 0xfc, 0x63, 0x02, 0xb6, 0x16, 0x08, 0xa3, 0x67, 0xf0, 0x38, 0x6a, 0xbe, 0xb2, 0x0b, 0x4d,
-	0x08, 0xab, 0x77, 0xec, 0x0f, 0x61, 0x5d, 0xdc, 0xb1, 0xc4, 0x33, 0x63, 0x37, 0x4e, 0x2e, 0x24,
-	0xb6, 0xe4, 0x7d, 0xea, 0x8a, 0xeb, 0x50, 0x0a, 0x89, 0x7b, 0x27, 0x17, 0x12, 0xb9, 0xd0, 0x74,
-	0x61, 0xd7, 0x66, 0x4f, 0xc9, 0xd7, 0xd1, 0x29, 0x49, 0xef, 0x29, 0xfb, 0xf9, 0xca, 0x27, 0xab,
-	0x9e, 0x92, 0xf0, 0x74, 0xfc, 0x27, 0x01, 0xbb, 0x4b, 0x4e, 0x50, 0x4c, 0xb9, 0xf2, 0xb3, 0x94,
-	0xa3, 0xdf, 0xc0, 0xfb, 0x84, 0xf5, 0x8f, 0xcc, 0x2e, 0x71, 0xa9, 0x6f, 0x33, 0x31, 0x91, 0x98,
-	0xce, 0x68, 0xd8, 0x21, 0x9e, 0xcc, 0x5c, 0x30, 0xee, 0x1c, 0xd5, 0x04, 0x9f, 0x4f, 0x20, 0x0d,
-	0xce, 0x45, 0x9f, 0xc3, 0x4e, 0x88, 0xb2, 0x1d, 0x6b, 0x30, 0xf2, 0x6d, 0xea, 0xc4, 0x53, 0xb9,
-	0x2d, 0xb9, 0xf5, 0x90, 0xc9, 0xb3, 0x75, 0x00, 0x2a, 0x8e, 0x9a, 0x90, 0xc9, 0xb7, 0xa6, 0xcc,
-	0xea, 0xc6, 0x84, 0xae, 0x07, 0x64, 0xf4, 0x35, 0x3c, 0xe3, 0x0a, 0x02, 0x41, 0xdb, 0x31, 0x63,
-	0xb0, 0x9b, 0x11, 0x19, 0x89, 0xe6, 0x9d, 0x32, 0xde, 0x0f, 0x65, 0xea, 0xce, 0xa4, 0xbb, 0x7d,
-	0x13, 0x08, 0x68, 0x5f, 0xc1, 0x7a, 0x8d, 0x0e, 0xb1, 0x1d, 0xf5, 0xea, 0x6d, 0x58, 0x13, 0x16,
-	0xe5, 0x51, 0xe2, 0x0b, 0xb4, 0x03, 0xe9, 0x2e, 0x17, 0x0b, 0x67, 0x11, 0xb1, 0xd2, 0xbe, 0x84,
-	0x7c, 0x08, 0x97, 0xe9, 0x3e, 0x00, 0x35, 0xba, 0xc2, 0x4d, 0x89, 0x11, 0xaa, 0x36, 0x22, 0xba,
-	0x80, 0x68, 0x7f, 0x4d, 0xc0, 0x26, 0xcf, 0x56, 0xdb, 0x23, 0x93, 0x1b, 0xf4, 0x35, 0xa4, 0x98,
-	0x27, 0xf7, 0x6d, 0xb6, 0x52, 0x59, 0x56, 0xad, 0x39, 0x60, 0x29, 0x58, 0x34, 0x68, 0x97, 0x18,
-	0x1c, 0x5f, 0xfc, 0xb7, 0x02, 0x99, 0x90, 0x84, 0x7e, 0x0d, 0x6b, 0xbc, 0x6c, 0xdc, 0x95, 0x6c,
-	0x45, 0x9b, 0x68, 0x25, 0xac, 0x5f, 0x0a, 0x47, 0xca, 0xd2, 0x31, 0x37, 0x21, 0x66, 0x48, 0x01,
-	0x98, 0x99, 0xed, 0x12, 0x33, 0xb3, 0x5d, 0x70, 0xe1, 0xba, 0xd8, 0x63, 0xb6, 0x65, 0xbb, 0xfc,
-	0x72, 0x1a, 0x53, 0x46, 0xc2, 0x3b, 0x7a, 0x33, 0xce, 0xb9, 0x0c, 0x18, 0x41, 0x73, 0x91, 0x23,
-	0x00, 0x97, 0x13, 0x55, 0x05, 0x71, 0xfb, 0x07, 0x14, 0xed, 0x0d, 0x6c, 0x07, 0x4e, 0x73, 0x17,
-	0x82, 0xcd, 0x10, 0x96, 0xe5, 0x29, 0x3c, 0xe6, 0xe3, 0xd1, 0xb5, 0x47, 0x87, 0x32, 0x9f, 0x99,
-	0x80, 0xf0, 0xda, 0xa3, 0xc3, 0x60, 0x54, 0xe4, 0x4c, 0x46, 0xe5, 0x7e, 0x4c, 0x07, 0xcb, 0x36,
-	0x7d, 0x79, 0x06, 0xeb, 0xd1, 0xae, 0x36, 0xe8, 0x80, 0xa0, 0x2c, 0x3c, 0x7a, 0xdb, 0x38, 0x6f,
-	0x5c, 0x5c, 0x35, 0xd4, 0xf7, 0x50, 0x0e, 0x32, 0xd5, 0x76, 0x5b, 0x6f, 0xb5, 0x75, 0x43, 0x55,
-	0x82, 0x55, 0xd3, 0xb8, 0x68, 0x5e, 0xb4, 0x74, 0x43, 0x4d, 0xa0, 0x3c, 0x40, 0xf5, 0xf4, 0xd4,
-	0xd0, 0x4f, 0xab, 0xed, 0x0b, 0x43, 0x4d, 0xbe, 0xfc, 0xa7, 0x02, 0x1b, 0x33, 0x07, 0x04, 0x21,
-	0xc8, 0x4b, 0x65, 0x66, 0xab, 0x5d, 0x6d, 0xbf, 0x6d, 0xa9, 0xef, 0xa1, 0x6d, 0x50, 0x6b, 0x7a,
-	0xf3, 0xa2, 0x55, 0x6f, 0x9b, 0x86, 0x7e, 0xa2, 0xd7, 0x2f, 0xf5, 0x9a, 0xaa, 0x04, 0x92, 0x4d,
-	0xbd, 0x51, 0xab, 0x37, 0x4e, 0xcd, 0xea, 0x49, 0xbb, 0x7e, 0xa9, 0xab, 0x09, 0x04, 0x90, 0x96,
-	0xdf, 0xc9, 0x80, 0x5f, 0x6f, 0xd4, 0xdb, 0xf5, 0x6a, 0x5b, 0xaf, 0x99, 0xfa, 0xb7, 0xf5, 0xb6,
-	0x9a, 0x42, 0x2a, 0xe4, 0xae, 0xea, 0xed, 0xb3, 0x9a, 0x51, 0xbd, 0xaa, 0x1e, 0xbf, 0xd1, 0xd5,
-	0xb5, 0x00, 0x11, 0xf0, 0xf4, 0x9a, 0x9a, 0x0e, 0x10, 0xe2, 0xdb, 0x6c, 0xbd, 0xa9, 0xb6, 0xce,
-	0xf4, 0x9a, 0xfa, 0xa8, 0xf2, 0x3f, 0x05, 0x36, 0xaa, 0x61, 0x6f, 0x12, 0xef, 0x11, 0xd4, 0x07,
-	0x24, 0x53, 0x18, 0x9b, 0xc0, 0xd1, 0xcb, 0xa5, 0xdd, 0x78, 0x6e, 0x4c, 0x2f, 0xfe, 0x72, 0xc9,
-	0x5e, 0x89, 0x89, 0xd6, 0x30, 0xc3, 0xc8, 0x84, 0xcd, 0xd6, 0xa8, 0x33, 0xb4, 0xa7, 0x0c, 0x69,
-	0xf7, 0x83, 0xe3, 0x06, 0x16, 0x39, 0x13, 0xee, 0xef, 0xca, 0x8f, 0x4a, 0xf4, 0xf2, 0x88, 0xc2,
-	0xfb, 0x16, 0x72, 0xd2, 0x4f, 0xbe, 0x63, 0xd0, 0x47, 0x77, 0x1e, 0x97, 0x30, 0xa4, 0x15, 0xb6,
-	0x3f, 0xfa, 0x0e, 0x72, 0xd2, 0x98, 0x58, 0xaf, 0x80, 0x29, 0x2e, 0x6d, 0xad, 0x33, 0x0f, 0xa6,
-	0xca, 0x9f, 0x14, 0xd8, 0x0c, 0xc7, 0x78, 0x1a, 0x05, 0xe3, 0xc1, 0xae, 0xcc, 0xa0, 0x64, 0x91,
-	0xaa, 0xd3, 0x6d, 0x7a, 0x94, 0x5e, 0xdf, 0x51, 0xb0, 0xb9, 0x57, 0x4a, 0xf1, 0x57, 0x2b, 0xc9,
-	0x4a, 0x4f, 0xfe, 0x95, 0x01, 0x75, 0xb2, 0xaf, 0xa5, 0x23, 0xdf, 0x01, 0x88, 0x16, 0xc5, 0x0b,
-	0xfb, 0xf1, 0x32, 0x7d, 0x53, 0x8d, 0x73, 0x79, 0x19, 0x67, 0x1a, 0xe4, 0xef, 0x61, 0xf3, 0x0a,
-	0xdb, 0xec, 0x75, 0x7c, 0xd2, 0x44, 0x95, 0x07, 0x8d, 0xa5, 0xc2, 0xe0, 0x67, 0x3f, 0x63, 0x94,
-	0x3d, 0x54, 0x10, 0x85, 0xfc, 0xf4, 0x14, 0x85, 0x3e, 0xbd, 0x57, 0x51, 0x7c, 0x4a, 0x2b, 0x96,
-	0x56, 0x15, 0x97, 0x01, 0x0f, 0x60, 0xeb, 0x24, 0x1c, 0x2c, 0x62, 0x43, 0xca, 0xc1, 0x2a, 0x13,
-	0x91, 0xb0, 0xf8, 0x72, 0xf5, 0xe1, 0x09, 0xdd, 0xcc, 0xf7, 0xa9, 0x07, 0xc6, 0xf7, 0xd0, 0x19,
-	0x1d, 0xfd, 0x41, 0x81, 0xed, 0x45, 0x8f, 0x42, 0x74, 0x7f, 0x85, 0xe6, 0xdf, 0xa5, 0xc5, 0xcf,
-	0x1f, 0x06, 0x92, 0x3e, 0x8c, 0x40, 0x9d, 0x9d, 0xf1, 0xd1, 0xd2, 0x40, 0x96, 0xbc, 0x24, 0x8a,
-	0x87, 0xab, 0x03, 0xa4, 0xd9, 0xdf, 0x45, 0x9b, 0x79, 0xf2, 0x48, 0x40, 0x3b, 0x25, 0xf1, 0x97,
-	0xa7, 0x14, 0xfe, 0xe5, 0x29, 0xe9, 0x43, 0x97, 0xdd, 0x2e, 0x2f, 0xe3, 0xfc, 0x03, 0xe3, 0x50,
-	0x41, 0xe7, 0xb0, 0x7e, 0x82, 0x1d, 0xea, 0xd8, 0x16, 0x1e, 0x9c, 0x11, 0xdc, 0x5d, 0xaa, 0x76,
-	0x95, 0x6e, 0x76, 0x0e, 0x59, 0xd9, 0x83, 0x82, 0x50, 0xe2, 0x6d, 0x72, 0x0a, 0x72, 0x49, 0x07,
-	0x23, 0x87, 0x61, 0xef, 0x36, 0x90, 0x2a, 0x2e, 0x31, 0x78, 0x9c, 0xfb, 0xf1, 0xdd, 0x0b, 0xe5,
-	0xbf, 0xef, 0x5e, 0x28, 0xff, 0x7f, 0xf7, 0x42, 0xe9, 0xa4, 0x39, 0xf7, 0xb3, 0x9f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xfd, 0x4f, 0x4f, 0xa7, 0x17, 0x13, 0x00, 0x00,
+	// 1782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x18, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x75, 0x29, 0xc9, 0x8a, 0xf2, 0x24, 0xdb, 0xf4, 0xd8, 0xb1, 0x15, 0x3a, 0xc9, 0xba, 0x4c, 0x36,
+	0x6b, 0xa7, 0x58, 0x39, 0x56, 0x16, 0xc1, 0x76, 0x17, 0xdb, 0x85, 0x6c, 0x31, 0x8e, 0x90, 0xc0,
+	0xd6, 0x52, 0x8a, 0xb3, 0x8b, 0x3d, 0x10, 0x14, 0x35, 0x96, 0x88, 0x95, 0x38, 0x0c, 0x39, 0x12,
+	0x36, 0x87, 0x16, 0xe8, 0xa5, 0x40, 0x6f, 0xed, 0xa1, 0xe8, 0xad, 0x45, 0x7f, 0x42, 0xd1, 0x43,
+	0xff, 0xc2, 0x1e, 0xfb, 0x03, 0x7a, 0x28, 0xf2, 0x23, 0x7a, 0x2e, 0xe6, 0x83, 0x14, 0xf5, 0xad,
+	0xe4, 0xc6, 0x79, 0xdf, 0x5f, 0xf3, 0xe6, 0x3d, 0x82, 0xee, 0x07, 0x84, 0x92, 0xe3, 0x16, 0xb6,
+	0x1d, 0xe2, 0x1d, 0x07, 0xbe, 0x73, 0x3c, 0x3c, 0x39, 0x0e, 0x71, 0x30, 0x74, 0x1d, 0x1c, 0x96,
+	0x38, 0x12, 0xed, 0x62, 0xda, 0xc5, 0x01, 0x1e, 0xf4, 0x4b, 0x82, 0xac, 0x14, 0xf8, 0x4e, 0x69,
+	0x78, 0xa2, 0xed, 0x77, 0x08, 0xe9, 0xf4, 0xf0, 0x31, 0xa7, 0x6a, 0x0d, 0xae, 0x8f, 0x71, 0xdf,
+	0xa7, 0x6f, 0x05, 0x93, 0xf6, 0x31, 0xa6, 0xdd, 0xe3, 0xe1, 0x89, 0xdd, 0xf3, 0xbb, 0xf6, 0x89,
+	0x94, 0x6f, 0xb5, 0x7a, 0xc4, 0xf9, 0x51, 0x12, 0xdc, 0x1b, 0x23, 0xb0, 0x29, 0xc5, 0x21, 0xb5,
+	0xa9, 0x4b, 0x3c, 0x81, 0xd7, 0x1d, 0x28, 0x9c, 0x32, 0x72, 0x13, 0xbf, 0x19, 0xe0, 0x90, 0x22,
+	0x04, 0x99, 0xb0, 0x47, 0x68, 0x51, 0x39, 0x50, 0x0e, 0x33, 0x26, 0xff, 0x46, 0xf7, 0x61, 0x3d,
+	0xb0, 0xbd, 0xb6, 0x4d, 0xac, 0x00, 0x0f, 0xb1, 0xdd, 0x2b, 0xa6, 0x0e, 0x94, 0xc3, 0x82, 0x59,
+	0x10, 0x40, 0x93, 0xc3, 0x90, 0x06, 0xb9, 0x4e, 0x60, 0x5f, 0x5f, 0xbb, 0xd4, 0x2d, 0xa6, 0x39,
+	0x3e, 0x3e, 0xeb, 0x8f, 0x61, 0xb3, 0x1e, 0x10, 0x9f, 0x84, 0xd8, 0xc4, 0xa1, 0x4f, 0xbc, 0x10,
+	0xa3, 0xbb, 0x00, 0xdc, 0x4c, 0x2b, 0x20, 0x52, 0x5b, 0xc1, 0xbc, 0xc9, 0x21, 0x26, 0x21, 0x54,
+	0xff, 0x83, 0x02, 0xa8, 0x32, 0x32, 0x36, 0xb2, 0xee, 0x2e, 0x80, 0x3f, 0x68, 0xf5, 0x5c, 0xc7,
+	0xfa, 0x11, 0xbf, 0x8d, 0xb8, 0x04, 0xe4, 0x05, 0x7e, 0x8b, 0xf6, 0xe0, 0x86, 0x4f, 0x1c, 0xab,
+	0xe5, 0x52, 0x69, 0x62, 0xd6, 0x27, 0xce, 0xa9, 0x3b, 0xf2, 0x2a, 0x9d, 0xf0, 0xea, 0x53, 0xd8,
+	0x74, 0x48, 0xbf, 0xef, 0x52, 0x8a, 0xb1, 0xe5, 0x7a, 0x6d, 0xfc, 0x53, 0x31, 0xc3, 0xd1, 0x1b,
+	0x31, 0xb8, 0xc6, 0xa0, 0xfa, 0x03, 0xd8, 0x10, 0xa6, 0xc4, 0xc6, 0x23, 0xc8, 0x24, 0xcc, 0xe6,
+	0xdf, 0xfa, 0x5f, 0x98, 0xc5, 0x9d, 0x4e, 0x80, 0x3b, 0x63, 0x16, 0xcf, 0x8a, 0xe7, 0x0c, 0xcd,
+	0xa9, 0x59, 0x9a, 0x27, 0xdc, 0x4d, 0x4f, 0xba, 0xfb, 0x09, 0x6c, 0x30, 0x79, 0x56, 0xe8, 0x76,
+	0x3c, 0x9b, 0x0e, 0x02, 0xcc, 0x1d, 0x28, 0x98, 0xeb, 0x0c, 0xda, 0x88, 0x80, 0xfa, 0x11, 0x6c,
+	0x8f, 0x19, 0xb6, 0xc0, 0x89, 0xdf, 0xc0, 0xc1, 0x59, 0x64, 0x42, 0x63, 0xd0, 0xf2, 0x30, 0x0d,
+	0x1b, 0x83, 0x56, 0xe8, 0x04, 0x6e, 0x0b, 0x47, 0x1e, 0x7d, 0x0f, 0xeb, 0xa1, 0x80, 0xf9, 0x4c,
+	0x5e, 0x58, 0x54, 0x0e, 0xd2, 0x87, 0xf9, 0xf2, 0x93, 0xd2, 0xec, 0xfa, 0x2d, 0x4d, 0x08, 0x6c,
+	0x24, 0x78, 0xcd, 0x71, 0x49, 0xfa, 0x5f, 0x15, 0xd8, 0x5f, 0x40, 0xbe, 0x2c, 0xfd, 0x51, 0xac,
+	0x53, 0x8b, 0x63, 0x9d, 0x9e, 0x19, 0xeb, 0xfb, 0xb0, 0xee, 0x86, 0x96, 0x2d, 0x03, 0x45, 0x02,
+	0x1e, 0xcb, 0x9c, 0x59, 0x70, 0xc3, 0x4a, 0x0c, 0xd3, 0x4d, 0xd8, 0xbf, 0xb2, 0x7b, 0x6e, 0x9b,
+	0x1d, 0xea, 0x38, 0xb8, 0x26, 0x41, 0xdf, 0xf6, 0x1c, 0xbc, 0x28, 0xd9, 0x1f, 0x43, 0x7e, 0x64,
+	0x73, 0x58, 0x4c, 0x1d, 0xa4, 0x0f, 0x0b, 0x26, 0xc4, 0x46, 0x87, 0xfa, 0x9f, 0x53, 0x70, 0x67,
+	0xb6, 0x50, 0x99, 0x28, 0x0d, 0x72, 0x2d, 0xbb, 0xc7, 0x40, 0x22, 0xd6, 0x19, 0x33, 0x3e, 0xa3,
+	0x23, 0x50, 0x29, 0xa1, 0x76, 0xcf, 0x1a, 0x46, 0x12, 0x42, 0xe9, 0xfe, 0x26, 0x87, 0xc7, 0x82,
+	0x43, 0xf4, 0x14, 0xf6, 0x04, 0xa9, 0xed, 0x50, 0x77, 0x88, 0x93, 0x1c, 0x22, 0x22, 0xb7, 0x38,
+	0xba, 0xc2, 0xb1, 0x09, 0xbe, 0xcf, 0x00, 0xf5, 0xdd, 0x30, 0x74, 0xbd, 0x4e, 0x92, 0x25, 0xc3,
+	0xfd, 0xd8, 0x92, 0x98, 0x04, 0xf9, 0x39, 0x1c, 0xd8, 0x43, 0x1c, 0xd8, 0x1d, 0x3c, 0xa5, 0xc8,
+	0x92, 0x66, 0x17, 0xd7, 0x0e, 0x94, 0xc3, 0x94, 0x79, 0x57, 0xd2, 0x4d, 0x68, 0x3c, 0x15, 0x44,
+	0xfa, 0xd7, 0xa0, 0xc5, 0x30, 0x4e, 0x32, 0x76, 0xaf, 0x26, 0xc2, 0xaa, 0x4c, 0x85, 0xf5, 0x6f,
+	0x29, 0xd8, 0x9f, 0xc9, 0x2f, 0xa3, 0xfa, 0x14, 0x6e, 0xd9, 0x02, 0x8a, 0xdb, 0xd6, 0x94, 0xa8,
+	0xd3, 0x54, 0x51, 0x31, 0xb7, 0x63, 0x82, 0x7a, 0x2c, 0x17, 0x5d, 0x41, 0x8e, 0x35, 0xa5, 0x41,
+	0x88, 0x45, 0x32, 0xf3, 0xe5, 0x2f, 0xe7, 0x55, 0xfe, 0x02, 0xf5, 0xa5, 0x06, 0x97, 0x61, 0xc6,
+	0xb2, 0x34, 0x1f, 0xb2, 0x02, 0xb6, 0xac, 0xca, 0xcf, 0x21, 0x2b, 0x98, 0x78, 0xa2, 0xf3, 0xe5,
+	0xe3, 0xa5, 0xea, 0xa5, 0x2e, 0xa9, 0xda, 0x94, 0xec, 0xfa, 0x97, 0xb0, 0x67, 0xfc, 0xe4, 0x52,
+	0xdc, 0x1e, 0x65, 0x6f, 0xe5, 0xe8, 0x7e, 0x05, 0xc5, 0x69, 0x5e, 0x19, 0xd9, 0xa5, 0xcc, 0xdf,
+	0x02, 0x3a, 0xeb, 0xda, 0xae, 0xd7, 0xa0, 0x76, 0x30, 0x6a, 0xaa, 0x45, 0xb8, 0x11, 0x32, 0x00,
+	0x6e, 0x73, 0x9f, 0x73, 0x66, 0x74, 0x44, 0xbf, 0x80, 0x42, 0x07, 0x7b, 0x38, 0x74, 0x43, 0x8b,
+	0xba, 0x7d, 0x2c, 0x0b, 0x3c, 0x2f, 0x61, 0x4d, 0xb7, 0x8f, 0xf5, 0xa7, 0x70, 0x2b, 0xb6, 0x84,
+	0xdf, 0xe7, 0xd5, 0x5e, 0x0c, 0xbd, 0x04, 0xbb, 0x93, 0x7c, 0xd2, 0x9c, 0x1d, 0x58, 0x13, 0xed,
+	0x42, 0x5c, 0x66, 0x71, 0xd0, 0x5f, 0xc1, 0x56, 0x25, 0x64, 0xfd, 0xb6, 0x8f, 0x3d, 0x9a, 0x88,
+	0x16, 0xf6, 0x89, 0xd3, 0xb5, 0xb8, 0xc1, 0x92, 0x01, 0x38, 0x88, 0xbb, 0xb8, 0xbc, 0x07, 0xfc,
+	0x31, 0x0d, 0x28, 0x29, 0x57, 0xda, 0xf0, 0x06, 0x76, 0x46, 0x97, 0xc7, 0x8e, 0xf1, 0xb2, 0xe3,
+	0xfe, 0x7a, 0x5e, 0xe2, 0xa7, 0x25, 0x25, 0x4a, 0x71, 0x84, 0xdb, 0x1e, 0x4e, 0x03, 0xb5, 0xdf,
+	0xa7, 0x60, 0x7b, 0x06, 0x31, 0xba, 0x03, 0x37, 0xe3, 0x86, 0x29, 0xbb, 0xd0, 0x08, 0xb0, 0xfa,
+	0x8b, 0x76, 0x1f, 0xd6, 0xc5, 0x0c, 0x82, 0x03, 0x2b, 0xf1, 0x22, 0x17, 0x22, 0x60, 0x43, 0xce,
+	0x1b, 0xbe, 0x18, 0x17, 0x24, 0x91, 0x78, 0x97, 0x0b, 0x11, 0x90, 0x13, 0x8d, 0x27, 0x76, 0x6d,
+	0xf2, 0x96, 0x7c, 0x13, 0xdf, 0x92, 0xec, 0x81, 0x72, 0xb8, 0x51, 0xfe, 0x74, 0xd5, 0x5b, 0x12,
+	0xdd, 0x8e, 0x7f, 0xa5, 0x60, 0x6f, 0xce, 0x0d, 0x4a, 0x08, 0x57, 0x3e, 0x48, 0x38, 0xfa, 0x15,
+	0xdc, 0xc6, 0xb4, 0x7b, 0x62, 0xb5, 0xb1, 0x4f, 0x42, 0x97, 0x8a, 0x89, 0xcd, 0xf2, 0x06, 0xfd,
+	0x16, 0x0e, 0x64, 0xe4, 0xd8, 0x38, 0x78, 0x52, 0x15, 0x78, 0x3e, 0xa1, 0x5d, 0x70, 0x2c, 0xfa,
+	0x1c, 0x76, 0x23, 0x2e, 0xd7, 0x73, 0x7a, 0x83, 0xd0, 0x25, 0x5e, 0x32, 0x94, 0x3b, 0x12, 0x5b,
+	0x8b, 0x90, 0x3c, 0x5a, 0x47, 0xa0, 0xda, 0x71, 0x13, 0xb2, 0x78, 0x69, 0xca, 0xa8, 0x6e, 0x8e,
+	0xe0, 0x06, 0x03, 0xa3, 0x6f, 0xe0, 0x0e, 0x17, 0xc0, 0x08, 0x5d, 0xcf, 0x4a, 0xb0, 0xbd, 0x19,
+	0xe0, 0x81, 0x68, 0xde, 0x19, 0xf3, 0x76, 0x44, 0x53, 0xf3, 0x46, 0xdd, 0xed, 0x5b, 0x46, 0xa0,
+	0x7f, 0x0d, 0xeb, 0x55, 0xd2, 0xb7, 0xdd, 0xb8, 0x57, 0xef, 0xc0, 0x9a, 0xd0, 0x28, 0xaf, 0x12,
+	0x3f, 0xa0, 0x5d, 0xc8, 0xb6, 0x39, 0x59, 0x34, 0xab, 0x89, 0x93, 0xfe, 0x15, 0x6c, 0x44, 0xec,
+	0x32, 0xdc, 0x47, 0xa0, 0xc6, 0x23, 0x8e, 0x25, 0x79, 0x84, 0xa8, 0xcd, 0x18, 0x2e, 0x58, 0xf4,
+	0x3f, 0xa5, 0x60, 0x8b, 0x47, 0xab, 0x19, 0xe0, 0xd1, 0x0b, 0xfa, 0x0c, 0x32, 0x34, 0x90, 0x75,
+	0x9b, 0x2f, 0x97, 0xe7, 0x65, 0x6b, 0x8a, 0xb1, 0xc4, 0x0e, 0x17, 0xa4, 0x8d, 0x4d, 0xce, 0xaf,
+	0xfd, 0x53, 0x81, 0x5c, 0x04, 0x42, 0x5f, 0xc0, 0x1a, 0x4f, 0x1b, 0x37, 0x25, 0x5f, 0xd6, 0x47,
+	0x52, 0x31, 0xed, 0x96, 0xa2, 0x91, 0xbb, 0x74, 0xca, 0x55, 0x70, 0xd1, 0xa6, 0x60, 0x98, 0x98,
+	0x7d, 0x53, 0x13, 0xb3, 0x2f, 0x7b, 0x70, 0x7d, 0x3b, 0xa0, 0xae, 0xe3, 0xfa, 0xfc, 0x71, 0x1a,
+	0x12, 0x8a, 0xa3, 0x37, 0x7a, 0x2b, 0x89, 0xb9, 0x62, 0x08, 0xd6, 0x5c, 0xe4, 0x08, 0xc0, 0xe9,
+	0x44, 0x56, 0x41, 0xbc, 0xfe, 0x0c, 0xa2, 0xbf, 0x84, 0x1d, 0x66, 0x34, 0x37, 0x81, 0x15, 0x43,
+	0x94, 0x96, 0x7d, 0xb8, 0xc9, 0xc7, 0xc7, 0xeb, 0x80, 0xf4, 0x65, 0x3c, 0x73, 0x0c, 0xf0, 0x2c,
+	0x20, 0x7d, 0x36, 0x4a, 0x73, 0x24, 0x25, 0xb2, 0x1e, 0xb3, 0xec, 0xd8, 0x24, 0x8f, 0x9e, 0xc3,
+	0x7a, 0x5c, 0xd5, 0x26, 0xe9, 0x61, 0x94, 0x87, 0x1b, 0xaf, 0x2e, 0x5e, 0x5c, 0x5c, 0xbe, 0xbe,
+	0x50, 0x3f, 0x42, 0x05, 0xc8, 0x55, 0x9a, 0x4d, 0xa3, 0xd1, 0x34, 0x4c, 0x55, 0x61, 0xa7, 0xba,
+	0x79, 0x59, 0xbf, 0x6c, 0x18, 0xa6, 0x9a, 0x42, 0x1b, 0x00, 0x95, 0xf3, 0x73, 0xd3, 0x38, 0xaf,
+	0x34, 0x2f, 0x4d, 0x35, 0xfd, 0xe8, 0xef, 0x0a, 0x6c, 0x4e, 0x5c, 0x10, 0x84, 0x60, 0x43, 0x0a,
+	0xb3, 0x1a, 0xcd, 0x4a, 0xf3, 0x55, 0x43, 0xfd, 0x08, 0xed, 0x80, 0x5a, 0x35, 0xea, 0x97, 0x8d,
+	0x5a, 0xd3, 0x32, 0x8d, 0x33, 0xa3, 0x76, 0x65, 0x54, 0x55, 0x85, 0x51, 0xd6, 0x8d, 0x8b, 0x6a,
+	0xed, 0xe2, 0xdc, 0xaa, 0x9c, 0x35, 0x6b, 0x57, 0x86, 0x9a, 0x42, 0x00, 0x59, 0xf9, 0x9d, 0x66,
+	0xf8, 0xda, 0x45, 0xad, 0x59, 0xab, 0x34, 0x8d, 0xaa, 0x65, 0x7c, 0x57, 0x6b, 0xaa, 0x19, 0xa4,
+	0x42, 0xe1, 0x75, 0xad, 0xf9, 0xbc, 0x6a, 0x56, 0x5e, 0x57, 0x4e, 0x5f, 0x1a, 0xea, 0x1a, 0xe3,
+	0x60, 0x38, 0xa3, 0xaa, 0x66, 0x19, 0x87, 0xf8, 0xb6, 0x1a, 0x2f, 0x2b, 0x8d, 0xe7, 0x46, 0x55,
+	0xbd, 0x51, 0xfe, 0x8f, 0x02, 0x9b, 0x95, 0xa8, 0x37, 0x89, 0x7d, 0x0d, 0x75, 0x01, 0xc9, 0x10,
+	0x26, 0x36, 0x14, 0xf4, 0x68, 0x6e, 0x37, 0x9e, 0x5a, 0x63, 0xb4, 0x87, 0x73, 0x6a, 0x25, 0x41,
+	0x5a, 0xb5, 0xa9, 0x8d, 0x2c, 0xd8, 0x6a, 0x0c, 0x5a, 0x7d, 0x77, 0x4c, 0x91, 0xbe, 0x9c, 0x59,
+	0x7b, 0xb8, 0xd8, 0x98, 0xa8, 0xbe, 0xcb, 0x3f, 0x2b, 0xf1, 0x66, 0x16, 0xbb, 0xf7, 0x1d, 0x14,
+	0xa4, 0x9d, 0xbc, 0x62, 0xd0, 0x83, 0x85, 0xd7, 0x25, 0x72, 0x69, 0x85, 0xf2, 0x47, 0x3f, 0x40,
+	0x41, 0x2a, 0x13, 0xe7, 0x15, 0x78, 0xb4, 0xb9, 0xad, 0x75, 0x62, 0xa1, 0x2c, 0xff, 0x4f, 0x81,
+	0xad, 0xd1, 0xa4, 0x1e, 0x39, 0x13, 0xc0, 0x9e, 0x8c, 0xa0, 0x44, 0xe1, 0x8a, 0xd7, 0xae, 0x07,
+	0x84, 0x5c, 0x2f, 0x48, 0xd8, 0xd4, 0x16, 0xa7, 0xfd, 0x72, 0x25, 0x5a, 0xd9, 0x6d, 0xfa, 0x70,
+	0x3b, 0x5e, 0x9a, 0x26, 0xb7, 0x29, 0xf4, 0xc5, 0x8a, 0x6b, 0xd2, 0xd4, 0xde, 0xa5, 0xed, 0x96,
+	0xc4, 0x8f, 0x80, 0x52, 0xf4, 0x23, 0xa0, 0x64, 0xb0, 0x1f, 0x01, 0xe5, 0x7f, 0xe4, 0x40, 0x1d,
+	0x5d, 0x23, 0xe9, 0xf7, 0x0f, 0x00, 0xa2, 0x23, 0xf2, 0x3a, 0xfa, 0x64, 0x9e, 0xd2, 0xb1, 0x3e,
+	0xad, 0x3d, 0x5c, 0x46, 0x26, 0x1d, 0xfc, 0x2d, 0x6c, 0xbd, 0xb6, 0x5d, 0xfa, 0x2c, 0x39, 0xd8,
+	0xa2, 0xf2, 0x7b, 0x4d, 0xc1, 0x42, 0xe1, 0x93, 0x0f, 0x98, 0x9c, 0x1f, 0x2b, 0x88, 0xc0, 0xc6,
+	0xf8, 0xd0, 0x86, 0x3e, 0x5b, 0x2a, 0x28, 0x39, 0x14, 0x6a, 0xa5, 0x55, 0xc9, 0xa5, 0xc3, 0x3d,
+	0xd8, 0x8e, 0xd3, 0x93, 0x98, 0x89, 0x8e, 0x56, 0x19, 0xc0, 0x84, 0xc6, 0x47, 0xab, 0xcf, 0x6a,
+	0xe8, 0xcd, 0x74, 0x5b, 0x7c, 0x4f, 0xff, 0xde, 0x77, 0x25, 0x40, 0xbf, 0x53, 0x60, 0x67, 0xd6,
+	0x0e, 0x8a, 0x96, 0x67, 0x68, 0x7a, 0x0d, 0xd6, 0x3e, 0x7f, 0x3f, 0x26, 0x69, 0xc3, 0x00, 0xd4,
+	0xc9, 0x95, 0x02, 0xcd, 0x75, 0x64, 0xce, 0xe2, 0xa2, 0x3d, 0x5e, 0x9d, 0x41, 0xaa, 0xfd, 0x3e,
+	0x2e, 0xe6, 0xd1, 0x4e, 0x82, 0xe6, 0xdc, 0xb5, 0xf9, 0x69, 0x9c, 0xde, 0x67, 0x1e, 0x2b, 0xe8,
+	0x05, 0xac, 0x9f, 0xd9, 0x1e, 0xf1, 0x5c, 0xc7, 0xee, 0x3d, 0xc7, 0x76, 0x7b, 0xae, 0xd8, 0x55,
+	0x9a, 0xe7, 0x0b, 0xc8, 0xcb, 0x96, 0xc7, 0x5c, 0x41, 0x0f, 0xe6, 0xb0, 0x5c, 0x91, 0xde, 0xc0,
+	0xa3, 0x76, 0xf0, 0x96, 0x51, 0xcd, 0xeb, 0x19, 0xa7, 0x85, 0x9f, 0xdf, 0xdd, 0x53, 0xfe, 0xfd,
+	0xee, 0x9e, 0xf2, 0xdf, 0x77, 0xf7, 0x94, 0x56, 0x96, 0x63, 0x9f, 0xfc, 0x7f, 0x00, 0x3a, 0x3a,
+	0x63, 0x9a, 0xa6, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1901,6 +2027,7 @@ var _ProposerService_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type AggregatorServiceClient interface {
 	SubmitAggregateAndProof(ctx context.Context, in *AggregationRequest, opts ...grpc.CallOption) (*AggregationResponse, error)
+	SubscribeCommitteeSubnets(ctx context.Context, in *CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type aggregatorServiceClient struct {
@@ -1920,9 +2047,19 @@ func (c *aggregatorServiceClient) SubmitAggregateAndProof(ctx context.Context, i
 	return out, nil
 }
 
+func (c *aggregatorServiceClient) SubscribeCommitteeSubnets(ctx context.Context, in *CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AggregatorService/SubscribeCommitteeSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AggregatorServiceServer is the server API for AggregatorService service.
 type AggregatorServiceServer interface {
 	SubmitAggregateAndProof(context.Context, *AggregationRequest) (*AggregationResponse, error)
+	SubscribeCommitteeSubnets(context.Context, *CommitteeSubnetsSubscribeRequest) (*types.Empty, error)
 }
 
 // UnimplementedAggregatorServiceServer can be embedded to have forward compatible implementations.
@@ -1932,6 +2069,9 @@ type UnimplementedAggregatorServiceServer struct {
 func (*UnimplementedAggregatorServiceServer) SubmitAggregateAndProof(ctx context.Context, req *AggregationRequest) (*AggregationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitAggregateAndProof not implemented")
 }
+func (*UnimplementedAggregatorServiceServer) SubscribeCommitteeSubnets(ctx context.Context, req *CommitteeSubnetsSubscribeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeCommitteeSubnets not implemented")
+}
 
 func RegisterAggregatorServiceServer(s *grpc.Server, srv AggregatorServiceServer) {
 	s.RegisterService(&_AggregatorService_serviceDesc, srv)
@@ -1955,6 +2095,24 @@ func _AggregatorService_SubmitAggregateAndProof_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AggregatorService_SubscribeCommitteeSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitteeSubnetsSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AggregatorServiceServer).SubscribeCommitteeSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AggregatorService/SubscribeCommitteeSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AggregatorServiceServer).SubscribeCommitteeSubnets(ctx, req.(*CommitteeSubnetsSubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AggregatorService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.AggregatorService",
 	HandlerType: (*AggregatorServiceServer)(nil),
@@ -1963,6 +2121,10 @@ var _AggregatorService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitAggregateAndProof",
 			Handler:    _AggregatorService_SubmitAggregateAndProof_Handler,
 		},
+		{
+			MethodName: "SubscribeCommitteeSubnets",
+			Handler:    _AggregatorService_SubscribeCommitteeSubnets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
@@ -2669,6 +2831,101 @@ func (m *AggregationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitteeSubnetsSubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeSubnetsSubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintServices(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeSubnetSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeSubnetSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeSubnetSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IsAggregator {
+		i--
+		if m.IsAggregator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Slot != 0 {
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3637,6 +3894,49 @@ func (m *AggregationResponse) Size() (n int) {
 	return n
 }
 
+func (m *CommitteeSubnetsSubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitteeSubnetSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovServices(uint64(m.CommitteeIndex))
+	}
+	if m.IsAggregator {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ValidatorPerformanceRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4767,6 +5067,240 @@ func (m *AggregationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommitteeSubnetsSubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeSubnetsSubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeSubnetsSubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, &CommitteeSubnetSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeSubnetSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeSubnetSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeSubnetSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAggregator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAggregator = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: proto/validator/rpc/v1/management.proto
+
+package ethereum_validator_rpc_v1
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListKeysResponse struct {
+	Keys                 []*ValidatorKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListKeysResponse) Reset()         { *m = ListKeysResponse{} }
+func (m *ListKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListKeysResponse) ProtoMessage()    {}
+func (*ListKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{0}
+}
+func (m *ListKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListKeysResponse.Unmarshal(m, b)
+}
+func (m *ListKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListKeysResponse.Merge(m, src)
+}
+func (m *ListKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListKeysResponse.Size(m)
+}
+func (m *ListKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListKeysResponse proto.InternalMessageInfo
+
+func (m *ListKeysResponse) GetKeys() []*ValidatorKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type ValidatorKey struct {
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Status of the validator in the beacon chain, such as ACTIVE or EXITED.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Index and balance in Gwei of the validator, only set once its deposit is processed.
+	Index                uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Balance              uint64   `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorKey) Reset()         { *m = ValidatorKey{} }
+func (m *ValidatorKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatorKey) ProtoMessage()    {}
+func (*ValidatorKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{1}
+}
+func (m *ValidatorKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorKey.Unmarshal(m, b)
+}
+func (m *ValidatorKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorKey.Marshal(b, m, deterministic)
+}
+func (m *ValidatorKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorKey.Merge(m, src)
+}
+func (m *ValidatorKey) XXX_Size() int {
+	return xxx_messageInfo_ValidatorKey.Size(m)
+}
+func (m *ValidatorKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorKey proto.InternalMessageInfo
+
+func (m *ValidatorKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorKey) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ValidatorKey) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ValidatorKey) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type ListDutiesResponse struct {
+	Duties               []*Duty  `protobuf:"bytes,1,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDutiesResponse) Reset()         { *m = ListDutiesResponse{} }
+func (m *ListDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDutiesResponse) ProtoMessage()    {}
+func (*ListDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{2}
+}
+func (m *ListDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDutiesResponse.Unmarshal(m, b)
+}
+func (m *ListDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDutiesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDutiesResponse.Merge(m, src)
+}
+func (m *ListDutiesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDutiesResponse.Size(m)
+}
+func (m *ListDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDutiesResponse proto.InternalMessageInfo
+
+func (m *ListDutiesResponse) GetDuties() []*Duty {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+type Duty struct {
+	PublicKey      []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ValidatorIndex uint64 `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	CommitteeIndex uint64 `protobuf:"varint,3,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	AttesterSlot   uint64 `protobuf:"varint,4,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	// Slot of the block to propose, zero if the validator does not propose in the epoch.
+	ProposerSlot         uint64   `protobuf:"varint,5,opt,name=proposer_slot,json=proposerSlot,proto3" json:"proposer_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Duty) Reset()         { *m = Duty{} }
+func (m *Duty) String() string { return proto.CompactTextString(m) }
+func (*Duty) ProtoMessage()    {}
+func (*Duty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{3}
+}
+func (m *Duty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Duty.Unmarshal(m, b)
+}
+func (m *Duty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Duty.Marshal(b, m, deterministic)
+}
+func (m *Duty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Duty.Merge(m, src)
+}
+func (m *Duty) XXX_Size() int {
+	return xxx_messageInfo_Duty.Size(m)
+}
+func (m *Duty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Duty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Duty proto.InternalMessageInfo
+
+func (m *Duty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Duty) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *Duty) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *Duty) GetAttesterSlot() uint64 {
+	if m != nil {
+		return m.AttesterSlot
+	}
+	return 0
+}
+
+func (m *Duty) GetProposerSlot() uint64 {
+	if m != nil {
+		return m.ProposerSlot
+	}
+	return 0
+}
+
+type SlashingProtectionHistoryRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlashingProtectionHistoryRequest) Reset()         { *m = SlashingProtectionHistoryRequest{} }
+func (m *SlashingProtectionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*SlashingProtectionHistoryRequest) ProtoMessage()    {}
+func (*SlashingProtectionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{4}
+}
+func (m *SlashingProtectionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlashingProtectionHistoryRequest.Unmarshal(m, b)
+}
+func (m *SlashingProtectionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlashingProtectionHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *SlashingProtectionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingProtectionHistoryRequest.Merge(m, src)
+}
+func (m *SlashingProtectionHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_SlashingProtectionHistoryRequest.Size(m)
+}
+func (m *SlashingProtectionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingProtectionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingProtectionHistoryRequest proto.InternalMessageInfo
+
+func (m *SlashingProtectionHistoryRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type SlashingProtectionHistory struct {
+	ProposedEpochs       []uint64             `protobuf:"varint,1,rep,packed,name=proposed_epochs,json=proposedEpochs,proto3" json:"proposed_epochs,omitempty"`
+	Attestations         []*AttestationRecord `protobuf:"bytes,2,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SlashingProtectionHistory) Reset()         { *m = SlashingProtectionHistory{} }
+func (m *SlashingProtectionHistory) String() string { return proto.CompactTextString(m) }
+func (*SlashingProtectionHistory) ProtoMessage()    {}
+func (*SlashingProtectionHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{5}
+}
+func (m *SlashingProtectionHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlashingProtectionHistory.Unmarshal(m, b)
+}
+func (m *SlashingProtectionHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlashingProtectionHistory.Marshal(b, m, deterministic)
+}
+func (m *SlashingProtectionHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingProtectionHistory.Merge(m, src)
+}
+func (m *SlashingProtectionHistory) XXX_Size() int {
+	return xxx_messageInfo_SlashingProtectionHistory.Size(m)
+}
+func (m *SlashingProtectionHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingProtectionHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingProtectionHistory proto.InternalMessageInfo
+
+func (m *SlashingProtectionHistory) GetProposedEpochs() []uint64 {
+	if m != nil {
+		return m.ProposedEpochs
+	}
+	return nil
+}
+
+func (m *SlashingProtectionHistory) GetAttestations() []*AttestationRecord {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type AttestationRecord struct {
+	SourceEpoch          uint64   `protobuf:"varint,1,opt,name=source_epoch,json=sourceEpoch,proto3" json:"source_epoch,omitempty"`
+	TargetEpoch          uint64   `protobuf:"varint,2,opt,name=target_epoch,json=targetEpoch,proto3" json:"target_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationRecord) Reset()         { *m = AttestationRecord{} }
+func (m *AttestationRecord) String() string { return proto.CompactTextString(m) }
+func (*AttestationRecord) ProtoMessage()    {}
+func (*AttestationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{6}
+}
+func (m *AttestationRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationRecord.Unmarshal(m, b)
+}
+func (m *AttestationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationRecord.Marshal(b, m, deterministic)
+}
+func (m *AttestationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationRecord.Merge(m, src)
+}
+func (m *AttestationRecord) XXX_Size() int {
+	return xxx_messageInfo_AttestationRecord.Size(m)
+}
+func (m *AttestationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationRecord proto.InternalMessageInfo
+
+func (m *AttestationRecord) GetSourceEpoch() uint64 {
+	if m != nil {
+		return m.SourceEpoch
+	}
+	return 0
+}
+
+func (m *AttestationRecord) GetTargetEpoch() uint64 {
+	if m != nil {
+		return m.TargetEpoch
+	}
+	return 0
+}
+
+type ProposeExitRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposeExitRequest) Reset()         { *m = ProposeExitRequest{} }
+func (m *ProposeExitRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposeExitRequest) ProtoMessage()    {}
+func (*ProposeExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7edc0d196608c02, []int{7}
+}
+func (m *ProposeExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposeExitRequest.Unmarshal(m, b)
+}
+func (m *ProposeExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposeExitRequest.Marshal(b, m, deterministic)
+}
+func (m *ProposeExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeExitRequest.Merge(m, src)
+}
+func (m *ProposeExitRequest) XXX_Size() int {
+	return xxx_messageInfo_ProposeExitRequest.Size(m)
+}
+func (m *ProposeExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeExitRequest proto.InternalMessageInfo
+
+func (m *ProposeExitRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListKeysResponse)(nil), "ethereum.validator.rpc.v1.ListKeysResponse")
+	proto.RegisterType((*ValidatorKey)(nil), "ethereum.validator.rpc.v1.ValidatorKey")
+	proto.RegisterType((*ListDutiesResponse)(nil), "ethereum.validator.rpc.v1.ListDutiesResponse")
+	proto.RegisterType((*Duty)(nil), "ethereum.validator.rpc.v1.Duty")
+	proto.RegisterType((*SlashingProtectionHistoryRequest)(nil), "ethereum.validator.rpc.v1.SlashingProtectionHistoryRequest")
+	proto.RegisterType((*SlashingProtectionHistory)(nil), "ethereum.validator.rpc.v1.SlashingProtectionHistory")
+	proto.RegisterType((*AttestationRecord)(nil), "ethereum.validator.rpc.v1.AttestationRecord")
+	proto.RegisterType((*ProposeExitRequest)(nil), "ethereum.validator.rpc.v1.ProposeExitRequest")
+}
+
+func init() { proto.RegisterFile("proto/validator/rpc/v1/management.proto", fileDescriptor_a7edc0d196608c02) }
+
+var fileDescriptor_a7edc0d196608c02 = []byte{
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x95, 0x43, 0xe0, 0xfb, 0xb8, 0x49, 0xa1, 0x1d, 0x21, 0x6a, 0x52, 0x50, 0xc3, 0xb0, 0x48,
+	0xfa, 0x67, 0x0b, 0xa8, 0x54, 0xa9, 0xac, 0x90, 0x40, 0x6d, 0x45, 0x51, 0x91, 0x91, 0x2a, 0x75,
+	0x15, 0x4d, 0x9c, 0xdb, 0x60, 0xe1, 0x78, 0x8c, 0xe7, 0x3a, 0xc2, 0xdb, 0xbe, 0x42, 0xa5, 0xae,
+	0xfb, 0x20, 0xdd, 0xf4, 0x19, 0xfa, 0x0a, 0x7d, 0x90, 0xca, 0x33, 0x76, 0x48, 0x40, 0x49, 0xaa,
+	0x2e, 0xe7, 0xf8, 0xdc, 0xb9, 0xe7, 0xcc, 0xbd, 0xc7, 0xd0, 0x8a, 0x13, 0x49, 0xd2, 0x1d, 0x8a,
+	0x30, 0xe8, 0x09, 0x92, 0x89, 0x9b, 0xc4, 0xbe, 0x3b, 0xdc, 0x75, 0x07, 0x22, 0x12, 0x7d, 0x1c,
+	0x60, 0x44, 0x8e, 0x66, 0xb0, 0x0d, 0xa4, 0x0b, 0x4c, 0x30, 0x1d, 0x38, 0x23, 0xae, 0x93, 0xc4,
+	0xbe, 0x33, 0xdc, 0x6d, 0x6c, 0xf6, 0xa5, 0xec, 0x87, 0xe8, 0x8a, 0x38, 0x70, 0x45, 0x14, 0x49,
+	0x12, 0x14, 0xc8, 0x48, 0x99, 0xc2, 0xc6, 0xa3, 0xe2, 0xab, 0x3e, 0x75, 0xd3, 0xcf, 0x2e, 0x0e,
+	0x62, 0xca, 0xcc, 0x47, 0xfe, 0x01, 0xee, 0xbf, 0x0f, 0x14, 0x9d, 0x60, 0xa6, 0x3c, 0x54, 0xb1,
+	0x8c, 0x14, 0xb2, 0x03, 0xa8, 0x5e, 0x62, 0xa6, 0x6c, 0xab, 0xb9, 0xd0, 0xae, 0xed, 0xb5, 0x9c,
+	0xa9, 0x8d, 0x9d, 0x8f, 0x25, 0x70, 0x82, 0x99, 0xa7, 0x8b, 0x78, 0x0a, 0xf5, 0x71, 0x94, 0x6d,
+	0x01, 0xc4, 0x69, 0x37, 0x0c, 0xfc, 0xce, 0x25, 0x66, 0xb6, 0xd5, 0xb4, 0xda, 0x75, 0x6f, 0xd9,
+	0x20, 0xf9, 0xe7, 0x75, 0x58, 0x52, 0x24, 0x28, 0x55, 0x76, 0xa5, 0x69, 0xb5, 0x97, 0xbd, 0xe2,
+	0xc4, 0xd6, 0x60, 0x31, 0x88, 0x7a, 0x78, 0x6d, 0x2f, 0x34, 0xad, 0x76, 0xd5, 0x33, 0x07, 0x66,
+	0xc3, 0x7f, 0x5d, 0x11, 0x8a, 0xc8, 0x47, 0xbb, 0xaa, 0xf1, 0xf2, 0xc8, 0x4f, 0x81, 0xe5, 0x3e,
+	0x8e, 0x52, 0x0a, 0xf0, 0xc6, 0xc9, 0x2b, 0x58, 0xea, 0x69, 0xa4, 0xf0, 0xf2, 0x78, 0x86, 0x97,
+	0xa3, 0x94, 0x32, 0xaf, 0xa0, 0xf3, 0x9f, 0x16, 0x54, 0x73, 0x60, 0x9e, 0xfc, 0x16, 0xac, 0x8e,
+	0x2e, 0xea, 0x18, 0xc1, 0x15, 0x2d, 0x6c, 0x65, 0x04, 0xbf, 0xd3, 0xca, 0x5b, 0xb0, 0xea, 0xcb,
+	0xc1, 0x20, 0x20, 0x42, 0xec, 0x8c, 0x3b, 0x5b, 0x19, 0xc1, 0x86, 0xb8, 0x03, 0xf7, 0x04, 0x11,
+	0x2a, 0xc2, 0xa4, 0xa3, 0x42, 0x49, 0x85, 0xd1, 0x7a, 0x09, 0x9e, 0x87, 0x92, 0x72, 0x52, 0x9c,
+	0xc8, 0x58, 0xaa, 0x92, 0xb4, 0x68, 0x48, 0x25, 0x98, 0x93, 0xf8, 0x21, 0x34, 0xcf, 0x43, 0xa1,
+	0x2e, 0x82, 0xa8, 0x7f, 0x96, 0x48, 0x42, 0x3f, 0x5f, 0x8a, 0xb7, 0x81, 0x22, 0x99, 0x64, 0x1e,
+	0x5e, 0xa5, 0xa8, 0x68, 0x8e, 0x3d, 0xfe, 0xcd, 0x82, 0x8d, 0xa9, 0x77, 0xe4, 0x9e, 0x8a, 0x86,
+	0xbd, 0x0e, 0xc6, 0xd2, 0xbf, 0x30, 0xcf, 0x5c, 0xf5, 0x56, 0x4a, 0xf8, 0x58, 0xa3, 0xec, 0x0c,
+	0x0a, 0xf9, 0x66, 0x2f, 0xed, 0x8a, 0x1e, 0xc6, 0xf3, 0x19, 0xc3, 0x38, 0xbc, 0xa1, 0x7b, 0xe8,
+	0xcb, 0xa4, 0xe7, 0x4d, 0xdc, 0xc0, 0x3f, 0xc1, 0x83, 0x3b, 0x14, 0xb6, 0x0d, 0x75, 0x25, 0xd3,
+	0xc4, 0x47, 0xa3, 0x46, 0xdb, 0xa9, 0x7a, 0x35, 0x83, 0x69, 0x29, 0x39, 0x85, 0x44, 0xd2, 0x47,
+	0x2a, 0x28, 0x66, 0x58, 0x35, 0x83, 0x69, 0x0a, 0xdf, 0x07, 0x76, 0x66, 0xe4, 0x1f, 0x5f, 0x07,
+	0xf4, 0x77, 0x0f, 0xb5, 0xf7, 0xbd, 0x0a, 0x70, 0x3a, 0x4a, 0x2c, 0xbb, 0x84, 0xff, 0xcb, 0x54,
+	0xb1, 0x75, 0xc7, 0xe4, 0xcf, 0x29, 0xf3, 0xe7, 0x1c, 0xe7, 0xf9, 0x6b, 0x3c, 0x9b, 0x61, 0xff,
+	0x76, 0x24, 0x79, 0xe3, 0xcb, 0xaf, 0xdf, 0x5f, 0x2b, 0x6b, 0x8c, 0x8d, 0xfd, 0x28, 0x86, 0xbb,
+	0x6e, 0x9e, 0x38, 0x76, 0x05, 0x70, 0xb3, 0xfa, 0x53, 0xdb, 0xbd, 0x98, 0xd3, 0x6e, 0x32, 0x39,
+	0x7c, 0x53, 0x37, 0x5c, 0x67, 0x6b, 0x93, 0x0d, 0x4d, 0x3c, 0xd8, 0x0f, 0x0b, 0x36, 0xdf, 0x20,
+	0x4d, 0x5f, 0x8d, 0x83, 0x19, 0xdd, 0xe6, 0x2d, 0x65, 0xe3, 0xe5, 0xbf, 0x14, 0xf3, 0x27, 0x5a,
+	0xf1, 0x0e, 0xdb, 0x9e, 0x54, 0xac, 0x8a, 0x82, 0x4e, 0x3c, 0xaa, 0x60, 0x0a, 0x6a, 0x63, 0x23,
+	0x66, 0xb3, 0x9e, 0xe6, 0xee, 0x2a, 0x34, 0xa6, 0xbc, 0x30, 0xdf, 0xd2, 0x02, 0x1e, 0xf2, 0x5b,
+	0x33, 0xc2, 0xeb, 0x80, 0x5e, 0x5b, 0x4f, 0xbb, 0x4b, 0x9a, 0xbe, 0xff, 0x67, 0x00, 0x04, 0xfc,
+	0xd9, 0xe8, 0xf1, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagementClient is the client API for Management service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagementClient interface {
+	ListKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListKeysResponse, error)
+	// Lists the duties of the current epoch, as last fetched from the beacon node.
+	ListDuties(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDutiesResponse, error)
+	// Returns the slashing protection history held by the validator client for a key.
+	GetSlashingProtectionHistory(ctx context.Context, in *SlashingProtectionHistoryRequest, opts ...grpc.CallOption) (*SlashingProtectionHistory, error)
+	// Signs a voluntary exit at the current epoch for a key and submits it to the beacon node.
+	ProposeExit(ctx context.Context, in *ProposeExitRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type managementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewManagementClient(cc *grpc.ClientConn) ManagementClient {
+	return &managementClient{cc}
+}
+
+func (c *managementClient) ListKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.rpc.v1.Management/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) ListDuties(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDutiesResponse, error) {
+	out := new(ListDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.rpc.v1.Management/ListDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) GetSlashingProtectionHistory(ctx context.Context, in *SlashingProtectionHistoryRequest, opts ...grpc.CallOption) (*SlashingProtectionHistory, error) {
+	out := new(SlashingProtectionHistory)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.rpc.v1.Management/GetSlashingProtectionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) ProposeExit(ctx context.Context, in *ProposeExitRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.rpc.v1.Management/ProposeExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagementServer is the server API for Management service.
+type ManagementServer interface {
+	ListKeys(context.Context, *empty.Empty) (*ListKeysResponse, error)
+	// Lists the duties of the current epoch, as last fetched from the beacon node.
+	ListDuties(context.Context, *empty.Empty) (*ListDutiesResponse, error)
+	// Returns the slashing protection history held by the validator client for a key.
+	GetSlashingProtectionHistory(context.Context, *SlashingProtectionHistoryRequest) (*SlashingProtectionHistory, error)
+	// Signs a voluntary exit at the current epoch for a key and submits it to the beacon node.
+	ProposeExit(context.Context, *ProposeExitRequest) (*empty.Empty, error)
+}
+
+// UnimplementedManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedManagementServer struct {
+}
+
+func (*UnimplementedManagementServer) ListKeys(ctx context.Context, req *empty.Empty) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (*UnimplementedManagementServer) ListDuties(ctx context.Context, req *empty.Empty) (*ListDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuties not implemented")
+}
+func (*UnimplementedManagementServer) GetSlashingProtectionHistory(ctx context.Context, req *SlashingProtectionHistoryRequest) (*SlashingProtectionHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSlashingProtectionHistory not implemented")
+}
+func (*UnimplementedManagementServer) ProposeExit(ctx context.Context, req *ProposeExitRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeExit not implemented")
+}
+
+func RegisterManagementServer(s *grpc.Server, srv ManagementServer) {
+	s.RegisterService(&_Management_serviceDesc, srv)
+}
+
+func _Management_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.rpc.v1.Management/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).ListKeys(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_ListDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).ListDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.rpc.v1.Management/ListDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).ListDuties(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_GetSlashingProtectionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlashingProtectionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).GetSlashingProtectionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.rpc.v1.Management/GetSlashingProtectionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).GetSlashingProtectionHistory(ctx, req.(*SlashingProtectionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_ProposeExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).ProposeExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.rpc.v1.Management/ProposeExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).ProposeExit(ctx, req.(*ProposeExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Management_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.rpc.v1.Management",
+	HandlerType: (*ManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListKeys",
+			Handler:    _Management_ListKeys_Handler,
+		},
+		{
+			MethodName: "ListDuties",
+			Handler:    _Management_ListDuties_Handler,
+		},
+		{
+			MethodName: "GetSlashingProtectionHistory",
+			Handler:    _Management_GetSlashingProtectionHistory_Handler,
+		},
+		{
+			MethodName: "ProposeExit",
+			Handler:    _Management_ProposeExit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/rpc/v1/management.proto",
+}
@@ -125,10 +125,10 @@ var (
 			"would whitelist connections to peers on your local network only. The default " +
 			"is to accept all connections.",
 	}
-	// P2PEncoding defines the encoding format for p2p messages.
+	// P2PEncoding defines the encoding format for p2p gossip messages.
 	P2PEncoding = cli.StringFlag{
 		Name:  "p2p-encoding",
-		Usage: "The encoding format of messages sent over the wire. The default is 0, which represents ssz",
+		Usage: "The encoding format of gossip messages sent over the wire, ssz or ssz-snappy. Req/resp streams negotiate their encoding with each peer, preferring ssz-snappy",
 		Value: "ssz",
 	}
 	// ForceClearDB removes any previously stored data at the data directory.
@@ -163,4 +163,9 @@ var (
 		Name:  "enable-upnp",
 		Usage: "Enable the service (Beacon chain or Validator) to use UPnP when possible.",
 	}
+	// ChainConfigFileFlag specifies the path to a chain config file.
+	ChainConfigFileFlag = cli.StringFlag{
+		Name:  "chain-config-file",
+		Usage: "The path to a YAML file with chain config values, in the format of the eth2 spec configs",
+	}
 )
@@ -0,0 +1,122 @@
+// Package hdkey implements the hierarchical deterministic derivation of BLS keys from a
+// seed, as specified by EIP-2333 and EIP-2334, and the BIP-39 mnemonics the seeds are
+// backed up with.
+package hdkey
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"github.com/minio/sha256-simd"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// minSeedLength is the minimum length of the seeds keys are derived from.
+	minSeedLength = 32
+	// keyLength is the length in bytes of secret keys and of the chunks of Lamport keys.
+	keyLength = 32
+	// lamportChunks is the number of chunks of the Lamport keys used to derive child keys.
+	lamportChunks = 255
+	// okmLength is the length of the output keying material reduced into a secret key.
+	okmLength = 48
+	// keygenSalt is the initial salt of the key generation of the BLS signature draft.
+	keygenSalt = "BLS-SIG-KEYGEN-SALT-"
+)
+
+var curveOrder = func() *big.Int {
+	order, ok := new(big.Int).SetString(bls.CurveOrder, 10)
+	if !ok {
+		panic("could not set bls curve order as big int")
+	}
+	return order
+}()
+
+// DeriveMasterSK derives the master secret key of a seed, as specified by EIP-2333:
+// https://eips.ethereum.org/EIPS/eip-2333
+func DeriveMasterSK(seed []byte) (*big.Int, error) {
+	if len(seed) < minSeedLength {
+		return nil, errors.Errorf("seed must be at least %d bytes long, received %d", minSeedLength, len(seed))
+	}
+	return hkdfModR(seed)
+}
+
+// DeriveChildSK derives the secret key of a child of a parent secret key at an index, as
+// specified by EIP-2333.
+func DeriveChildSK(parentSK *big.Int, index uint32) (*big.Int, error) {
+	compressedLamportPK, err := parentSKToLamportPK(parentSK, index)
+	if err != nil {
+		return nil, err
+	}
+	return hkdfModR(compressedLamportPK)
+}
+
+// parentSKToLamportPK computes the compressed Lamport public key of a parent secret key
+// at an index, from which the child secret key is derived.
+func parentSKToLamportPK(parentSK *big.Int, index uint32) ([]byte, error) {
+	salt := make([]byte, 4)
+	binary.BigEndian.PutUint32(salt, index)
+	ikm := i2osp(parentSK)
+	notIKM := make([]byte, len(ikm))
+	for i, b := range ikm {
+		notIKM[i] = ^b
+	}
+
+	h := sha256.New()
+	for _, secret := range [][]byte{ikm, notIKM} {
+		lamportSK, err := ikmToLamportSK(secret, salt)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < lamportChunks; i++ {
+			chunk := sha256.Sum256(lamportSK[i*keyLength : (i+1)*keyLength])
+			h.Write(chunk[:])
+		}
+	}
+	return h.Sum(nil), nil
+}
+
+// ikmToLamportSK expands input keying material into the chunks of a Lamport secret key.
+func ikmToLamportSK(ikm, salt []byte) ([]byte, error) {
+	okm := make([]byte, keyLength*lamportChunks)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, nil), okm); err != nil {
+		return nil, errors.Wrap(err, "could not expand lamport secret key")
+	}
+	return okm, nil
+}
+
+// hkdfModR derives a non-zero secret key from input keying material, as specified by the
+// key generation of the BLS signature draft.
+func hkdfModR(ikm []byte) (*big.Int, error) {
+	salt := []byte(keygenSalt)
+	ikmPostfixed := append(append([]byte{}, ikm...), 0)
+	info := []byte{0, okmLength}
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		hash := sha256.Sum256(salt)
+		salt = hash[:]
+		okm := make([]byte, okmLength)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, ikmPostfixed, salt, info), okm); err != nil {
+			return nil, errors.Wrap(err, "could not expand secret key")
+		}
+		sk.SetBytes(okm)
+		sk.Mod(sk, curveOrder)
+	}
+	return sk, nil
+}
+
+// i2osp encodes a secret key as a big endian byte slice of the key length.
+func i2osp(sk *big.Int) []byte {
+	b := sk.Bytes()
+	out := make([]byte, keyLength)
+	copy(out[keyLength-len(b):], b)
+	return out
+}
+
+// secretKeyFromInt converts a derived secret key to a BLS secret key.
+func secretKeyFromInt(sk *big.Int) (*bls.SecretKey, error) {
+	return bls.SecretKeyFromBytes(i2osp(sk))
+}
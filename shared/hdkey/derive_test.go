@@ -0,0 +1,119 @@
+package hdkey
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+// Test vectors of EIP-2333.
+var deriveTests = []struct {
+	seed       string
+	masterSK   string
+	childIndex uint32
+	childSK    string
+}{
+	{
+		seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		masterSK:   "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+		childIndex: 0,
+		childSK:    "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+	},
+	{
+		seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+		masterSK:   "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+		childIndex: 3141592653,
+		childSK:    "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+	},
+	{
+		seed:       "0099ff991111002299dd7744ee3355bbdd8844115566cc55663355668888cc00",
+		masterSK:   "27580842291869792442942448775674722299803720648445448686099262467207037398656",
+		childIndex: 4294967295,
+		childSK:    "29358610794459428860402234341874281240803786294062035874021252734817515685787",
+	},
+}
+
+func TestDeriveMasterAndChildSK(t *testing.T) {
+	for _, tt := range deriveTests {
+		seed, err := hex.DecodeString(tt.seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		masterSK, err := DeriveMasterSK(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if masterSK.String() != tt.masterSK {
+			t.Errorf("Wrong master key of seed %s, expected %s, received %s", tt.seed, tt.masterSK, masterSK)
+		}
+		childSK, err := DeriveChildSK(masterSK, tt.childIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if childSK.String() != tt.childSK {
+			t.Errorf("Wrong child key %d of seed %s, expected %s, received %s", tt.childIndex, tt.seed, tt.childSK, childSK)
+		}
+	}
+}
+
+func TestDeriveMasterSK_ShortSeed(t *testing.T) {
+	if _, err := DeriveMasterSK(make([]byte, 31)); err == nil {
+		t.Error("Expected seeds shorter than 32 bytes to be rejected")
+	}
+}
+
+func TestDeriveSecretKey(t *testing.T) {
+	seed, err := hex.DecodeString(deriveTests[0].seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, err := DeriveSecretKey(seed, "m/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	childSK, _ := new(big.Int).SetString(deriveTests[0].childSK, 10)
+	if !bytes.Equal(sk.Marshal(), i2osp(childSK)) {
+		t.Errorf("Wrong key at path m/0, expected %x, received %x", i2osp(childSK), sk.Marshal())
+	}
+
+	for _, path := range []string{"", "0/1", "m/", "m/-1", "m/4294967296"} {
+		if _, err := DeriveSecretKey(seed, path); err == nil {
+			t.Errorf("Expected invalid path %q to be rejected", path)
+		}
+	}
+}
+
+func TestDeriveAccountKeys(t *testing.T) {
+	seed, err := hex.DecodeString(deriveTests[0].seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingKeys, withdrawalKeys, err := DeriveAccountKeys(seed, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signingKeys) != 2 || len(withdrawalKeys) != 2 {
+		t.Fatalf("Expected keys of 2 accounts, received %d and %d", len(signingKeys), len(withdrawalKeys))
+	}
+	for i := 0; i < 2; i++ {
+		account := uint32(i + 1)
+		signingKey, err := DeriveSecretKey(seed, SigningKeyPath(account))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(signingKeys[i].Marshal(), signingKey.Marshal()) {
+			t.Errorf("Signing key of account %d does not match the key at %s", account, SigningKeyPath(account))
+		}
+		withdrawalKey, err := DeriveSecretKey(seed, WithdrawalKeyPath(account))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(withdrawalKeys[i].Marshal(), withdrawalKey.Marshal()) {
+			t.Errorf("Withdrawal key of account %d does not match the key at %s", account, WithdrawalKeyPath(account))
+		}
+	}
+	if SigningKeyPath(1) != "m/12381/3600/1/0/0" {
+		t.Errorf("Wrong signing key path %s", SigningKeyPath(1))
+	}
+}
@@ -0,0 +1,117 @@
+package hdkey
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/minio/sha256-simd"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MnemonicEntropyBits is the entropy of the mnemonics generated by NewMnemonic, which
+	// are 24 words long.
+	MnemonicEntropyBits = 256
+
+	bitsPerWord      = 11
+	seedIterations   = 2048
+	seedLength       = 64
+	seedSaltPrefix   = "mnemonic"
+	minEntropyLength = 16
+	maxEntropyLength = 32
+)
+
+// ErrInvalidMnemonic is returned for mnemonics with unknown words, a wrong number of
+// words or a wrong checksum.
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// NewMnemonic generates a BIP-39 mnemonic from random entropy:
+// https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki
+func NewMnemonic() (string, error) {
+	entropy := make([]byte, MnemonicEntropyBits/8)
+	if _, err := io.ReadFull(rand.Reader, entropy); err != nil {
+		return "", fmt.Errorf("reading from crypto/rand failed: %v", err)
+	}
+	return MnemonicFromEntropy(entropy)
+}
+
+// MnemonicFromEntropy encodes entropy of 128 to 256 bits, in multiples of 32 bits, as a
+// BIP-39 mnemonic.
+func MnemonicFromEntropy(entropy []byte) (string, error) {
+	if len(entropy) < minEntropyLength || len(entropy) > maxEntropyLength || len(entropy)%4 != 0 {
+		return "", fmt.Errorf("invalid entropy length: %d", len(entropy))
+	}
+	// The entropy is followed by the first bits of its hash as a checksum, then split into
+	// words of 11 bits.
+	checksumBits := uint(len(entropy) * 8 / 32)
+	hash := sha256.Sum256(entropy)
+	data := new(big.Int).SetBytes(entropy)
+	data.Lsh(data, checksumBits)
+	data.Or(data, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+
+	numWords := (len(entropy)*8 + int(checksumBits)) / bitsPerWord
+	words := make([]string, numWords)
+	mask := big.NewInt(1<<bitsPerWord - 1)
+	index := new(big.Int)
+	for i := numWords - 1; i >= 0; i-- {
+		index.And(data, mask)
+		words[i] = englishWordlist[index.Int64()]
+		data.Rsh(data, bitsPerWord)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// SeedFromMnemonic validates a BIP-39 mnemonic and returns the seed it encodes with the
+// passphrase, which is empty for mnemonics without one.
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+	if err := validateMnemonic(words); err != nil {
+		return nil, err
+	}
+	password := []byte(strings.Join(words, " "))
+	salt := []byte(norm.NFKD.String(seedSaltPrefix + passphrase))
+	return pbkdf2.Key(password, salt, seedIterations, seedLength, sha512.New), nil
+}
+
+// validateMnemonic checks that the words of a mnemonic are in the wordlist, and that they
+// encode entropy with a matching checksum.
+func validateMnemonic(words []string) error {
+	numWords := len(words)
+	if numWords < 12 || numWords > 24 || numWords%3 != 0 {
+		return errors.Wrapf(ErrInvalidMnemonic, "unexpected number of words %d", numWords)
+	}
+	data := new(big.Int)
+	for _, word := range words {
+		index, ok := wordIndex(word)
+		if !ok {
+			return errors.Wrapf(ErrInvalidMnemonic, "unknown word %q", word)
+		}
+		data.Lsh(data, bitsPerWord)
+		data.Or(data, big.NewInt(int64(index)))
+	}
+
+	checksumBits := uint(numWords * bitsPerWord / 33)
+	checksum := new(big.Int).And(data, big.NewInt(1<<checksumBits-1))
+	data.Rsh(data, checksumBits)
+	entropy := make([]byte, numWords/3*4)
+	b := data.Bytes()
+	copy(entropy[len(entropy)-len(b):], b)
+	hash := sha256.Sum256(entropy)
+	if checksum.Int64() != int64(hash[0]>>(8-checksumBits)) {
+		return errors.Wrap(ErrInvalidMnemonic, "checksum mismatch")
+	}
+	return nil
+}
+
+// wordIndex returns the index of a word in the wordlist, which is sorted.
+func wordIndex(word string) (int, bool) {
+	i := sort.SearchStrings(englishWordlist[:], word)
+	return i, i < len(englishWordlist) && englishWordlist[i] == word
+}
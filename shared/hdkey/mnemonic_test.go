@@ -0,0 +1,97 @@
+package hdkey
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// Test vectors of the BIP-39 reference implementation, which use the passphrase "TREZOR".
+var mnemonicTests = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+}
+
+func TestMnemonicFromEntropy(t *testing.T) {
+	for _, tt := range mnemonicTests {
+		entropy, err := hex.DecodeString(tt.entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mnemonic, err := MnemonicFromEntropy(entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mnemonic != tt.mnemonic {
+			t.Errorf("Wrong mnemonic of entropy %s, expected %q, received %q", tt.entropy, tt.mnemonic, mnemonic)
+		}
+	}
+
+	mnemonic, err := MnemonicFromEntropy(bytes.Repeat([]byte{0xff}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := strings.Repeat("zoo ", 23) + "vote"; mnemonic != want {
+		t.Errorf("Wrong mnemonic of 256 bits of entropy, expected %q, received %q", want, mnemonic)
+	}
+
+	if _, err := MnemonicFromEntropy(make([]byte, 15)); err == nil {
+		t.Error("Expected entropy of 120 bits to be rejected")
+	}
+}
+
+func TestSeedFromMnemonic(t *testing.T) {
+	for _, tt := range mnemonicTests {
+		seed, err := SeedFromMnemonic(tt.mnemonic, "TREZOR")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(seed) != tt.seed {
+			t.Errorf("Wrong seed of mnemonic %q, expected %s, received %x", tt.mnemonic, tt.seed, seed)
+		}
+	}
+}
+
+func TestSeedFromMnemonic_Invalid(t *testing.T) {
+	tests := []string{
+		// Wrong checksum.
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		// Unknown word.
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon ethereum",
+		// Wrong number of words.
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+	}
+	for _, mnemonic := range tests {
+		if _, err := SeedFromMnemonic(mnemonic, ""); errors.Cause(err) != ErrInvalidMnemonic {
+			t.Errorf("Expected %v for mnemonic %q, received %v", ErrInvalidMnemonic, mnemonic, err)
+		}
+	}
+}
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words := strings.Fields(mnemonic); len(words) != 24 {
+		t.Errorf("Expected a mnemonic of 24 words, received %d", len(words))
+	}
+	if _, err := SeedFromMnemonic(mnemonic, ""); err != nil {
+		t.Errorf("Expected generated mnemonic to be valid, received %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package hdkey
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+const (
+	// purpose is the purpose level of the paths of EIP-2334, the name of the BLS12-381 curve.
+	purpose = 12381
+	// coinType is the coin type level of the paths of EIP-2334 for Ethereum 2.
+	coinType = 3600
+)
+
+// WithdrawalKeyPath returns the EIP-2334 derivation path of the withdrawal key of an
+// account: https://eips.ethereum.org/EIPS/eip-2334
+func WithdrawalKeyPath(account uint32) string {
+	return fmt.Sprintf("m/%d/%d/%d/0", purpose, coinType, account)
+}
+
+// SigningKeyPath returns the EIP-2334 derivation path of the signing key of an account,
+// which is a child of its withdrawal key.
+func SigningKeyPath(account uint32) string {
+	return WithdrawalKeyPath(account) + "/0"
+}
+
+// DeriveSecretKey derives the BLS secret key of a seed at a derivation path, such as
+// "m/12381/3600/0/0/0".
+func DeriveSecretKey(seed []byte, path string) (*bls.SecretKey, error) {
+	indices, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := DeriveMasterSK(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		sk, err = DeriveChildSK(sk, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return secretKeyFromInt(sk)
+}
+
+// DeriveAccountKeys derives the signing and withdrawal keys of a number of consecutive
+// accounts of a seed, starting at an offset.
+func DeriveAccountKeys(seed []byte, offset, numAccounts uint64) ([]*bls.SecretKey, []*bls.SecretKey, error) {
+	if offset+numAccounts > math.MaxUint32+1 {
+		return nil, nil, errors.Errorf("account indices beyond %d are not supported", uint64(math.MaxUint32))
+	}
+	masterSK, err := DeriveMasterSK(seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The purpose and coin type levels are shared by all of the accounts.
+	accountsSK := masterSK
+	for _, index := range []uint32{purpose, coinType} {
+		accountsSK, err = DeriveChildSK(accountsSK, index)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	signingKeys := make([]*bls.SecretKey, numAccounts)
+	withdrawalKeys := make([]*bls.SecretKey, numAccounts)
+	for i := uint64(0); i < numAccounts; i++ {
+		accountSK, err := DeriveChildSK(accountsSK, uint32(offset+i))
+		if err != nil {
+			return nil, nil, err
+		}
+		withdrawalSK, err := DeriveChildSK(accountSK, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		signingSK, err := DeriveChildSK(withdrawalSK, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		if withdrawalKeys[i], err = secretKeyFromInt(withdrawalSK); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not create withdrawal key of account %d", offset+i)
+		}
+		if signingKeys[i], err = secretKeyFromInt(signingSK); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not create signing key of account %d", offset+i)
+		}
+	}
+	return signingKeys, withdrawalKeys, nil
+}
+
+// parsePath parses a derivation path of the form "m/12381/3600/0/0" into the indices of
+// its levels below the master key.
+func parsePath(path string) ([]uint32, error) {
+	levels := strings.Split(path, "/")
+	if levels[0] != "m" {
+		return nil, errors.Errorf("derivation path %q must start with m", path)
+	}
+	indices := make([]uint32, len(levels)-1)
+	for i, level := range levels[1:] {
+		index, err := strconv.ParseUint(level, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid index %q of derivation path %q", level, path)
+		}
+		indices[i] = uint32(index)
+	}
+	return indices, nil
+}
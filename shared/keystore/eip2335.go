@@ -0,0 +1,334 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/minio/sha256-simd"
+	"github.com/pborman/uuid"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/text/unicode/norm"
+)
+
+// EIP2335Version is the version of the keystores specified by EIP-2335:
+// https://eips.ethereum.org/EIPS/eip-2335
+const EIP2335Version = 4
+
+const (
+	// ScryptKDF is the name of the scrypt key derivation function of EIP-2335 keystores.
+	ScryptKDF = "scrypt"
+	// PBKDF2KDF is the name of the PBKDF2 key derivation function of EIP-2335 keystores.
+	PBKDF2KDF = "pbkdf2"
+
+	// StandardPBKDF2C is the iteration count of the PBKDF2 key derivation function
+	// recommended by EIP-2335.
+	StandardPBKDF2C = 1 << 18
+
+	eip2335CipherFunction   = "aes-128-ctr"
+	eip2335ChecksumFunction = "sha256"
+	pbkdf2PRF               = "hmac-sha256"
+)
+
+type eip2335JSON struct {
+	Crypto      eip2335CryptoJSON `json:"crypto"`
+	Description string            `json:"description,omitempty"`
+	PublicKey   string            `json:"pubkey"`
+	Path        string            `json:"path"`
+	ID          string            `json:"uuid"`
+	Version     uint              `json:"version"`
+}
+
+type eip2335CryptoJSON struct {
+	KDF      eip2335ModuleJSON `json:"kdf"`
+	Checksum eip2335ModuleJSON `json:"checksum"`
+	Cipher   eip2335ModuleJSON `json:"cipher"`
+}
+
+type eip2335ModuleJSON struct {
+	Function string                 `json:"function"`
+	Params   map[string]interface{} `json:"params"`
+	Message  string                 `json:"message"`
+}
+
+// IsEIP2335 returns whether a json blob is an EIP-2335 keystore.
+func IsEIP2335(keyjson []byte) bool {
+	k := new(eip2335JSON)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		return false
+	}
+	return k.Version == EIP2335Version
+}
+
+// EncryptKeyEIP2335 encrypts a key into an EIP-2335 keystore json blob, using the given key
+// derivation function with cost as its scrypt N parameter or PBKDF2 iteration count. The path
+// is the derivation path of the key, which is empty for keys that are not derived.
+func EncryptKeyEIP2335(key *Key, password, path, kdf string, cost int) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("reading from crypto/rand failed: %v", err)
+	}
+	kdfParams := make(map[string]interface{}, 5)
+	kdfParams["dklen"] = scryptDKLen
+	kdfParams["salt"] = hex.EncodeToString(salt)
+	switch kdf {
+	case ScryptKDF:
+		kdfParams["n"] = cost
+		kdfParams["r"] = scryptR
+		kdfParams["p"] = StandardScryptP
+	case PBKDF2KDF:
+		kdfParams["c"] = cost
+		kdfParams["prf"] = pbkdf2PRF
+	default:
+		return nil, fmt.Errorf("unsupported KDF: %s", kdf)
+	}
+	kdfModule := eip2335ModuleJSON{
+		Function: kdf,
+		Params:   kdfParams,
+	}
+	derivedKey, err := eip2335DerivedKey(kdfModule, password)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, fmt.Errorf("reading from crypto/rand failed: %v", err)
+	}
+	cipherText, err := aesCTRXOR(derivedKey[:16], key.SecretKey.Marshal(), iv)
+	if err != nil {
+		return nil, err
+	}
+	checksum := eip2335Checksum(derivedKey, cipherText)
+
+	keystore := eip2335JSON{
+		Crypto: eip2335CryptoJSON{
+			KDF: kdfModule,
+			Checksum: eip2335ModuleJSON{
+				Function: eip2335ChecksumFunction,
+				Params:   map[string]interface{}{},
+				Message:  hex.EncodeToString(checksum),
+			},
+			Cipher: eip2335ModuleJSON{
+				Function: eip2335CipherFunction,
+				Params:   map[string]interface{}{"iv": hex.EncodeToString(iv)},
+				Message:  hex.EncodeToString(cipherText),
+			},
+		},
+		PublicKey: hex.EncodeToString(key.PublicKey.Marshal()),
+		Path:      path,
+		ID:        key.ID.String(),
+		Version:   EIP2335Version,
+	}
+	return json.MarshalIndent(keystore, "", "  ")
+}
+
+// DecryptKeyEIP2335 decrypts a key from an EIP-2335 keystore json blob.
+func DecryptKeyEIP2335(keyjson []byte, password string) (*Key, error) {
+	k := new(eip2335JSON)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		return nil, err
+	}
+	if k.Version != EIP2335Version {
+		return nil, fmt.Errorf("keystore version not supported: %d", k.Version)
+	}
+	if k.Crypto.Checksum.Function != eip2335ChecksumFunction {
+		return nil, fmt.Errorf("checksum not supported: %s", k.Crypto.Checksum.Function)
+	}
+	if k.Crypto.Cipher.Function != eip2335CipherFunction {
+		return nil, fmt.Errorf("cipher not supported: %s", k.Crypto.Cipher.Function)
+	}
+
+	checksum, err := hex.DecodeString(k.Crypto.Checksum.Message)
+	if err != nil {
+		return nil, err
+	}
+	ivHex, ok := k.Crypto.Cipher.Params["iv"].(string)
+	if !ok {
+		return nil, errors.New("missing cipher iv")
+	}
+	iv, err := hex.DecodeString(ivHex)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("invalid cipher iv length: %d", len(iv))
+	}
+	cipherText, err := hex.DecodeString(k.Crypto.Cipher.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := eip2335DerivedKey(k.Crypto.KDF, password)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(eip2335Checksum(derivedKey, cipherText), checksum) {
+		return nil, ErrDecrypt
+	}
+	plainText, err := aesCTRXOR(derivedKey[:16], cipherText, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	secretKey, err := bls.SecretKeyFromBytes(plainText)
+	if err != nil {
+		return nil, err
+	}
+	publicKey := secretKey.PublicKey()
+	if k.PublicKey != "" && k.PublicKey != hex.EncodeToString(publicKey.Marshal()) {
+		return nil, fmt.Errorf("public key %s does not match the decrypted secret key", k.PublicKey)
+	}
+	return &Key{
+		ID:        uuid.Parse(k.ID),
+		PublicKey: publicKey,
+		SecretKey: secretKey,
+	}, nil
+}
+
+// StoreKeyEIP2335 encrypts a key with a password into an EIP-2335 keystore, using the scrypt
+// parameters of the store, and writes it to filename.
+func (ks Store) StoreKeyEIP2335(filename string, key *Key, auth, path string) error {
+	keyjson, err := EncryptKeyEIP2335(key, auth, path, ScryptKDF, ks.scryptN)
+	if err != nil {
+		return err
+	}
+	return writeKeyFile(filename, keyjson)
+}
+
+// GetKeysEIP2335 loads and decrypts the EIP-2335 keystores of a directory with a password.
+// Files which are not EIP-2335 keystores are ignored.
+func (ks Store) GetKeysEIP2335(directory, password string) (map[string]*Key, error) {
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*Key)
+	for _, f := range files {
+		if !f.Mode().IsRegular() || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		// #nosec G304
+		keyjson, err := ioutil.ReadFile(filepath.Join(directory, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if !IsEIP2335(keyjson) {
+			continue
+		}
+		key, err := DecryptKeyEIP2335(keyjson, password)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt keystore %s: %v", f.Name(), err)
+		}
+		keys[hex.EncodeToString(key.PublicKey.Marshal())] = key
+	}
+	return keys, nil
+}
+
+// eip2335DerivedKey derives the decryption key from the password with the key derivation
+// function of a keystore. It must be at least 32 bytes long, as the first 16 bytes are the
+// key of the cipher and the next 16 bytes are used to checksum the cipher text.
+func eip2335DerivedKey(kdf eip2335ModuleJSON, password string) ([]byte, error) {
+	saltHex, ok := kdf.Params["salt"].(string)
+	if !ok {
+		return nil, errors.New("missing KDF salt")
+	}
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return nil, err
+	}
+	dkLen, err := eip2335IntParam(kdf.Params, "dklen")
+	if err != nil {
+		return nil, err
+	}
+	if dkLen < 32 {
+		return nil, fmt.Errorf("derived key length too short: %d", dkLen)
+	}
+	authArray := eip2335Password(password)
+
+	switch kdf.Function {
+	case ScryptKDF:
+		n, err := eip2335IntParam(kdf.Params, "n")
+		if err != nil {
+			return nil, err
+		}
+		if n <= 1 || n&(n-1) != 0 {
+			return nil, fmt.Errorf("scrypt N must be a power of two greater than 1: %d", n)
+		}
+		r, err := eip2335IntParam(kdf.Params, "r")
+		if err != nil {
+			return nil, err
+		}
+		p, err := eip2335IntParam(kdf.Params, "p")
+		if err != nil {
+			return nil, err
+		}
+		if r <= 0 || p <= 0 {
+			return nil, fmt.Errorf("invalid scrypt parameters: r=%d p=%d", r, p)
+		}
+		return scrypt.Key(authArray, salt, n, r, p, dkLen)
+	case PBKDF2KDF:
+		if prf, _ := kdf.Params["prf"].(string); prf != pbkdf2PRF {
+			return nil, fmt.Errorf("unsupported PBKDF2 PRF: %s", prf)
+		}
+		c, err := eip2335IntParam(kdf.Params, "c")
+		if err != nil {
+			return nil, err
+		}
+		if c <= 0 {
+			return nil, fmt.Errorf("invalid PBKDF2 iteration count: %d", c)
+		}
+		return pbkdf2.Key(authArray, salt, c, dkLen, sha256.New), nil
+	}
+	return nil, fmt.Errorf("unsupported KDF: %s", kdf.Function)
+}
+
+// eip2335IntParam reads an integer parameter of a keystore module. Parameters decoded from
+// json are float64 numbers, while those of keystores being encrypted are ints.
+func eip2335IntParam(params map[string]interface{}, name string) (int, error) {
+	switch v := params[name].(type) {
+	case int:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt32 || v > math.MaxInt32 {
+			return 0, fmt.Errorf("KDF parameter %s out of range: %v", name, v)
+		}
+		return int(v), nil
+	case nil:
+		return 0, fmt.Errorf("missing KDF parameter %s", name)
+	default:
+		return 0, fmt.Errorf("KDF parameter %s is not a number: %v", name, v)
+	}
+}
+
+// eip2335Checksum is the checksum of the cipher text, computed with the second half of the
+// derived key.
+func eip2335Checksum(derivedKey, cipherText []byte) []byte {
+	h := sha256.New()
+	h.Write(derivedKey[16:32])
+	h.Write(cipherText)
+	return h.Sum(nil)
+}
+
+// eip2335Password processes a password as specified by EIP-2335: it is normalized to its
+// NFKD representation, and stripped of its control codes.
+func eip2335Password(password string) []byte {
+	return []byte(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(password)))
+}
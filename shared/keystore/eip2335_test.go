@@ -0,0 +1,251 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestEncryptDecryptKeyEIP2335(t *testing.T) {
+	tests := []struct {
+		kdf  string
+		cost int
+	}{
+		{kdf: ScryptKDF, cost: LightScryptN},
+		{kdf: PBKDF2KDF, cost: 1024},
+	}
+	for _, tt := range tests {
+		key, err := NewKey()
+		if err != nil {
+			t.Fatalf("key generation failed %v", err)
+		}
+		keyjson, err := EncryptKeyEIP2335(key, "password", "m/12381/3600/0/0", tt.kdf, tt.cost)
+		if err != nil {
+			t.Fatalf("unable to encrypt key with %s: %v", tt.kdf, err)
+		}
+		if !IsEIP2335(keyjson) {
+			t.Fatalf("expected %s keystore to be an EIP-2335 keystore", tt.kdf)
+		}
+
+		newkey, err := DecryptKeyEIP2335(keyjson, "password")
+		if err != nil {
+			t.Fatalf("unable to decrypt %s keystore %v", tt.kdf, err)
+		}
+		if !bytes.Equal(newkey.ID, key.ID) {
+			t.Errorf("decrypted key's uuid doesn't match %v", newkey.ID)
+		}
+		if !bytes.Equal(newkey.SecretKey.Marshal(), key.SecretKey.Marshal()) {
+			t.Errorf("decrypted key's value is not equal %v", newkey.SecretKey.Marshal())
+		}
+
+		if _, err := DecryptKeyEIP2335(keyjson, "wrong password"); err != ErrDecrypt {
+			t.Errorf("expected error %v with wrong password, received %v", ErrDecrypt, err)
+		}
+	}
+}
+
+// Test vectors of EIP-2335: https://eips.ethereum.org/EIPS/eip-2335#test-cases
+const (
+	eip2335TestPassword  = "𝔱𝔢𝔰𝔱𝔭𝔞𝔰𝔰𝔴𝔬𝔯𝔡🔑"
+	eip2335TestSecret    = "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f"
+	eip2335TestPublicKey = "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07"
+
+	eip2335ScryptTestVector = `{
+    "crypto": {
+        "kdf": {
+            "function": "scrypt",
+            "params": {
+                "dklen": 32,
+                "n": 262144,
+                "p": 1,
+                "r": 8,
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "d2217fe5f3e9a1e34581ef8a78f7c9928e436d36dacc5e846690a5581e8ea484"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "06ae90d55fe0a6e9c5c3bc5b170827b2e5cce3929ed3f116c2811e6366dfe20f"
+        }
+    },
+    "description": "This is a test keystore that uses scrypt to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/3141592653/589793238",
+    "uuid": "1d85ae20-35c5-4611-98e8-aa14a633906f",
+    "version": 4
+}`
+
+	eip2335PBKDF2TestVector = `{
+    "crypto": {
+        "kdf": {
+            "function": "pbkdf2",
+            "params": {
+                "dklen": 32,
+                "c": 262144,
+                "prf": "hmac-sha256",
+                "salt": "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3"
+            },
+            "message": ""
+        },
+        "checksum": {
+            "function": "sha256",
+            "params": {},
+            "message": "8a9f5d9912ed7e75ea794bc5a89bca5f193721d30868ade6f73043c6ea6febf1"
+        },
+        "cipher": {
+            "function": "aes-128-ctr",
+            "params": {
+                "iv": "264daa3f303d7259501c93d997d84fe6"
+            },
+            "message": "cee03fde2af33149775b7223e7845e4fb2c8ae1792e5f99fe9ecf474cc8c16ad"
+        }
+    },
+    "description": "This is a test keystore that uses PBKDF2 to secure the secret.",
+    "pubkey": "9612d7a727c9d0a22e185a1c768478dfe919cada9266988cb32359c11f2b7b27f4ae4040902382ae2910c15e2b420d07",
+    "path": "m/12381/60/0/0",
+    "uuid": "64625def-3331-4eea-ab6f-782f3ed16a83",
+    "version": 4
+}`
+)
+
+func TestDecryptKeyEIP2335_TestVectors(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyjson string
+		id      string
+	}{
+		{name: ScryptKDF, keyjson: eip2335ScryptTestVector, id: "1d85ae20-35c5-4611-98e8-aa14a633906f"},
+		{name: PBKDF2KDF, keyjson: eip2335PBKDF2TestVector, id: "64625def-3331-4eea-ab6f-782f3ed16a83"},
+	}
+	for _, tt := range tests {
+		key, err := DecryptKeyEIP2335([]byte(tt.keyjson), eip2335TestPassword)
+		if err != nil {
+			t.Fatalf("unable to decrypt %s test vector: %v", tt.name, err)
+		}
+		if secret := hex.EncodeToString(key.SecretKey.Marshal()); secret != eip2335TestSecret {
+			t.Errorf("%s test vector: wanted secret %s, received %s", tt.name, eip2335TestSecret, secret)
+		}
+		if pubKey := hex.EncodeToString(key.PublicKey.Marshal()); pubKey != eip2335TestPublicKey {
+			t.Errorf("%s test vector: wanted public key %s, received %s", tt.name, eip2335TestPublicKey, pubKey)
+		}
+		if key.ID.String() != tt.id {
+			t.Errorf("%s test vector: wanted uuid %s, received %s", tt.name, tt.id, key.ID.String())
+		}
+		if _, err := DecryptKeyEIP2335([]byte(tt.keyjson), "testpassword"); err != ErrDecrypt {
+			t.Errorf("%s test vector: expected error %v with wrong password, received %v", tt.name, ErrDecrypt, err)
+		}
+	}
+}
+
+func TestDecryptKeyEIP2335_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyjson string
+		old     string
+		new     string
+	}{
+		{name: "missing dklen", keyjson: eip2335ScryptTestVector, old: `"dklen": 32,`, new: ``},
+		{name: "short dklen", keyjson: eip2335ScryptTestVector, old: `"dklen": 32,`, new: `"dklen": 16,`},
+		{name: "huge dklen", keyjson: eip2335ScryptTestVector, old: `"dklen": 32,`, new: `"dklen": 1e30,`},
+		{name: "missing n", keyjson: eip2335ScryptTestVector, old: `"n": 262144,`, new: ``},
+		{name: "string n", keyjson: eip2335ScryptTestVector, old: `"n": 262144,`, new: `"n": "262144",`},
+		{name: "n not a power of two", keyjson: eip2335ScryptTestVector, old: `"n": 262144,`, new: `"n": 262143,`},
+		{name: "fractional n", keyjson: eip2335ScryptTestVector, old: `"n": 262144,`, new: `"n": 2.5,`},
+		{name: "zero r", keyjson: eip2335ScryptTestVector, old: `"r": 8,`, new: `"r": 0,`},
+		{name: "negative p", keyjson: eip2335ScryptTestVector, old: `"p": 1,`, new: `"p": -1,`},
+		{name: "missing c", keyjson: eip2335PBKDF2TestVector, old: `"c": 262144,`, new: ``},
+		{name: "zero c", keyjson: eip2335PBKDF2TestVector, old: `"c": 262144,`, new: `"c": 0,`},
+		{name: "null c", keyjson: eip2335PBKDF2TestVector, old: `"c": 262144,`, new: `"c": null,`},
+		{name: "short iv", keyjson: eip2335ScryptTestVector, old: `"iv": "264daa3f303d7259501c93d997d84fe6"`, new: `"iv": "264daa3f"`},
+		{name: "long iv", keyjson: eip2335PBKDF2TestVector, old: `"iv": "264daa3f303d7259501c93d997d84fe6"`, new: `"iv": "264daa3f303d7259501c93d997d84fe600"`},
+	}
+	for _, tt := range tests {
+		if !strings.Contains(tt.keyjson, tt.old) {
+			t.Fatalf("%s: test vector does not contain %s", tt.name, tt.old)
+		}
+		keyjson := strings.Replace(tt.keyjson, tt.old, tt.new, 1)
+		if _, err := DecryptKeyEIP2335([]byte(keyjson), eip2335TestPassword); err == nil {
+			t.Errorf("%s: expected decryption to fail", tt.name)
+		}
+	}
+}
+
+func TestDecryptKeyEIP2335_StripsControlCodes(t *testing.T) {
+	key, err := NewKey()
+	if err != nil {
+		t.Fatalf("key generation failed %v", err)
+	}
+	keyjson, err := EncryptKeyEIP2335(key, "pass\x7fword\n", "", ScryptKDF, LightScryptN)
+	if err != nil {
+		t.Fatalf("unable to encrypt key %v", err)
+	}
+	if _, err := DecryptKeyEIP2335(keyjson, "password"); err != nil {
+		t.Errorf("expected control codes of password to be ignored, received %v", err)
+	}
+}
+
+func TestIsEIP2335_GethFormat(t *testing.T) {
+	key, err := NewKey()
+	if err != nil {
+		t.Fatalf("key generation failed %v", err)
+	}
+	keyjson, err := EncryptKey(key, "password", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatalf("unable to encrypt key %v", err)
+	}
+	if IsEIP2335(keyjson) {
+		t.Error("expected go-ethereum style keystore not to be an EIP-2335 keystore")
+	}
+}
+
+func TestStoreAndGetKeysEIP2335(t *testing.T) {
+	dir := filepath.Join(testutil.TempDir(), "eip2335")
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("unable to remove temporary files %v", err)
+		}
+	}()
+	ks := &Store{
+		keysDirPath: dir,
+		scryptN:     LightScryptN,
+		scryptP:     LightScryptP,
+	}
+
+	key, err := NewKey()
+	if err != nil {
+		t.Fatalf("key generation failed %v", err)
+	}
+	if err := ks.StoreKeyEIP2335(ks.JoinPath("keystore-1.json"), key, "password", ""); err != nil {
+		t.Fatalf("unable to store key %v", err)
+	}
+	// Keystores of other formats in the directory are ignored.
+	if err := ks.StoreKey(ks.JoinPath("keystore-2.json"), key, "password"); err != nil {
+		t.Fatalf("unable to store key %v", err)
+	}
+
+	keys, err := ks.GetKeysEIP2335(dir, "password")
+	if err != nil {
+		t.Fatalf("unable to get keys %v", err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("expected 1 key, received %d", len(keys))
+	}
+	for _, k := range keys {
+		if !bytes.Equal(k.SecretKey.Marshal(), key.SecretKey.Marshal()) {
+			t.Errorf("retrieved secret keys are not equal %v", k.SecretKey.Marshal())
+		}
+	}
+}
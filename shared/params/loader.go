@@ -0,0 +1,142 @@
+package params
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// LoadChainConfigFile reads a spec style YAML chain config from the given path and
+// overrides the current beacon config with it. Keys missing from the file keep their
+// value from the current config.
+func LoadChainConfigFile(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "could not read chain config file")
+	}
+	cfg, err := UnmarshalConfig(data, BeaconConfig())
+	if err != nil {
+		return errors.Wrapf(err, "could not load chain config file %s", path)
+	}
+	OverrideBeaconConfig(cfg)
+	return nil
+}
+
+// UnmarshalConfig applies the values of a spec style YAML chain config on top of a copy
+// of the base config. It returns an error if the YAML contains a key that is not part of
+// the chain config, or if the resulting config is invalid.
+func UnmarshalConfig(data []byte, base *BeaconChainConfig) (*BeaconChainConfig, error) {
+	// Values are decoded as raw strings so that hex values such as domains keep their
+	// leading zeros.
+	values := make(map[string]string)
+	if err := yaml.UnmarshalStrict(data, &values); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal yaml")
+	}
+
+	cfg := *base
+	fields := configFields(&cfg)
+	for key, value := range values {
+		field, ok := fields[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown chain config key %s", key)
+		}
+		if err := setField(field, value); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s", key)
+		}
+	}
+	if err := validateConfig(&cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// configFields maps the yaml tag of each chain config field to the field itself.
+func configFields(cfg *BeaconChainConfig) map[string]reflect.Value {
+	v := reflect.ValueOf(cfg).Elem()
+	t := v.Type()
+	fields := make(map[string]reflect.Value, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("yaml")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		fields[tag] = v.Field(i)
+	}
+	return fields
+}
+
+func setField(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.Uint64:
+		n, err := strconv.ParseUint(value, 0, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Uint8:
+		n, err := strconv.ParseUint(value, 0, 8)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported field type %s", field.Type())
+		}
+		if !strings.HasPrefix(value, "0x") {
+			return errors.New("expected hex value with 0x prefix")
+		}
+		b, err := hex.DecodeString(value[2:])
+		if err != nil {
+			return err
+		}
+		field.SetBytes(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// validateConfig checks the values that the beacon chain divides by or indexes with.
+func validateConfig(cfg *BeaconChainConfig) error {
+	nonZero := map[string]uint64{
+		"SLOTS_PER_EPOCH":              cfg.SlotsPerEpoch,
+		"SECONDS_PER_SLOT":             cfg.SecondsPerSlot,
+		"TARGET_COMMITTEE_SIZE":        cfg.TargetCommitteeSize,
+		"MAX_COMMITTEES_PER_SLOT":      cfg.MaxCommitteesPerSlot,
+		"SHUFFLE_ROUND_COUNT":          cfg.ShuffleRoundCount,
+		"EFFECTIVE_BALANCE_INCREMENT":  cfg.EffectiveBalanceIncrement,
+		"SLOTS_PER_HISTORICAL_ROOT":    cfg.SlotsPerHistoricalRoot,
+		"EPOCHS_PER_HISTORICAL_VECTOR": cfg.EpochsPerHistoricalVector,
+		"EPOCHS_PER_SLASHINGS_VECTOR":  cfg.EpochsPerSlashingsVector,
+		"SLOTS_PER_ETH1_VOTING_PERIOD": cfg.SlotsPerEth1VotingPeriod,
+	}
+	for key, value := range nonZero {
+		if value == 0 {
+			return fmt.Errorf("%s must be non-zero", key)
+		}
+	}
+	if cfg.MinSeedLookahead > cfg.MaxSeedLookahead {
+		return fmt.Errorf("MIN_SEED_LOOKAHEAD %d is greater than MAX_SEED_LOOKAHEAD %d", cfg.MinSeedLookahead, cfg.MaxSeedLookahead)
+	}
+	fourBytes := map[string][]byte{
+		"GENESIS_FORK_VERSION":   cfg.GenesisForkVersion,
+		"DOMAIN_BEACON_PROPOSER": cfg.DomainBeaconProposer,
+		"DOMAIN_BEACON_ATTESTER": cfg.DomainBeaconAttester,
+		"DOMAIN_RANDAO":          cfg.DomainRandao,
+		"DOMAIN_DEPOSIT":         cfg.DomainDeposit,
+		"DOMAIN_VOLUNTARY_EXIT":  cfg.DomainVoluntaryExit,
+	}
+	for key, value := range fourBytes {
+		if len(value) != 4 {
+			return fmt.Errorf("%s must be 4 bytes, got %d", key, len(value))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package params_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestUnmarshalConfig(t *testing.T) {
+	data := []byte(`
+SLOTS_PER_EPOCH: 16
+SECONDS_PER_SLOT: 3
+BLS_WITHDRAWAL_PREFIX: 0x01
+DOMAIN_BEACON_ATTESTER: 0x01000000
+GENESIS_FORK_VERSION: 0x00000001
+`)
+	base := params.MainnetConfig()
+	cfg, err := params.UnmarshalConfig(data, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.SlotsPerEpoch != 16 {
+		t.Errorf("Wanted slots per epoch %d, got %d", 16, cfg.SlotsPerEpoch)
+	}
+	if cfg.SecondsPerSlot != 3 {
+		t.Errorf("Wanted seconds per slot %d, got %d", 3, cfg.SecondsPerSlot)
+	}
+	if cfg.BLSWithdrawalPrefixByte != 1 {
+		t.Errorf("Wanted withdrawal prefix %d, got %d", 1, cfg.BLSWithdrawalPrefixByte)
+	}
+	if !bytes.Equal(cfg.DomainBeaconAttester, []byte{1, 0, 0, 0}) {
+		t.Errorf("Wanted attester domain %#x, got %#x", []byte{1, 0, 0, 0}, cfg.DomainBeaconAttester)
+	}
+	if !bytes.Equal(cfg.GenesisForkVersion, []byte{0, 0, 0, 1}) {
+		t.Errorf("Wanted genesis fork version %#x, got %#x", []byte{0, 0, 0, 1}, cfg.GenesisForkVersion)
+	}
+	if cfg.MaxEffectiveBalance != base.MaxEffectiveBalance {
+		t.Errorf("Wanted unset value %d to be kept, got %d", base.MaxEffectiveBalance, cfg.MaxEffectiveBalance)
+	}
+	if base.SlotsPerEpoch == 16 {
+		t.Error("Base config should not be modified")
+	}
+}
+
+func TestUnmarshalConfig_UnknownKey(t *testing.T) {
+	data := []byte("SLOTS_PER_EPOCH: 16\nNOT_A_CONFIG_KEY: 1\n")
+	_, err := params.UnmarshalConfig(data, params.MainnetConfig())
+	if err == nil || !strings.Contains(err.Error(), "unknown chain config key NOT_A_CONFIG_KEY") {
+		t.Errorf("Expected unknown key error, got %v", err)
+	}
+}
+
+func TestUnmarshalConfig_ZeroSlotsPerEpoch(t *testing.T) {
+	data := []byte("SLOTS_PER_EPOCH: 0\n")
+	_, err := params.UnmarshalConfig(data, params.MainnetConfig())
+	if err == nil || !strings.Contains(err.Error(), "SLOTS_PER_EPOCH must be non-zero") {
+		t.Errorf("Expected non-zero error, got %v", err)
+	}
+}
+
+func TestUnmarshalConfig_InvalidDomain(t *testing.T) {
+	data := []byte("DOMAIN_RANDAO: 0x0200\n")
+	_, err := params.UnmarshalConfig(data, params.MainnetConfig())
+	if err == nil || !strings.Contains(err.Error(), "DOMAIN_RANDAO must be 4 bytes") {
+		t.Errorf("Expected domain length error, got %v", err)
+	}
+}
+
+func TestLoadChainConfigFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chainconfig")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yaml")
+	if err := ioutil.WriteFile(path, []byte("SLOTS_PER_EPOCH: 4\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	defer params.UseMainnetConfig()
+	if err := params.LoadChainConfigFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if params.BeaconConfig().SlotsPerEpoch != 4 {
+		t.Errorf("Wanted slots per epoch %d, got %d", 4, params.BeaconConfig().SlotsPerEpoch)
+	}
+}
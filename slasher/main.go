@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
 	"github.com/prysmaticlabs/prysm/slasher/service"
@@ -26,6 +27,13 @@ func startSlasher(ctx *cli.Context) error {
 		return err
 	}
 	logrus.SetLevel(level)
+	if ctx.GlobalIsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFile); err != nil {
+			return err
+		}
+		log.WithField("path", chainConfigFile).Info("Using chain config file")
+	}
 	port := ctx.GlobalInt(flags.RPCPort.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	key := ctx.GlobalString(flags.KeyFlag.Name)
@@ -61,6 +69,7 @@ var appFlags = []cli.Flag{
 	cmd.LogFileName,
 	cmd.LogFormat,
 	cmd.ClearDB,
+	cmd.ChainConfigFileFlag,
 	debug.PProfFlag,
 	debug.PProfAddrFlag,
 	debug.PProfPortFlag,
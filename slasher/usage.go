@@ -54,6 +54,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.LogFormat,
 			cmd.LogFileName,
 			cmd.ClearDB,
+			cmd.ChainConfigFileFlag,
 		},
 	},
 	{
@@ -0,0 +1,156 @@
+// Package main defines a reference remote signer which holds validator secret
+// keys and signs requests received from validator clients using the remote
+// key manager over mutually-authenticated gRPC.
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	port            = flag.Int("port", 12345, "Port on which to serve gRPC")
+	wallet          = flag.String("wallet", "Validators", "Name of the wallet under which accounts are listed")
+	keysPath        = flag.String("unencrypted-keys", "", "Path to a JSON file of unencrypted validator keys, as generated by unencrypted-keys-gen")
+	numValidators   = flag.Uint64("interop-num-validators", 0, "Number of deterministic interop keys to hold if no keys file is supplied")
+	startIndex      = flag.Uint64("interop-start-index", 0, "Start index for the deterministic interop keys")
+	tlsCert         = flag.String("tls-cert", "", "Certificate presented by the signer to clients")
+	tlsKey          = flag.String("tls-key", "", "Secret key for the signer's certificate")
+	tlsClientCACert = flag.String("tls-client-ca-cert", "", "Certificate of the authority which signs client certificates")
+	protectionDir   = flag.String("slashing-protection-dir", "", "Directory of the slashing protection database. If set, the signer refuses to sign slashable attestations and proposals")
+	minimalConfig   = flag.Bool("minimal-config", false, "Use the minimal chain configuration when checking for slashable proposals")
+	verbose         = flag.Bool("verbose", false, "Enable debug logging")
+)
+
+var log = logrus.WithField("prefix", "remote-signer")
+
+type unencryptedKeysContainer struct {
+	Keys []*unencryptedKeys `json:"keys"`
+}
+
+type unencryptedKeys struct {
+	ValidatorKey []byte `json:"validator_key"`
+}
+
+func main() {
+	flag.Parse()
+	if *verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+	if *minimalConfig {
+		params.UseMinimalConfig()
+	}
+	if *tlsCert == "" || *tlsKey == "" || *tlsClientCACert == "" {
+		log.Fatal("Please specify --tls-cert, --tls-key and --tls-client-ca-cert")
+	}
+
+	sks, err := loadKeys()
+	if err != nil {
+		log.Fatalf("Could not load keys: %v", err)
+	}
+	if len(sks) == 0 {
+		log.Fatal("Please specify --unencrypted-keys or --interop-num-validators to provide keys to sign with")
+	}
+
+	var valDB *db.Store
+	if *protectionDir != "" {
+		pubKeys := make([][48]byte, len(sks))
+		for i, sk := range sks {
+			pubKeys[i] = bytesutil.ToBytes48(sk.PublicKey().Marshal())
+		}
+		valDB, err = db.NewKVStore(*protectionDir, pubKeys)
+		if err != nil {
+			log.Fatalf("Could not open slashing protection database: %v", err)
+		}
+		log.WithField("path", *protectionDir).Info("Slashing protection enabled")
+	} else {
+		log.Warn("Slashing protection disabled; the signer will sign any request")
+	}
+
+	creds, err := serverCredentials(*tlsCert, *tlsKey, *tlsClientCACert)
+	if err != nil {
+		log.Fatalf("Could not get valid credentials: %v", err)
+	}
+
+	s := grpc.NewServer(grpc.Creds(creds))
+	pb.RegisterRemoteSignerServer(s, newServer(*wallet, sks, valDB))
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		log.Fatalf("Could not listen to port :%d: %v", *port, err)
+	}
+	log.WithField("accounts", len(sks)).Infof("Listening for signing requests on port %d", *port)
+	if err := s.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadKeys loads the secret keys to sign with, either from an unencrypted keys file
+// or by generating deterministic interop keys.
+func loadKeys() ([]*bls.SecretKey, error) {
+	if *keysPath == "" {
+		if *numValidators == 0 {
+			return nil, nil
+		}
+		log.Warn("Using deterministic interop keys. Do not do this in production!")
+		sks, _, err := interop.DeterministicallyGenerateKeys(*startIndex, *numValidators)
+		return sks, err
+	}
+
+	log.Warn("Loading unencrypted keys from disk. Do not do this in production!")
+	data, err := ioutil.ReadFile(*keysPath)
+	if err != nil {
+		return nil, err
+	}
+	ctnr := &unencryptedKeysContainer{}
+	if err := json.Unmarshal(data, ctnr); err != nil {
+		return nil, err
+	}
+	sks := make([]*bls.SecretKey, 0, len(ctnr.Keys))
+	for _, key := range ctnr.Keys {
+		sk, err := bls.SecretKeyFromBytes(key.ValidatorKey)
+		if err != nil {
+			return nil, err
+		}
+		sks = append(sks, sk)
+	}
+	return sks, nil
+}
+
+// serverCredentials builds TLS credentials which require clients to present a
+// certificate signed by the given authority.
+func serverCredentials(certPath string, keyPath string, clientCACertPath string) (credentials.TransportCredentials, error) {
+	serverPair, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain server's certificate and/or key")
+	}
+	caCert, err := ioutil.ReadFile(clientCACertPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain client's CA certificate")
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("failed to add client's CA certificate to pool")
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverPair},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    certPool,
+	}), nil
+}
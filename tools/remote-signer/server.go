@@ -0,0 +1,215 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// account is a named secret key held by the signer.
+type account struct {
+	name      string
+	secretKey *bls.SecretKey
+}
+
+// server is a reference implementation of the remote signer, holding its
+// secret keys in memory.
+type server struct {
+	wallet   string
+	accounts map[[48]byte]*account
+	// db holds the attestation and proposal history of the signer's accounts.
+	// Slashing protection is disabled if it is nil.
+	db *db.Store
+	// protectionLock serializes slashing protection checks and updates, so that
+	// concurrent requests for the same key cannot both pass the checks.
+	protectionLock sync.Mutex
+}
+
+// newServer creates a signer holding the provided secret keys. Keys are
+// named <wallet>/Account<n> in the order in which they are provided. If
+// a database is supplied the signer refuses to sign slashable requests.
+func newServer(wallet string, sks []*bls.SecretKey, valDB *db.Store) *server {
+	s := &server{
+		wallet:   wallet,
+		accounts: make(map[[48]byte]*account, len(sks)),
+		db:       valDB,
+	}
+	for i, sk := range sks {
+		pubKey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+		s.accounts[pubKey] = &account{
+			name:      fmt.Sprintf("%s/Account%d", wallet, i),
+			secretKey: sk,
+		}
+	}
+	return s
+}
+
+// ListAccounts returns the accounts held by the signer which match any of the requested paths.
+func (s *server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	matchers := make([]*regexp.Regexp, 0, len(req.Paths))
+	for _, path := range req.Paths {
+		parts := strings.SplitN(path, "/", 2)
+		if parts[0] != s.wallet {
+			continue
+		}
+		accountSpecifier := "^.*$"
+		if len(parts) > 1 && len(parts[1]) > 0 {
+			accountSpecifier = fmt.Sprintf("^%s$", parts[1])
+		}
+		re, err := regexp.Compile(accountSpecifier)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account specifier %q: %v", path, err)
+		}
+		matchers = append(matchers, re)
+	}
+
+	resp := &pb.ListAccountsResponse{}
+	for pubKey, acc := range s.accounts {
+		accountName := strings.TrimPrefix(acc.name, s.wallet+"/")
+		for _, re := range matchers {
+			if re.MatchString(accountName) {
+				key := pubKey
+				resp.Accounts = append(resp.Accounts, &pb.Account{
+					Name:      acc.name,
+					PublicKey: key[:],
+				})
+				break
+			}
+		}
+	}
+	return resp, nil
+}
+
+// Sign signs the provided signing root with the key for the requested public key.
+func (s *server) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	if len(req.SigningRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "signing root must be 32 bytes, received %d", len(req.SigningRoot))
+	}
+	acc, ok := s.account(req.PublicKey)
+	if !ok {
+		return &pb.SignResponse{State: pb.SignResponse_FAILED}, nil
+	}
+	if s.db != nil && isSlashableDomain(req.Domain) {
+		log.WithField("pubKey", fmt.Sprintf("%#x", req.PublicKey)).Warn("Refusing to sign opaque root in slashable domain")
+		return &pb.SignResponse{State: pb.SignResponse_DENIED}, nil
+	}
+	return sign(acc, bytesutil.ToBytes32(req.SigningRoot), req.Domain), nil
+}
+
+// SignAttestation signs attestation data with the key for the requested public key,
+// refusing to do so if the attestation would be slashable.
+func (s *server) SignAttestation(ctx context.Context, req *pb.SignAttestationRequest) (*pb.SignResponse, error) {
+	if req.Data == nil || req.Data.Source == nil || req.Data.Target == nil {
+		return nil, status.Error(codes.InvalidArgument, "attestation data with source and target is required")
+	}
+	acc, ok := s.account(req.PublicKey)
+	if !ok {
+		return &pb.SignResponse{State: pb.SignResponse_FAILED}, nil
+	}
+	root, err := ssz.HashTreeRoot(req.Data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not hash attestation data: %v", err)
+	}
+	if s.db == nil {
+		return sign(acc, root, req.Domain), nil
+	}
+
+	s.protectionLock.Lock()
+	defer s.protectionLock.Unlock()
+	history, err := s.db.AttestationHistory(ctx, req.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get attestation history: %v", err)
+	}
+	sourceEpoch, targetEpoch := req.Data.Source.Epoch, req.Data.Target.Epoch
+	if db.IsNewAttSlashable(history, sourceEpoch, targetEpoch) {
+		log.WithFields(logrus.Fields{
+			"pubKey":      fmt.Sprintf("%#x", req.PublicKey),
+			"sourceEpoch": sourceEpoch,
+			"targetEpoch": targetEpoch,
+		}).Warn("Refusing to sign slashable attestation")
+		return &pb.SignResponse{State: pb.SignResponse_DENIED}, nil
+	}
+	// The history is saved before the signature is released, so a failure to save
+	// can never leave a signed attestation unrecorded.
+	history = db.MarkAttestationForTargetEpoch(history, sourceEpoch, targetEpoch)
+	if err := s.db.SaveAttestationHistory(ctx, req.PublicKey, history); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not save attestation history: %v", err)
+	}
+	return sign(acc, root, req.Domain), nil
+}
+
+// SignProposal signs a block proposal with the key for the requested public key,
+// refusing to do so if the proposal would be slashable.
+func (s *server) SignProposal(ctx context.Context, req *pb.SignProposalRequest) (*pb.SignResponse, error) {
+	if req.Header == nil {
+		return nil, status.Error(codes.InvalidArgument, "block header is required")
+	}
+	acc, ok := s.account(req.PublicKey)
+	if !ok {
+		return &pb.SignResponse{State: pb.SignResponse_FAILED}, nil
+	}
+	root, err := ssz.HashTreeRoot(req.Header)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not hash block header: %v", err)
+	}
+	if s.db == nil {
+		return sign(acc, root, req.Domain), nil
+	}
+
+	s.protectionLock.Lock()
+	defer s.protectionLock.Unlock()
+	history, err := s.db.ProposalHistory(ctx, req.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get proposal history: %v", err)
+	}
+	epoch := req.Header.Slot / params.BeaconConfig().SlotsPerEpoch
+	if db.HasProposedForEpoch(history, epoch) {
+		log.WithFields(logrus.Fields{
+			"pubKey": fmt.Sprintf("%#x", req.PublicKey),
+			"epoch":  epoch,
+		}).Warn("Refusing to sign slashable block proposal")
+		return &pb.SignResponse{State: pb.SignResponse_DENIED}, nil
+	}
+	history = db.SetProposedForEpoch(history, epoch)
+	if err := s.db.SaveProposalHistory(ctx, req.PublicKey, history); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not save proposal history: %v", err)
+	}
+	return sign(acc, root, req.Domain), nil
+}
+
+// account returns the account for the given public key, if held by the signer.
+func (s *server) account(pubKey []byte) (*account, bool) {
+	acc, ok := s.accounts[bytesutil.ToBytes48(pubKey)]
+	if !ok {
+		log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Warn("Received signing request for unknown key")
+	}
+	return acc, ok
+}
+
+func sign(acc *account, root [32]byte, domain uint64) *pb.SignResponse {
+	return &pb.SignResponse{
+		State:     pb.SignResponse_SUCCEEDED,
+		Signature: acc.secretKey.Sign(root[:], domain).Marshal(),
+	}
+}
+
+// isSlashableDomain returns true if signing in the given domain can lead to slashing.
+// The domain type is held in the first 4 bytes of the little-endian encoded domain.
+func isSlashableDomain(domain uint64) bool {
+	domainType := bytesutil.Bytes8(domain)[:4]
+	return bytes.Equal(domainType, params.BeaconConfig().DomainBeaconAttester) ||
+		bytes.Equal(domainType, params.BeaconConfig().DomainBeaconProposer)
+}
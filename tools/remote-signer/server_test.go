@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+)
+
+func TestListAccounts(t *testing.T) {
+	sks := []*bls.SecretKey{bls.RandKey(), bls.RandKey(), bls.RandKey()}
+	s := newServer("Validators", sks, nil)
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  int
+	}{
+		{name: "Wallet", paths: []string{"Validators"}, want: 3},
+		{name: "AllAccounts", paths: []string{"Validators/Account.*"}, want: 3},
+		{name: "SingleAccount", paths: []string{"Validators/Account1"}, want: 1},
+		{name: "MultiplePaths", paths: []string{"Validators/Account0", "Validators/Account2"}, want: 2},
+		{name: "UnknownWallet", paths: []string{"Other"}, want: 0},
+		{name: "NoPaths", paths: nil, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.ListAccounts(context.Background(), &pb.ListAccountsRequest{Paths: tt.paths})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.Accounts) != tt.want {
+				t.Errorf("Wanted %d accounts, received %d", tt.want, len(resp.Accounts))
+			}
+		})
+	}
+}
+
+func TestListAccounts_InvalidSpecifier(t *testing.T) {
+	s := newServer("Validators", []*bls.SecretKey{bls.RandKey()}, nil)
+	if _, err := s.ListAccounts(context.Background(), &pb.ListAccountsRequest{Paths: []string{"Validators/("}}); err == nil {
+		t.Error("Expected error for invalid account specifier")
+	}
+}
+
+func TestSign(t *testing.T) {
+	sk := bls.RandKey()
+	s := newServer("Validators", []*bls.SecretKey{sk}, nil)
+
+	root := [32]byte{'a'}
+	domain := uint64(5)
+	resp, err := s.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:   sk.PublicKey().Marshal(),
+		SigningRoot: root[:],
+		Domain:      domain,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != pb.SignResponse_SUCCEEDED {
+		t.Fatalf("Wanted state %v, received %v", pb.SignResponse_SUCCEEDED, resp.State)
+	}
+	sig, err := bls.SignatureFromBytes(resp.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root[:], sk.PublicKey(), domain) {
+		t.Error("Signature did not verify")
+	}
+}
+
+func TestSign_UnknownKey(t *testing.T) {
+	s := newServer("Validators", []*bls.SecretKey{bls.RandKey()}, nil)
+
+	root := [32]byte{'a'}
+	resp, err := s.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:   bls.RandKey().PublicKey().Marshal(),
+		SigningRoot: root[:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != pb.SignResponse_FAILED {
+		t.Errorf("Wanted state %v, received %v", pb.SignResponse_FAILED, resp.State)
+	}
+}
+
+func setupProtectedServer(t *testing.T) (*server, *bls.SecretKey, func()) {
+	sk := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	valDB := db.SetupDB(t, [][48]byte{pubKey})
+	return newServer("Validators", []*bls.SecretKey{sk}, valDB), sk, func() { db.TeardownDB(t, valDB) }
+}
+
+func attestationRequest(sk *bls.SecretKey, sourceEpoch uint64, targetEpoch uint64, root byte) *pb.SignAttestationRequest {
+	return &pb.SignAttestationRequest{
+		PublicKey: sk.PublicKey().Marshal(),
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: bytesutil.Bytes32(uint64(root)),
+			Source:          &ethpb.Checkpoint{Epoch: sourceEpoch, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: targetEpoch, Root: make([]byte, 32)},
+		},
+	}
+}
+
+func TestSignAttestation_Unprotected(t *testing.T) {
+	sk := bls.RandKey()
+	s := newServer("Validators", []*bls.SecretKey{sk}, nil)
+
+	for i := 0; i < 2; i++ {
+		resp, err := s.SignAttestation(context.Background(), attestationRequest(sk, 1, 2, byte(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.State != pb.SignResponse_SUCCEEDED {
+			t.Errorf("Wanted state %v, received %v", pb.SignResponse_SUCCEEDED, resp.State)
+		}
+	}
+}
+
+func TestSignAttestation_RefusesSlashable(t *testing.T) {
+	s, sk, teardown := setupProtectedServer(t)
+	defer teardown()
+	ctx := context.Background()
+
+	resp, err := s.SignAttestation(ctx, attestationRequest(sk, 2, 3, 'a'))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != pb.SignResponse_SUCCEEDED {
+		t.Fatalf("Wanted state %v, received %v", pb.SignResponse_SUCCEEDED, resp.State)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.SignAttestationRequest
+		want pb.SignResponse_State
+	}{
+		{name: "DoubleVote", req: attestationRequest(sk, 2, 3, 'b'), want: pb.SignResponse_DENIED},
+		{name: "SurroundingVote", req: attestationRequest(sk, 1, 4, 'a'), want: pb.SignResponse_DENIED},
+		{name: "NextEpoch", req: attestationRequest(sk, 3, 4, 'a'), want: pb.SignResponse_SUCCEEDED},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.SignAttestation(ctx, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.State != tt.want {
+				t.Errorf("Wanted state %v, received %v", tt.want, resp.State)
+			}
+		})
+	}
+}
+
+func TestSignProposal_RefusesDoubleProposal(t *testing.T) {
+	s, sk, teardown := setupProtectedServer(t)
+	defer teardown()
+	ctx := context.Background()
+
+	req := &pb.SignProposalRequest{
+		PublicKey: sk.PublicKey().Marshal(),
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:       params.BeaconConfig().SlotsPerEpoch + 1,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+	}
+	resp, err := s.SignProposal(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != pb.SignResponse_SUCCEEDED {
+		t.Fatalf("Wanted state %v, received %v", pb.SignResponse_SUCCEEDED, resp.State)
+	}
+
+	req.Header.StateRoot = bytesutil.Bytes32(1)
+	resp, err = s.SignProposal(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != pb.SignResponse_DENIED {
+		t.Errorf("Wanted state %v, received %v", pb.SignResponse_DENIED, resp.State)
+	}
+}
+
+func TestSign_ProtectedRefusesSlashableDomain(t *testing.T) {
+	s, sk, teardown := setupProtectedServer(t)
+	defer teardown()
+
+	root := [32]byte{'a'}
+	req := &pb.SignRequest{
+		PublicKey:   sk.PublicKey().Marshal(),
+		SigningRoot: root[:],
+		Domain:      bls.ComputeDomain(params.BeaconConfig().DomainBeaconAttester),
+	}
+	resp, err := s.Sign(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != pb.SignResponse_DENIED {
+		t.Errorf("Wanted state %v, received %v", pb.SignResponse_DENIED, resp.State)
+	}
+
+	req.Domain = bls.ComputeDomain(params.BeaconConfig().DomainRandao)
+	resp, err = s.Sign(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.State != pb.SignResponse_SUCCEEDED {
+		t.Errorf("Wanted state %v, received %v", pb.SignResponse_SUCCEEDED, resp.State)
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/pkg/errors"
 	contract "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/hdkey"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -31,6 +32,32 @@ func DecryptKeysFromKeystore(directory string, password string) (map[string]*key
 	return validatorKeys, nil
 }
 
+// ImportToEIP2335 converts the validator keys of an encrypted keystore directory to EIP-2335
+// keystores in the destination directory, encrypted with the same password, so that they can
+// be used by the eip2335 key manager and other Ethereum 2 tooling. Keys which already have a
+// keystore in the destination directory are skipped. It returns the paths of the created keystores.
+func ImportToEIP2335(keystorePath, password, destPath string) ([]string, error) {
+	keys, err := DecryptKeysFromKeystore(keystorePath, password)
+	if err != nil {
+		return nil, err
+	}
+	ks := keystore.NewKeystore(destPath)
+	var paths []string
+	for pubKey, key := range keys {
+		keyFile := ks.JoinPath(fmt.Sprintf("keystore-%s.json", pubKey))
+		if _, err := os.Stat(keyFile); err == nil {
+			log.WithField("path", keyFile).Info("Keystore already imported, skipping")
+			continue
+		}
+		// The keys of the keystore are not derived, so they have no derivation path.
+		if err := ks.StoreKeyEIP2335(keyFile, key, password, ""); err != nil {
+			return paths, errors.Wrap(err, "unable to store key")
+		}
+		paths = append(paths, keyFile)
+	}
+	return paths, nil
+}
+
 // VerifyAccountNotExists checks if a validator has not yet created an account
 // and keystore in the provided directory string.
 func VerifyAccountNotExists(directory string, password string) error {
@@ -85,19 +112,13 @@ func NewValidatorAccount(directory string, password string) error {
 		validatorKeyFile,
 	).Info("Keystore generated for validator signatures at path")
 
-	data, depositRoot, err := keystore.DepositInput(validatorKey, shardWithdrawalKey, params.BeaconConfig().MaxEffectiveBalance)
-	if err != nil {
-		return errors.Wrap(err, "unable to generate deposit data")
-	}
 	testAcc, err := contract.Setup()
 	if err != nil {
 		return errors.Wrap(err, "unable to create simulated backend")
 	}
-	testAcc.TxOpts.GasLimit = 1000000
-
-	tx, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, depositRoot)
+	txData, err := depositTransactionData(testAcc, validatorKey, shardWithdrawalKey)
 	if err != nil {
-		return errors.Wrap(err, "unable to create deposit transaction")
+		return err
 	}
 	log.Info(`Account creation complete! Copy and paste the raw transaction data shown below when issuing a transaction into the ETH1.0 deposit contract to activate your validator client`)
 	fmt.Printf(`
@@ -106,10 +127,75 @@ func NewValidatorAccount(directory string, password string) error {
 %#x
 
 ===================================================================
-`, tx.Data())
+`, txData)
 	return nil
 }
 
+// NewMnemonicAccounts generates a BIP-39 mnemonic and derives the signing and withdrawal keys of a
+// number of validator accounts from it along the paths of EIP-2334. It logs the mnemonic, which is
+// the only backup of the accounts, and the deposit transaction data of each account. The signing keys
+// of the accounts can be recovered from the mnemonic with the mnemonic key manager.
+func NewMnemonicAccounts(numAccounts uint64) (string, error) {
+	mnemonic, err := hdkey.NewMnemonic()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to generate mnemonic")
+	}
+	seed, err := hdkey.SeedFromMnemonic(mnemonic, "")
+	if err != nil {
+		return "", err
+	}
+	signingKeys, withdrawalKeys, err := hdkey.DeriveAccountKeys(seed, 0, numAccounts)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to derive account keys")
+	}
+	testAcc, err := contract.Setup()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to create simulated backend")
+	}
+
+	log.Info(`Account creation complete! Write down the mnemonic shown below and keep it secret, it is the only way to recover your validator accounts`)
+	fmt.Printf(`
+=============================Mnemonic==============================
+
+%s
+
+===================================================================
+`, mnemonic)
+	log.Info(`Copy and paste the raw transaction data shown below when issuing a transaction into the ETH1.0 deposit contract to activate each validator account`)
+	for i := range signingKeys {
+		validatorKey := &keystore.Key{PublicKey: signingKeys[i].PublicKey(), SecretKey: signingKeys[i]}
+		withdrawalKey := &keystore.Key{PublicKey: withdrawalKeys[i].PublicKey(), SecretKey: withdrawalKeys[i]}
+		txData, err := depositTransactionData(testAcc, validatorKey, withdrawalKey)
+		if err != nil {
+			return "", err
+		}
+		fmt.Printf(`
+=================Raw Transaction Data of Account %d=================
+
+%#x
+
+===================================================================
+`, i, txData)
+	}
+	return mnemonic, nil
+}
+
+// depositTransactionData returns the data of the transaction into the ETH1.0 deposit contract which
+// activates a validator with the given signing and withdrawal keys.
+func depositTransactionData(testAcc *contract.TestAccount, validatorKey, withdrawalKey *keystore.Key) ([]byte, error) {
+	data, depositRoot, err := keystore.DepositInput(validatorKey, withdrawalKey, params.BeaconConfig().MaxEffectiveBalance)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to generate deposit data")
+	}
+	testAcc.TxOpts.GasLimit = 1000000
+
+	tx, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, depositRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create deposit transaction")
+	}
+	return tx.Data(), nil
+}
+
 // Exists checks if a validator account at a given keystore path exists.
 func Exists(keystorePath string) (bool, error) {
 	/* #nosec */
@@ -1,10 +1,12 @@
 package accounts
 
 import (
+	"bytes"
 	"io/ioutil"
 	"os"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/shared/hdkey"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -35,3 +37,57 @@ func TestNewValidatorAccount_AccountExists(t *testing.T) {
 		t.Fatalf("Could not remove directory: %v", err)
 	}
 }
+
+func TestImportToEIP2335(t *testing.T) {
+	directory := testutil.TempDir() + "/testkeystore"
+	destination := testutil.TempDir() + "/testeip2335"
+	defer os.RemoveAll(directory)
+	defer os.RemoveAll(destination)
+	validatorKey, err := keystore.NewKey()
+	if err != nil {
+		t.Fatalf("Cannot create new key: %v", err)
+	}
+	ks := keystore.NewKeystore(directory)
+	if err := ks.StoreKey(directory+params.BeaconConfig().ValidatorPrivkeyFileName, validatorKey, "password"); err != nil {
+		t.Fatalf("Unable to store key %v", err)
+	}
+
+	paths, err := ImportToEIP2335(directory, "password", destination)
+	if err != nil {
+		t.Fatalf("Could not import keystore: %v", err)
+	}
+	if len(paths) != 1 {
+		t.Fatalf("Expected 1 imported keystore, got %d", len(paths))
+	}
+	keys, err := keystore.NewKeystore(destination).GetKeysEIP2335(destination, "password")
+	if err != nil {
+		t.Fatalf("Could not decrypt imported keystore: %v", err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("Expected 1 key in imported keystores, got %d", len(keys))
+	}
+	for _, key := range keys {
+		if !bytes.Equal(key.SecretKey.Marshal(), validatorKey.SecretKey.Marshal()) {
+			t.Errorf("Imported key %#x does not match the original key", key.PublicKey.Marshal())
+		}
+	}
+
+	// Importing again does not overwrite the imported keystores.
+	paths, err = ImportToEIP2335(directory, "password", destination)
+	if err != nil {
+		t.Fatalf("Could not import keystore: %v", err)
+	}
+	if len(paths) != 0 {
+		t.Errorf("Expected no keystore to be imported again, got %v", paths)
+	}
+}
+
+func TestNewMnemonicAccounts(t *testing.T) {
+	mnemonic, err := NewMnemonicAccounts(2)
+	if err != nil {
+		t.Fatalf("Could not create mnemonic accounts: %v", err)
+	}
+	if _, err := hdkey.SeedFromMnemonic(mnemonic, ""); err != nil {
+		t.Errorf("Expected a valid mnemonic, received %v", err)
+	}
+}
@@ -0,0 +1,321 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// healthCheckInterval is how often the health of the beacon nodes is checked.
+const healthCheckInterval = 4 * time.Second
+
+// healthCheckTimeout is the time a beacon node has to answer a health check.
+const healthCheckTimeout = 2 * time.Second
+
+// headSlotTolerance is how many slots a synced beacon node may be behind the highest head
+// before another node is preferred over it, so that nodes which are a block apart do not
+// cause the validator to switch back and forth between them.
+const headSlotTolerance = 2
+
+type pinnedNodeKey struct{}
+
+// beaconNode is a connection to one of the beacon nodes, along with its last known health.
+type beaconNode struct {
+	endpoint     string
+	conn         *grpc.ClientConn
+	node         ethpb.NodeClient
+	beaconClient ethpb.BeaconChainClient
+	healthy      bool
+	syncing      bool
+	headSlot     uint64
+}
+
+// beaconNodes is the set of beacon nodes the validator client is connected to. Their
+// health is checked periodically, and the RPCs of the validator client are sent to the
+// healthiest synced node, failing over to the next one when a node is unavailable. As the
+// failover happens in the interceptors of the connections, it is transparent to the
+// validator, which keeps its slashing protection checks local regardless of the node it
+// talks to.
+type beaconNodes struct {
+	lock    sync.RWMutex
+	nodes   []*beaconNode
+	ranked  []*beaconNode
+	changed bool
+}
+
+func newBeaconNodes() *beaconNodes {
+	return &beaconNodes{}
+}
+
+// dial connects to the given endpoints. The dial options must include the interceptors of
+// the beacon nodes for requests to fail over between them.
+func (b *beaconNodes) dial(ctx context.Context, endpoints []string, opts ...grpc.DialOption) error {
+	if len(endpoints) == 0 {
+		return errors.New("no beacon node endpoint")
+	}
+	nodes := make([]*beaconNode, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		conn, err := grpc.DialContext(ctx, endpoint, opts...)
+		if err != nil {
+			for _, n := range nodes {
+				if err := n.conn.Close(); err != nil {
+					log.WithError(err).Error("Could not close connection")
+				}
+			}
+			return errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+		}
+		nodes = append(nodes, &beaconNode{
+			endpoint:     endpoint,
+			conn:         conn,
+			node:         ethpb.NewNodeClient(conn),
+			beaconClient: ethpb.NewBeaconChainClient(conn),
+		})
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.nodes = nodes
+	b.rank()
+	return nil
+}
+
+// conn returns a connection to build the RPC clients on. Requests made on it are sent to
+// the healthiest beacon node.
+func (b *beaconNodes) conn() *grpc.ClientConn {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.nodes[0].conn
+}
+
+// close the connections to the beacon nodes.
+func (b *beaconNodes) close() error {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	var err error
+	for _, n := range b.nodes {
+		if closeErr := n.conn.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// hasHealthy returns whether at least one of the beacon nodes is healthy.
+func (b *beaconNodes) hasHealthy() bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	for _, n := range b.nodes {
+		if n.healthy {
+			return true
+		}
+	}
+	return false
+}
+
+// activeChanged returns whether the beacon node receiving the requests changed since the
+// last call.
+func (b *beaconNodes) activeChanged() bool {
+	if b == nil {
+		return false
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	changed := b.changed
+	b.changed = false
+	return changed
+}
+
+// run checks the health of the beacon nodes periodically until the context is canceled.
+func (b *beaconNodes) run(ctx context.Context) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.checkHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkHealth queries the sync status and chain head of every beacon node, and ranks
+// them accordingly.
+func (b *beaconNodes) checkHealth(ctx context.Context) {
+	b.lock.RLock()
+	nodes := b.nodes
+	b.lock.RUnlock()
+
+	type health struct {
+		syncing  bool
+		headSlot uint64
+		err      error
+	}
+	results := make([]health, len(nodes))
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.WithValue(ctx, pinnedNodeKey{}, n), healthCheckTimeout)
+			defer cancel()
+			s, err := n.node.GetSyncStatus(ctx, &ptypes.Empty{})
+			if err != nil {
+				results[i].err = errors.Wrap(err, "could not get sync status")
+				return
+			}
+			head, err := n.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+			if err != nil {
+				results[i].err = errors.Wrap(err, "could not get chain head")
+				return
+			}
+			results[i] = health{syncing: s.Syncing, headSlot: head.HeadSlot}
+		}(i, n)
+	}
+	wg.Wait()
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for i, n := range nodes {
+		if results[i].err != nil {
+			if n.healthy {
+				log.WithError(results[i].err).WithField("endpoint", n.endpoint).Warn("Beacon node is unhealthy")
+			}
+			n.healthy = false
+			continue
+		}
+		if !n.healthy {
+			log.WithField("endpoint", n.endpoint).Info("Beacon node is healthy")
+		}
+		n.healthy = true
+		n.syncing = results[i].syncing
+		n.headSlot = results[i].headSlot
+	}
+	b.rank()
+}
+
+// rank orders the beacon nodes by preference: synced nodes at the highest head first,
+// then synced nodes behind it, syncing nodes, and finally unhealthy nodes, which are only
+// tried as a last resort. Nodes of the same rank keep the order they were given in. The
+// caller must hold the lock.
+func (b *beaconNodes) rank() {
+	var highestHead uint64
+	for _, n := range b.nodes {
+		if n.healthy && !n.syncing && n.headSlot > highestHead {
+			highestHead = n.headSlot
+		}
+	}
+	tier := func(n *beaconNode) int {
+		switch {
+		case !n.healthy:
+			return 3
+		case n.syncing:
+			return 2
+		case n.headSlot+headSlotTolerance < highestHead:
+			return 1
+		default:
+			return 0
+		}
+	}
+	ranked := make([]*beaconNode, len(b.nodes))
+	copy(ranked, b.nodes)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return tier(ranked[i]) < tier(ranked[j])
+	})
+
+	if len(b.ranked) > 0 && len(ranked) > 0 && b.ranked[0] != ranked[0] {
+		log.WithFields(logrus.Fields{
+			"previous": b.ranked[0].endpoint,
+			"endpoint": ranked[0].endpoint,
+		}).Warn("Switching to another beacon node")
+		b.changed = true
+	}
+	b.ranked = ranked
+}
+
+// candidates returns the beacon nodes to send a request to, in order of preference.
+func (b *beaconNodes) candidates() []*beaconNode {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.ranked
+}
+
+// markUnavailable marks a beacon node as unhealthy after a request to it failed, until
+// the next health check.
+func (b *beaconNodes) markUnavailable(n *beaconNode, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if n.healthy {
+		log.WithError(err).WithField("endpoint", n.endpoint).Warn("Beacon node is unavailable")
+	}
+	n.healthy = false
+	b.rank()
+}
+
+// shouldFailover returns whether a request which failed with the given error should be
+// retried with another beacon node.
+func shouldFailover(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	return status.Code(err) == codes.Unavailable
+}
+
+// unaryInterceptor sends unary requests to the healthiest beacon node, failing over to
+// the next one when a node is unavailable.
+func (b *beaconNodes) unaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if ctx.Value(pinnedNodeKey{}) != nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	err := errors.New("no beacon node")
+	for _, n := range b.candidates() {
+		err = invoker(ctx, method, req, reply, n.conn, opts...)
+		if !shouldFailover(ctx, err) {
+			return err
+		}
+		b.markUnavailable(n, err)
+	}
+	return err
+}
+
+// streamInterceptor opens streams with the healthiest beacon node, failing over to the
+// next one when a node is unavailable. A stream failing after it was opened is not
+// reopened, the caller is expected to retry.
+func (b *beaconNodes) streamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if ctx.Value(pinnedNodeKey{}) != nil {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	err := errors.New("no beacon node")
+	for _, n := range b.candidates() {
+		var stream grpc.ClientStream
+		stream, err = streamer(ctx, desc, n.conn, method, opts...)
+		if !shouldFailover(ctx, err) {
+			return stream, err
+		}
+		b.markUnavailable(n, err)
+	}
+	return nil, err
+}
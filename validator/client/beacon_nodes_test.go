@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setupBeaconNodes(t *testing.T, endpoints ...string) *beaconNodes {
+	b := newBeaconNodes()
+	if err := b.dial(context.Background(), endpoints, grpc.WithInsecure()); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestBeaconNodes_RanksSyncedNodesAtHead(t *testing.T) {
+	b := setupBeaconNodes(t, "a", "b", "c", "d")
+	defer b.close()
+
+	b.nodes[0].healthy = false
+	b.nodes[1].healthy, b.nodes[1].syncing = true, true
+	b.nodes[2].healthy, b.nodes[2].headSlot = true, 90
+	b.nodes[3].healthy, b.nodes[3].headSlot = true, 100
+	b.rank()
+
+	want := []string{"d", "c", "b", "a"}
+	for i, n := range b.candidates() {
+		if n.endpoint != want[i] {
+			t.Errorf("Wanted endpoint %s in position %d, got %s", want[i], i, n.endpoint)
+		}
+	}
+	if !b.activeChanged() {
+		t.Error("Expected active beacon node to have changed")
+	}
+	if b.activeChanged() {
+		t.Error("Expected change of active beacon node to be reported once")
+	}
+
+	// Nodes within the head slot tolerance keep their order.
+	b.nodes[2].headSlot = 100 - headSlotTolerance
+	b.rank()
+	if active := b.candidates()[0].endpoint; active != "c" {
+		t.Errorf("Wanted active endpoint %s, got %s", "c", active)
+	}
+}
+
+func TestBeaconNodes_UnaryInterceptor_FailsOver(t *testing.T) {
+	b := setupBeaconNodes(t, "a", "b")
+	defer b.close()
+	for _, n := range b.nodes {
+		n.healthy = true
+	}
+	b.rank()
+
+	var called []*grpc.ClientConn
+	invoker := func(_ context.Context, _ string, _, _ interface{}, cc *grpc.ClientConn, _ ...grpc.CallOption) error {
+		called = append(called, cc)
+		if cc == b.nodes[0].conn {
+			return status.Error(codes.Unavailable, "connection refused")
+		}
+		return nil
+	}
+	if err := b.unaryInterceptor(context.Background(), "method", nil, nil, b.conn(), invoker); err != nil {
+		t.Fatal(err)
+	}
+	if len(called) != 2 || called[0] != b.nodes[0].conn || called[1] != b.nodes[1].conn {
+		t.Errorf("Expected request to be sent to the first node then the second, got %v", called)
+	}
+	if b.nodes[0].healthy {
+		t.Error("Expected unavailable node to be marked unhealthy")
+	}
+	if active := b.candidates()[0].endpoint; active != "b" {
+		t.Errorf("Wanted active endpoint %s, got %s", "b", active)
+	}
+
+	// Other errors are returned without failing over.
+	called = nil
+	invoker = func(_ context.Context, _ string, _, _ interface{}, cc *grpc.ClientConn, _ ...grpc.CallOption) error {
+		called = append(called, cc)
+		return status.Error(codes.NotFound, "not found")
+	}
+	if err := b.unaryInterceptor(context.Background(), "method", nil, nil, b.conn(), invoker); status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted error code %v, got %v", codes.NotFound, err)
+	}
+	if len(called) != 1 {
+		t.Errorf("Expected request to be sent once, got %d", len(called))
+	}
+}
@@ -17,6 +17,8 @@ type fakeValidator struct {
 	NextSlotRet                      <-chan uint64
 	NextSlotCalled                   bool
 	CanonicalHeadSlotCalled          bool
+	UpdateValidatingKeysCalled       bool
+	UpdateValidatingKeysArg1         uint64
 	UpdateDutiesCalled               bool
 	UpdateDutiesArg1                 uint64
 	UpdateDutiesRet                  error
@@ -66,6 +68,12 @@ func (fv *fakeValidator) NextSlot() <-chan uint64 {
 	return fv.NextSlotRet
 }
 
+func (fv *fakeValidator) UpdateValidatingKeys(_ context.Context, slot uint64) error {
+	fv.UpdateValidatingKeysCalled = true
+	fv.UpdateValidatingKeysArg1 = slot
+	return nil
+}
+
 func (fv *fakeValidator) UpdateDuties(_ context.Context, slot uint64) error {
 	fv.UpdateDutiesCalled = true
 	fv.UpdateDutiesArg1 = slot
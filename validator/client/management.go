@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	vpb "github.com/prysmaticlabs/prysm/proto/validator/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = vpb.ManagementServer(&ValidatorService{})
+
+// ListKeys lists the validating keys, along with their status and balance in the beacon chain.
+func (v *ValidatorService) ListKeys(ctx context.Context, _ *ptypes.Empty) (*vpb.ListKeysResponse, error) {
+	val, err := v.runningValidator()
+	if err != nil {
+		return nil, err
+	}
+	pubKeys, err := val.keyManager.FetchValidatingKeys()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+
+	keys := make([]*vpb.ValidatorKey, 0, len(pubKeys))
+	var indexed []*vpb.ValidatorKey
+	var indices []uint64
+	for _, pubKey := range pubKeys {
+		key := &vpb.ValidatorKey{PublicKey: pubKey[:]}
+		keys = append(keys, key)
+		statusResp, err := val.validatorClient.ValidatorStatus(ctx, &ethpb.ValidatorStatusRequest{PublicKey: pubKey[:]})
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Could not get validator status: %v", err)
+		}
+		key.Status = statusResp.Status.String()
+		indexResp, err := val.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+		if status.Code(err) == codes.NotFound {
+			// The deposit of the validator is not processed yet.
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Could not get validator index: %v", err)
+		}
+		key.Index = indexResp.Index
+		indexed = append(indexed, key)
+		indices = append(indices, indexResp.Index)
+	}
+	if len(indices) == 0 {
+		return &vpb.ListKeysResponse{Keys: keys}, nil
+	}
+
+	balances := make(map[uint64]uint64, len(indices))
+	req := &ethpb.ListValidatorBalancesRequest{Indices: indices}
+	for {
+		resp, err := val.beaconClient.ListValidatorBalances(ctx, req)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Could not list validator balances: %v", err)
+		}
+		for _, b := range resp.Balances {
+			balances[b.Index] = b.Balance
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	for _, key := range indexed {
+		key.Balance = balances[key.Index]
+	}
+	return &vpb.ListKeysResponse{Keys: keys}, nil
+}
+
+// ListDuties lists the duties of the current epoch, as last fetched from the beacon node.
+func (v *ValidatorService) ListDuties(_ context.Context, _ *ptypes.Empty) (*vpb.ListDutiesResponse, error) {
+	val, err := v.runningValidator()
+	if err != nil {
+		return nil, err
+	}
+	duties := val.currentDuties()
+	if duties == nil {
+		return nil, status.Error(codes.NotFound, "Duties not fetched yet")
+	}
+	res := make([]*vpb.Duty, len(duties.Duties))
+	for i, duty := range duties.Duties {
+		res[i] = &vpb.Duty{
+			PublicKey:      duty.PublicKey,
+			ValidatorIndex: duty.ValidatorIndex,
+			CommitteeIndex: duty.CommitteeIndex,
+			AttesterSlot:   duty.AttesterSlot,
+			ProposerSlot:   duty.ProposerSlot,
+		}
+	}
+	return &vpb.ListDutiesResponse{Duties: res}, nil
+}
+
+// GetSlashingProtectionHistory returns the block proposals and attestations recorded in the
+// slashing protection database for a validating key.
+func (v *ValidatorService) GetSlashingProtectionHistory(
+	ctx context.Context,
+	req *vpb.SlashingProtectionHistoryRequest,
+) (*vpb.SlashingProtectionHistory, error) {
+	val, err := v.runningValidator()
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := validatingKey(val, req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	history, err := val.db.ValidatorHistory(ctx, pubKey[:])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not read slashing protection history: %v", err)
+	}
+	res := &vpb.SlashingProtectionHistory{
+		ProposedEpochs: history.ProposedEpochs,
+		Attestations:   make([]*vpb.AttestationRecord, len(history.Attestations)),
+	}
+	for i, att := range history.Attestations {
+		res.Attestations[i] = &vpb.AttestationRecord{
+			SourceEpoch: att.SourceEpoch,
+			TargetEpoch: att.TargetEpoch,
+		}
+	}
+	return res, nil
+}
+
+// ProposeExit signs a voluntary exit at the current epoch for a validating key and submits
+// it to the beacon node.
+func (v *ValidatorService) ProposeExit(ctx context.Context, req *vpb.ProposeExitRequest) (*ptypes.Empty, error) {
+	val, err := v.runningValidator()
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := validatingKey(val, req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := val.ProposeExit(ctx, pubKey); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not propose exit: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// runningValidator returns the validator once the service is started.
+func (v *ValidatorService) runningValidator() (*validator, error) {
+	val, ok := v.validator.(*validator)
+	if !ok || val == nil {
+		return nil, status.Error(codes.Unavailable, "Validator client is not running")
+	}
+	return val, nil
+}
+
+// validatingKey checks that the given public key is one of the keys of the validator.
+func validatingKey(val *validator, pubKey []byte) ([48]byte, error) {
+	if len(pubKey) != 48 {
+		return [48]byte{}, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(pubKey))
+	}
+	pubKeys, err := val.keyManager.FetchValidatingKeys()
+	if err != nil {
+		return [48]byte{}, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	key := bytesutil.ToBytes48(pubKey)
+	for _, k := range pubKeys {
+		if k == key {
+			return key, nil
+		}
+	}
+	return [48]byte{}, status.Errorf(codes.NotFound, "Public key %#x is not a validating key", pubKey)
+}
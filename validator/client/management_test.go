@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	vpb "github.com/prysmaticlabs/prysm/proto/validator/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestManagement_NotRunning(t *testing.T) {
+	vs := &ValidatorService{}
+	if _, err := vs.ListDuties(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("Wanted error code %v, got %v", codes.Unavailable, err)
+	}
+}
+
+func TestManagement_ListDuties(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+	defer db.TeardownDB(t, validator.db)
+	vs := &ValidatorService{validator: validator}
+
+	if _, err := vs.ListDuties(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted error code %v before duties are fetched, got %v", codes.NotFound, err)
+	}
+
+	validator.duties = &ethpb.DutiesResponse{Duties: []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      validatorPubKey[:],
+			ValidatorIndex: 5,
+			CommitteeIndex: 2,
+			AttesterSlot:   70,
+			ProposerSlot:   68,
+		},
+	}}
+	resp, err := vs.ListDuties(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Duties) != 1 {
+		t.Fatalf("Wanted %d duty, got %d", 1, len(resp.Duties))
+	}
+	if duty := resp.Duties[0]; duty.ValidatorIndex != 5 || duty.AttesterSlot != 70 || duty.ProposerSlot != 68 {
+		t.Errorf("Unexpected duty %v", duty)
+	}
+}
+
+func TestManagement_GetSlashingProtectionHistory(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+	defer db.TeardownDB(t, validator.db)
+	vs := &ValidatorService{validator: validator}
+
+	attHistory := &slashpb.AttestationHistory{
+		TargetToSource: map[uint64]uint64{0: params.BeaconConfig().FarFutureEpoch},
+	}
+	attHistory = db.MarkAttestationForTargetEpoch(attHistory, 2, 3)
+	if err := validator.db.SaveAttestationHistory(context.Background(), validatorPubKey[:], attHistory); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := vs.GetSlashingProtectionHistory(context.Background(), &vpb.SlashingProtectionHistoryRequest{
+		PublicKey: validatorPubKey[:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Attestations) != 1 || resp.Attestations[0].SourceEpoch != 2 || resp.Attestations[0].TargetEpoch != 3 {
+		t.Errorf("Unexpected attestations %v", resp.Attestations)
+	}
+
+	// Only the history of validating keys is served.
+	if _, err := vs.GetSlashingProtectionHistory(context.Background(), &vpb.SlashingProtectionHistoryRequest{
+		PublicKey: make([]byte, 48),
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted error code %v, got %v", codes.NotFound, err)
+	}
+}
+
+func TestManagement_ProposeExit(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	defer db.TeardownDB(t, validator.db)
+	vs := &ValidatorService{validator: validator}
+	validator.genesisTime = uint64(roughtime.Now().Unix()) - 3*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot
+
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		&ethpb.ValidatorIndexRequest{PublicKey: validatorPubKey[:]},
+	).Return(&ethpb.ValidatorIndexResponse{Index: 7}, nil)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
+	m.validatorClient.EXPECT().ProposeExit(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.SignedVoluntaryExit{}),
+	).Do(func(_ context.Context, exit *ethpb.SignedVoluntaryExit) {
+		if exit.Exit.ValidatorIndex != 7 || exit.Exit.Epoch != 3 {
+			t.Errorf("Unexpected exit %v", exit.Exit)
+		}
+		if len(exit.Signature) == 0 {
+			t.Error("Expected exit to be signed")
+		}
+	}).Return(&ptypes.Empty{}, nil)
+
+	if _, err := vs.ProposeExit(context.Background(), &vpb.ProposeExitRequest{PublicKey: validatorPubKey[:]}); err != nil {
+		t.Fatal(err)
+	}
+}
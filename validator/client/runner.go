@@ -12,6 +12,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// retryInterval is the time to wait before retrying a failed step of the validator startup.
+const retryInterval = 5 * time.Second
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
@@ -22,6 +25,7 @@ type Validator interface {
 	NextSlot() <-chan uint64
 	SlotDeadline(slot uint64) time.Time
 	LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error
+	UpdateValidatingKeys(ctx context.Context, slot uint64) error
 	UpdateDuties(ctx context.Context, slot uint64) error
 	RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb.ValidatorRole, error) // validator pubKey -> roles
 	SubmitAttestation(ctx context.Context, slot uint64, pubKey [48]byte)
@@ -37,23 +41,28 @@ type Validator interface {
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
 // 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 4 - Apply changes to the validating keys
+// 5 - Update assignments
+// 6 - Determine role at current slot
+// 7 - Perform assigned role, if any
 func run(ctx context.Context, v Validator) {
 	defer v.Done()
-	if err := v.WaitForChainStart(ctx); err != nil {
-		log.Fatalf("Could not determine if beacon chain started: %v", err)
+	if !retry(ctx, "Could not determine if beacon chain started", v.WaitForChainStart) {
+		return
 	}
-	if err := v.WaitForSync(ctx); err != nil {
-		log.Fatalf("Could not determine if beacon node synced: %v", err)
+	if !retry(ctx, "Could not determine if beacon node synced", v.WaitForSync) {
+		return
 	}
-	if err := v.WaitForActivation(ctx); err != nil {
-		log.Fatalf("Could not wait for validator activation: %v", err)
+	if !retry(ctx, "Could not wait for validator activation", v.WaitForActivation) {
+		return
 	}
-	headSlot, err := v.CanonicalHeadSlot(ctx)
-	if err != nil {
-		log.Fatalf("Could not get current canonical head slot: %v", err)
+	var headSlot uint64
+	if !retry(ctx, "Could not get current canonical head slot", func(ctx context.Context) error {
+		var err error
+		headSlot, err = v.CanonicalHeadSlot(ctx)
+		return err
+	}) {
+		return
 	}
 	if err := v.UpdateDuties(ctx, headSlot); err != nil {
 		handleAssignmentError(err, headSlot)
@@ -74,6 +83,12 @@ func run(ctx context.Context, v Validator) {
 				log.WithError(err).Error("Could not report validator's rewards/penalties")
 			}
 
+			// Start or stop performing duties for the keys added to or removed from the key
+			// manager, at the start of an epoch before its assignments are fetched.
+			if err := v.UpdateValidatingKeys(ctx, slot); err != nil {
+				log.WithError(err).Error("Could not update validating keys")
+			}
+
 			// Keep trying to update assignments if they are nil or if we are past an
 			// epoch transition in the beacon node's state.
 			if err := v.UpdateDuties(ctx, slot); err != nil {
@@ -129,3 +144,24 @@ func handleAssignmentError(err error, slot uint64) {
 		log.WithField("error", err).Error("Failed to update assignments")
 	}
 }
+
+// retry calls fn until it succeeds, logging the given message on failure, so that the
+// validator keeps waiting through beacon node outages instead of exiting. It returns false
+// if the context is canceled first.
+func retry(ctx context.Context, msg string, fn func(context.Context) error) bool {
+	for {
+		err := fn(ctx)
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		log.WithError(err).Error(msg)
+		select {
+		case <-time.After(retryInterval):
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
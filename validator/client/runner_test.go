@@ -64,6 +64,29 @@ func TestUpdateDuties_NextSlot(t *testing.T) {
 	}
 }
 
+func TestUpdateValidatingKeys_NextSlot(t *testing.T) {
+	v := &fakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slot := uint64(64)
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	if !v.UpdateValidatingKeysCalled {
+		t.Fatalf("Expected UpdateValidatingKeys(%d) to be called", slot)
+	}
+	if v.UpdateValidatingKeysArg1 != slot {
+		t.Errorf("UpdateValidatingKeys was called with wrong argument. Want=%d, got=%d", slot, v.UpdateValidatingKeysArg1)
+	}
+}
+
 func TestUpdateDuties_HandlesError(t *testing.T) {
 	hook := logTest.NewGlobal()
 	v := &fakeValidator{}
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
@@ -26,8 +27,8 @@ type ValidatorService struct {
 	cancel               context.CancelFunc
 	validator            Validator
 	graffiti             []byte
-	conn                 *grpc.ClientConn
-	endpoint             string
+	beaconNodes          *beaconNodes
+	endpoints            []string
 	withCert             string
 	dataDir              string
 	keyManager           keymanager.KeyManager
@@ -37,7 +38,7 @@ type ValidatorService struct {
 
 // Config for the validator service.
 type Config struct {
-	Endpoint                   string
+	Endpoints                  []string
 	DataDir                    string
 	CertFlag                   string
 	GraffitiFlag               string
@@ -53,7 +54,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 	return &ValidatorService{
 		ctx:                  ctx,
 		cancel:               cancel,
-		endpoint:             cfg.Endpoint,
+		endpoints:            cfg.Endpoints,
 		withCert:             cfg.CertFlag,
 		dataDir:              cfg.DataDir,
 		graffiti:             []byte(cfg.GraffitiFlag),
@@ -87,6 +88,7 @@ func (v *ValidatorService) Start() {
 		maxCallRecvMsgSize = 10 * 5 << 20 // Default 50Mb
 	}
 
+	nodes := newBeaconNodes()
 	opts := []grpc.DialOption{
 		dialOpt,
 		grpc.WithDefaultCallOptions(
@@ -94,21 +96,31 @@ func (v *ValidatorService) Start() {
 		),
 		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
 		grpc.WithStreamInterceptor(middleware.ChainStreamClient(
+			nodes.streamInterceptor,
 			grpc_opentracing.StreamClientInterceptor(),
 			grpc_prometheus.StreamClientInterceptor,
 		)),
 		grpc.WithUnaryInterceptor(middleware.ChainUnaryClient(
+			nodes.unaryInterceptor,
 			grpc_opentracing.UnaryClientInterceptor(),
 			grpc_prometheus.UnaryClientInterceptor,
 		)),
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, opts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+	if err := nodes.dial(v.ctx, v.endpoints, opts...); err != nil {
+		log.Errorf("Could not dial beacon nodes: %v", err)
 		return
 	}
-	log.Info("Successfully started gRPC connection")
+	v.beaconNodes = nodes
+	log.WithField("endpoints", v.endpoints).Info("Successfully started gRPC connections")
+	nodes.checkHealth(v.ctx)
+	go nodes.run(v.ctx)
 
+	// Subscribe to the changes of the keys before fetching them, so that none is missed.
+	keyChanges := make(chan [][48]byte, 1)
+	var keysSub event.Subscription
+	if notifier, ok := v.keyManager.(keymanager.KeyChangeNotifier); ok {
+		keysSub = notifier.SubscribeKeyChanges(v.ctx, keyChanges)
+	}
 	pubkeys, err := v.keyManager.FetchValidatingKeys()
 	if err != nil {
 		log.Errorf("Could not get validating keys: %v", err)
@@ -121,19 +133,27 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	v.conn = conn
-	v.validator = &validator{
+	// The clients are built on a single connection, their requests are routed to the
+	// healthiest beacon node by its interceptors.
+	conn := nodes.conn()
+	val := &validator{
 		db:                   valDB,
-		validatorClient:      ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:         ethpb.NewBeaconChainClient(v.conn),
-		aggregatorClient:     pb.NewAggregatorServiceClient(v.conn),
-		node:                 ethpb.NewNodeClient(v.conn),
+		validatorClient:      ethpb.NewBeaconNodeValidatorClient(conn),
+		beaconClient:         ethpb.NewBeaconChainClient(conn),
+		aggregatorClient:     pb.NewAggregatorServiceClient(conn),
+		node:                 ethpb.NewNodeClient(conn),
+		beaconNodes:          nodes,
 		keyManager:           v.keyManager,
+		pubKeys:              pubkeys,
 		graffiti:             v.graffiti,
 		logValidatorBalances: v.logValidatorBalances,
 		prevBalance:          make(map[[48]byte]uint64),
 		attLogs:              make(map[[32]byte]*attSubmitted),
 	}
+	v.validator = val
+	if keysSub != nil {
+		go val.watchKeyChanges(v.ctx, keyChanges, keysSub)
+	}
 	go run(v.ctx, v.validator)
 }
 
@@ -141,8 +161,8 @@ func (v *ValidatorService) Start() {
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if v.beaconNodes != nil {
+		return v.beaconNodes.close()
 	}
 	return nil
 }
@@ -151,8 +171,11 @@ func (v *ValidatorService) Stop() error {
 //
 // WIP - not done.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if v.beaconNodes == nil {
 		return errors.New("no connection to beacon RPC")
 	}
+	if !v.beaconNodes.hasHealthy() {
+		return errors.New("no healthy beacon node")
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
@@ -25,16 +26,23 @@ import (
 )
 
 type validator struct {
-	genesisTime          uint64
+	genesisTime          uint64 // Accessed atomically, as it is read by the management RPCs.
 	ticker               *slotutil.SlotTicker
 	db                   *db.Store
 	duties               *ethpb.DutiesResponse
+	dutiesLock           sync.RWMutex
 	validatorClient      ethpb.BeaconNodeValidatorClient
 	beaconClient         ethpb.BeaconChainClient
 	graffiti             []byte
 	aggregatorClient     pb.AggregatorServiceClient
 	node                 ethpb.NodeClient
+	beaconNodes          *beaconNodes
 	keyManager           keymanager.KeyManager
+	keysLock             sync.Mutex
+	pubKeys              [][48]byte
+	newKeys              map[[48]byte]bool
+	pendingKeys          [][48]byte
+	keysChanged          bool
 	prevBalance          map[[48]byte]uint64
 	logValidatorBalances bool
 	attLogs              map[[32]byte]*attSubmitted
@@ -43,7 +51,9 @@ type validator struct {
 
 // Done cleans up the validator.
 func (v *validator) Done() {
-	v.ticker.Done()
+	if v.ticker != nil {
+		v.ticker.Done()
+	}
 }
 
 // WaitForChainStart checks whether the beacon node has started its runtime. That is,
@@ -72,13 +82,13 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "could not receive ChainStart from stream")
 		}
-		v.genesisTime = chainStartRes.GenesisTime
+		atomic.StoreUint64(&v.genesisTime, chainStartRes.GenesisTime)
 		break
 	}
 	// Once the ChainStart log is received, we update the genesis time of the validator client
 	// and begin a slot ticker used to track the current slot the beacon node is in.
-	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
-	log.WithField("genesisTime", time.Unix(int64(v.genesisTime), 0)).Info("Beacon chain started")
+	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.chainGenesisTime()), 0), params.BeaconConfig().SecondsPerSlot)
+	log.WithField("genesisTime", time.Unix(int64(v.chainGenesisTime()), 0)).Info("Beacon chain started")
 	return nil
 }
 
@@ -88,7 +98,7 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 func (v *validator) WaitForActivation(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "validator.WaitForActivation")
 	defer span.End()
-	validatingKeys, err := v.keyManager.FetchValidatingKeys()
+	validatingKeys, err := v.validatingKeys()
 	if err != nil {
 		return errors.Wrap(err, "could not fetch validating keys")
 	}
@@ -124,7 +134,7 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 	for _, pubKey := range validatorActivatedRecords {
 		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Validator activated")
 	}
-	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.chainGenesisTime()), 0), params.BeaconConfig().SecondsPerSlot)
 
 	return nil
 }
@@ -216,15 +226,16 @@ func (v *validator) NextSlot() <-chan uint64 {
 // SlotDeadline is the start time of the next slot.
 func (v *validator) SlotDeadline(slot uint64) time.Time {
 	secs := (slot + 1) * params.BeaconConfig().SecondsPerSlot
-	return time.Unix(int64(v.genesisTime), 0 /*ns*/).Add(time.Duration(secs) * time.Second)
+	return time.Unix(int64(v.chainGenesisTime()), 0 /*ns*/).Add(time.Duration(secs) * time.Second)
 }
 
 // UpdateDuties checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
-		// Do nothing if not epoch start AND assignments already exist.
+	// Do nothing if not epoch start AND assignments already exist, unless the validator
+	// switched to another beacon node, which has to be subscribed to the committee subnets.
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil && !v.beaconNodes.activeChanged() {
 		return nil
 	}
 	// Set deadline to end of epoch.
@@ -233,7 +244,7 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
 	defer span.End()
 
-	validatingKeys, err := v.keyManager.FetchValidatingKeys()
+	validatingKeys, err := v.validatingKeys()
 	if err != nil {
 		return err
 	}
@@ -244,12 +255,15 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 
 	resp, err := v.validatorClient.GetDuties(ctx, req)
 	if err != nil {
-		v.duties = nil // Clear assignments so we know to retry the request.
+		v.setDuties(nil) // Clear assignments so we know to retry the request.
 		log.Error(err)
 		return err
 	}
 
-	v.duties = resp
+	v.setDuties(resp)
+	if err := v.subscribeToSubnets(ctx, resp); err != nil {
+		log.WithError(err).Error("Could not subscribe to committee subnets")
+	}
 	// Only log the full assignments output on epoch start to be less verbose.
 	if slot%params.BeaconConfig().SlotsPerEpoch == 0 {
 		for _, duty := range v.duties.Duties {
@@ -275,12 +289,60 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	return nil
 }
 
+// chainGenesisTime returns the genesis time of the beacon chain, which is 0 until the chain
+// has started.
+func (v *validator) chainGenesisTime() uint64 {
+	return atomic.LoadUint64(&v.genesisTime)
+}
+
+// currentDuties returns the last fetched duties, which are replaced rather than modified
+// when fetched again, so that they can be read while the duties are being updated.
+func (v *validator) currentDuties() *ethpb.DutiesResponse {
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+	return v.duties
+}
+
+func (v *validator) setDuties(duties *ethpb.DutiesResponse) {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	v.duties = duties
+}
+
+// subscribeToSubnets reports the attestation duties of the active validators to the beacon
+// node, so that it joins the subnets of their aggregation duties ahead of time.
+func (v *validator) subscribeToSubnets(ctx context.Context, res *ethpb.DutiesResponse) error {
+	var subscriptions []*pb.CommitteeSubnetSubscription
+	for _, duty := range res.Duties {
+		if duty == nil || duty.Status != ethpb.ValidatorStatus_ACTIVE {
+			continue
+		}
+		aggregator, err := v.isAggregator(ctx, duty.Committee, duty.AttesterSlot, bytesutil.ToBytes48(duty.PublicKey))
+		if err != nil {
+			return errors.Wrap(err, "could not check if a validator is an aggregator")
+		}
+		subscriptions = append(subscriptions, &pb.CommitteeSubnetSubscription{
+			PublicKey:      duty.PublicKey,
+			Slot:           duty.AttesterSlot,
+			CommitteeIndex: duty.CommitteeIndex,
+			IsAggregator:   aggregator,
+		})
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+	_, err := v.aggregatorClient.SubscribeCommitteeSubnets(ctx, &pb.CommitteeSubnetsSubscribeRequest{
+		Subscriptions: subscriptions,
+	})
+	return err
+}
+
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
 // validator is known to not have a roles at the at slot. Returns UNKNOWN if the
 // validator assignments are unknown. Otherwise returns a valid ValidatorRole map.
 func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]pb.ValidatorRole, error) {
 	rolesAt := make(map[[48]byte][]pb.ValidatorRole)
-	for _, duty := range v.duties.Duties {
+	for _, duty := range v.currentDuties().Duties {
 		var roles []pb.ValidatorRole
 
 		if duty == nil {
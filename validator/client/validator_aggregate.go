@@ -93,7 +93,7 @@ func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot uint64) {
 	twoThird := params.BeaconConfig().SecondsPerSlot * 2 / 3
 	delay := time.Duration(twoThird) * time.Second
 
-	startTime := slotutil.SlotStartTime(v.genesisTime, slot)
+	startTime := slotutil.SlotStartTime(v.chainGenesisTime(), slot)
 	finalTime := startTime.Add(delay)
 	time.Sleep(roughtime.Until(finalTime))
 }
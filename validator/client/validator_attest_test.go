@@ -12,11 +12,12 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
-	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -393,132 +394,61 @@ func TestWaitForSlotOneThird_WaitCorrectly(t *testing.T) {
 	}
 }
 
-func TestAttestationHistory_BlocksDoubleAttestation(t *testing.T) {
-	newMap := make(map[uint64]uint64)
-	newMap[0] = params.BeaconConfig().FarFutureEpoch
-	attestations := &slashpb.AttestationHistory{
-		TargetToSource:     newMap,
-		LatestEpochWritten: 0,
-	}
-
-	// Mark an attestation spanning epochs 0 to 3.
-	newAttSource := uint64(0)
-	newAttTarget := uint64(3)
-	attestations = markAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
-	if attestations.LatestEpochWritten != newAttTarget {
-		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
-	}
-
-	// Try an attestation that should be slashable (double att) spanning epochs 1 to 3.
-	newAttSource = uint64(1)
-	newAttTarget = uint64(3)
-	if !isNewAttSlashable(attestations, newAttSource, newAttTarget) {
-		t.Fatalf("Expected attestation of source %d and target %d to be considered slashable", newAttSource, newAttTarget)
-	}
+// protectingKeyManager records which of its signing methods have been used.
+type protectingKeyManager struct {
+	keymanager.KeyManager
+	signedAttestation bool
+	signedProposal    bool
 }
 
-func TestAttestationHistory_Prunes(t *testing.T) {
-	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
-	newMap := make(map[uint64]uint64)
-	newMap[0] = params.BeaconConfig().FarFutureEpoch
-	attestations := &slashpb.AttestationHistory{
-		TargetToSource:     newMap,
-		LatestEpochWritten: 0,
-	}
-
-	// Try an attestation on totally unmarked history, should not be slashable.
-	if isNewAttSlashable(attestations, 0, wsPeriod+5) {
-		t.Fatalf("Expected attestation of source 0, target %d to be considered slashable", wsPeriod+5)
-	}
-
-	// Mark attestations spanning epochs 0 to 3 and 6 to 9.
-	prunedNewAttSource := uint64(0)
-	prunedNewAttTarget := uint64(3)
-	attestations = markAttestationForTargetEpoch(attestations, prunedNewAttSource, prunedNewAttTarget)
-	newAttSource := prunedNewAttSource + 6
-	newAttTarget := prunedNewAttTarget + 6
-	attestations = markAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
-	if attestations.LatestEpochWritten != newAttTarget {
-		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
-	}
-
-	// Mark an attestation spanning epochs 54000 to 54003.
-	farNewAttSource := newAttSource + wsPeriod
-	farNewAttTarget := newAttTarget + wsPeriod
-	attestations = markAttestationForTargetEpoch(attestations, farNewAttSource, farNewAttTarget)
-	if attestations.LatestEpochWritten != farNewAttTarget {
-		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
-	}
-
-	if safeTargetToSource(attestations, prunedNewAttTarget) != params.BeaconConfig().FarFutureEpoch {
-		t.Fatalf("Expected attestation at target epoch %d to not be marked", prunedNewAttTarget)
-	}
-
-	if safeTargetToSource(attestations, farNewAttTarget) != farNewAttSource {
-		t.Fatalf("Expected attestation at target epoch %d to not be marked", farNewAttSource)
-	}
-
-	// Try an attestation from existing source to outside prune, should slash.
-	if !isNewAttSlashable(attestations, newAttSource, farNewAttTarget) {
-		t.Fatalf("Expected attestation of source %d, target %d to be considered slashable", newAttSource, farNewAttTarget)
-	}
-	// Try an attestation from before existing target to outside prune, should slash.
-	if !isNewAttSlashable(attestations, newAttTarget-1, farNewAttTarget) {
-		t.Fatalf("Expected attestation of source %d, target %d to be considered slashable", newAttTarget-1, farNewAttTarget)
-	}
-	// Try an attestation larger than pruning amount, should slash.
-	if !isNewAttSlashable(attestations, 0, farNewAttTarget+5) {
-		t.Fatalf("Expected attestation of source 0, target %d to be considered slashable", farNewAttTarget+5)
+func (km *protectingKeyManager) SignAttestation(pubKey [48]byte, domain uint64, data *ethpb.AttestationData) (*bls.Signature, error) {
+	km.signedAttestation = true
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		return nil, err
 	}
+	return km.Sign(pubKey, root, domain)
 }
 
-func TestAttestationHistory_BlocksSurroundedAttestation(t *testing.T) {
-	newMap := make(map[uint64]uint64)
-	newMap[0] = params.BeaconConfig().FarFutureEpoch
-	attestations := &slashpb.AttestationHistory{
-		TargetToSource:     newMap,
-		LatestEpochWritten: 0,
+func (km *protectingKeyManager) SignProposal(pubKey [48]byte, domain uint64, data *ethpb.BeaconBlockHeader) (*bls.Signature, error) {
+	km.signedProposal = true
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		return nil, err
 	}
+	return km.Sign(pubKey, root, domain)
+}
 
-	// Mark an attestation spanning epochs 0 to 3.
-	newAttSource := uint64(0)
-	newAttTarget := uint64(3)
-	attestations = markAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
-	if attestations.LatestEpochWritten != newAttTarget {
-		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
-	}
+func TestSignAtt_UsesProtectingKeyManager(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	km := &protectingKeyManager{KeyManager: testKeyManager}
+	validator.keyManager = km
 
-	// Try an attestation that should be slashable (being surrounded) spanning epochs 1 to 2.
-	newAttSource = uint64(1)
-	newAttTarget = uint64(2)
-	if !isNewAttSlashable(attestations, newAttSource, newAttTarget) {
-		t.Fatalf("Expected attestation of source %d and target %d to be considered slashable", newAttSource, newAttTarget)
-	}
-}
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
 
-func TestAttestationHistory_BlocksSurroundingAttestation(t *testing.T) {
-	newMap := make(map[uint64]uint64)
-	newMap[0] = params.BeaconConfig().FarFutureEpoch
-	attestations := &slashpb.AttestationHistory{
-		TargetToSource:     newMap,
-		LatestEpochWritten: 0,
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: []byte("A"),
+		Target:          &ethpb.Checkpoint{Root: []byte("B"), Epoch: 1},
+		Source:          &ethpb.Checkpoint{Root: []byte("C"), Epoch: 0},
 	}
-
-	// Mark an attestation spanning epochs 1 to 2.
-	newAttSource := uint64(1)
-	newAttTarget := uint64(2)
-	attestations = markAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
-	if attestations.LatestEpochWritten != newAttTarget {
-		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
+	sig, err := validator.signAtt(context.Background(), validatorPubKey, data)
+	if err != nil {
+		t.Fatal(err)
 	}
-	if attestations.TargetToSource[newAttTarget] != newAttSource {
-		t.Fatalf("Expected source epoch to be %d, received %d", newAttSource, attestations.TargetToSource[newAttTarget])
+	if !km.signedAttestation {
+		t.Error("Expected attestation to be signed through the protecting key manager")
 	}
 
-	// Try an attestation that should be slashable (surrounding) spanning epochs 0 to 3.
-	newAttSource = uint64(0)
-	newAttTarget = uint64(3)
-	if !isNewAttSlashable(attestations, newAttSource, newAttTarget) {
-		t.Fatalf("Expected attestation of source %d and target %d to be considered slashable", newAttSource, newAttTarget)
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := validatorKey.SecretKey.Sign(root[:], 0).Marshal()
+	if !reflect.DeepEqual(sig, wanted) {
+		t.Errorf("Incorrect signature; wanted %#x, received %#x", wanted, sig)
 	}
 }
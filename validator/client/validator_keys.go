@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// validatingKeys returns the keys the validator performs duties for, which are the keys of
+// its key manager if they are not tracked by the validator.
+func (v *validator) validatingKeys() ([][48]byte, error) {
+	v.keysLock.Lock()
+	defer v.keysLock.Unlock()
+	if v.pubKeys == nil {
+		return v.keyManager.FetchValidatingKeys()
+	}
+	return v.pubKeys, nil
+}
+
+// watchKeyChanges records the changes of the keys of the key manager received from the
+// subscription, to be applied at the start of the next epoch. It returns once the context
+// is canceled.
+func (v *validator) watchKeyChanges(ctx context.Context, ch <-chan [][48]byte, sub event.Subscription) {
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case keys := <-ch:
+			v.keysLock.Lock()
+			v.pendingKeys = keys
+			v.keysChanged = true
+			v.keysLock.Unlock()
+			log.WithField("keys", len(keys)).Info("Validating keys changed, applying at the start of the next epoch")
+		case err := <-sub.Err():
+			if err != nil {
+				log.WithError(err).Error("Could not watch validating keys")
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// UpdateValidatingKeys applies the changes of the keys of the key manager at the start of
+// an epoch, so that the duties of an epoch are performed by a stable set of keys. Removed
+// keys stop performing duties right away. Added keys have their slashing protection history
+// initialized, then join the duties at the start of the epoch following their activation.
+func (v *validator) UpdateValidatingKeys(ctx context.Context, slot uint64) error {
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.UpdateValidatingKeys")
+	defer span.End()
+
+	v.keysLock.Lock()
+	defer v.keysLock.Unlock()
+	if v.keysChanged {
+		keys := make(map[[48]byte]bool, len(v.pendingKeys))
+		var added [][48]byte
+		for _, pubKey := range v.pendingKeys {
+			keys[pubKey] = true
+			if !v.holdsKey(pubKey) {
+				added = append(added, pubKey)
+			}
+		}
+		// The history of new keys must exist before they sign anything. If it cannot be
+		// initialized, the change is applied again at the next epoch.
+		if err := v.db.InitializeHistory(ctx, added); err != nil {
+			return errors.Wrap(err, "could not initialize slashing protection history")
+		}
+
+		pubKeys := make([][48]byte, 0, len(v.pubKeys))
+		for _, pubKey := range v.pubKeys {
+			if keys[pubKey] {
+				pubKeys = append(pubKeys, pubKey)
+				continue
+			}
+			log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Stopped validating with removed key")
+		}
+		v.pubKeys = pubKeys
+		for pubKey := range v.newKeys {
+			if !keys[pubKey] {
+				delete(v.newKeys, pubKey)
+			}
+		}
+		if len(added) > 0 {
+			if v.newKeys == nil {
+				v.newKeys = make(map[[48]byte]bool)
+			}
+			for _, pubKey := range added {
+				v.newKeys[pubKey] = false
+				log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Waiting for activation of added key")
+			}
+			go v.waitForKeysActivation(ctx, added)
+		}
+		v.pendingKeys = nil
+		v.keysChanged = false
+	}
+
+	for pubKey, activated := range v.newKeys {
+		if !activated {
+			continue
+		}
+		v.pubKeys = append(v.pubKeys, pubKey)
+		delete(v.newKeys, pubKey)
+		log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Info("Started validating with added key")
+	}
+	return nil
+}
+
+// holdsKey returns whether a key performs duties or waits for activation. The keys lock must
+// be held by the caller.
+func (v *validator) holdsKey(pubKey [48]byte) bool {
+	if _, ok := v.newKeys[pubKey]; ok {
+		return true
+	}
+	for _, k := range v.pubKeys {
+		if k == pubKey {
+			return true
+		}
+	}
+	return false
+}
+
+// waitForKeysActivation waits in the background for the activation of keys added while the
+// validator runs, marking them as activated so that they join the duties at the next epoch.
+// It returns once all of the keys are activated or removed, or the context is canceled.
+func (v *validator) waitForKeysActivation(ctx context.Context, pubKeys [][48]byte) {
+	for {
+		waiting := v.waitingKeys(pubKeys)
+		if len(waiting) == 0 {
+			return
+		}
+		activated, err := v.activatedKeys(ctx, waiting)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.WithError(err).Error("Could not wait for activation of added keys")
+			select {
+			case <-time.After(retryInterval):
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+		v.keysLock.Lock()
+		for _, pubKey := range activated {
+			if _, ok := v.newKeys[pubKey]; ok {
+				v.newKeys[pubKey] = true
+			}
+		}
+		v.keysLock.Unlock()
+	}
+}
+
+// waitingKeys returns the given keys which are still waiting for activation.
+func (v *validator) waitingKeys(pubKeys [][48]byte) [][48]byte {
+	v.keysLock.Lock()
+	defer v.keysLock.Unlock()
+	var waiting [][48]byte
+	for _, pubKey := range pubKeys {
+		if activated, ok := v.newKeys[pubKey]; ok && !activated {
+			waiting = append(waiting, pubKey)
+		}
+	}
+	return waiting
+}
+
+// activatedKeys blocks until the beacon node reports some of the given keys as active, and
+// returns them.
+func (v *validator) activatedKeys(ctx context.Context, pubKeys [][48]byte) ([][48]byte, error) {
+	stream, err := v.validatorClient.WaitForActivation(ctx, &ethpb.ValidatorActivationRequest{
+		PublicKeys: bytesutil.FromBytes48Array(pubKeys),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not setup validator WaitForActivation streaming client")
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil, errors.New("activation stream closed before any key was activated")
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "could not receive validator activation from stream")
+		}
+		activated := v.checkAndLogValidatorStatus(res.Statuses)
+		if len(activated) > 0 {
+			keys := make([][48]byte, len(activated))
+			for i, pubKey := range activated {
+				keys[i] = bytesutil.ToBytes48(pubKey)
+			}
+			return keys, nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+)
+
+func TestUpdateValidatingKeys_NotEpochStart(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+	defer db.TeardownDB(t, validator.db)
+	validator.pubKeys = [][48]byte{validatorPubKey}
+	validator.pendingKeys = [][48]byte{}
+	validator.keysChanged = true
+
+	if err := validator.UpdateValidatingKeys(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := validator.validatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != validatorPubKey {
+		t.Errorf("Expected keys to change at the start of an epoch only, got %v", keys)
+	}
+}
+
+func TestUpdateValidatingKeys_AddsActivatedAndRemovesKeys(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	defer db.TeardownDB(t, validator.db)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	added := [48]byte{1, 2, 3}
+	validator.pubKeys = [][48]byte{validatorPubKey}
+	validator.pendingKeys = [][48]byte{added}
+	validator.keysChanged = true
+
+	resp := generateMockStatusResponse([][]byte{added[:]})
+	resp.Statuses[0].Status.Status = ethpb.ValidatorStatus_ACTIVE
+	clientStream := internal.NewMockBeaconNodeValidator_WaitForActivationClient(ctrl)
+	m.validatorClient.EXPECT().WaitForActivation(
+		gomock.Any(),
+		&ethpb.ValidatorActivationRequest{PublicKeys: [][]byte{added[:]}},
+	).Return(clientStream, nil)
+	clientStream.EXPECT().Recv().Return(resp, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := validator.UpdateValidatingKeys(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := validator.validatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 0 {
+		t.Errorf("Expected removed key to stop validating, got %v", keys)
+	}
+	attHistory, err := validator.db.AttestationHistory(ctx, added[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attHistory == nil {
+		t.Error("Expected attestation history of added key to be initialized")
+	}
+
+	// The added key joins the duties at the start of the epoch following its activation.
+	for i := 0; len(validator.waitingKeys([][48]byte{added})) > 0; i++ {
+		if i == 100 {
+			t.Fatal("Added key was not activated")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := validator.UpdateValidatingKeys(ctx, params.BeaconConfig().SlotsPerEpoch); err != nil {
+		t.Fatal(err)
+	}
+	keys, err = validator.validatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != added {
+		t.Errorf("Expected added key to start validating, got %v", keys)
+	}
+}
@@ -23,7 +23,7 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 		return nil
 	}
 
-	pks, err := v.keyManager.FetchValidatingKeys()
+	pks, err := v.validatingKeys()
 	if err != nil {
 		return err
 	}
@@ -5,14 +5,18 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-ssz"
-	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -59,7 +63,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 			return
 		}
 
-		if HasProposedForEpoch(history, epoch) {
+		if db.HasProposedForEpoch(history, epoch) {
 			log.WithField("epoch", epoch).Warn("Tried to sign a double proposal, rejected")
 			return
 		}
@@ -76,26 +80,28 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		Signature: sig,
 	}
 
-	// Propose and broadcast block via beacon node
-	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
-	if err != nil {
-		log.WithError(err).Error("Failed to propose block")
-		return
-	}
-
+	// Record the proposal before submitting it, as a beacon node may broadcast the block even
+	// if the request fails, in which case no other block must be signed for the epoch.
 	if featureconfig.Get().ProtectProposer {
 		history, err := v.db.ProposalHistory(ctx, pubKey[:])
 		if err != nil {
 			log.WithError(err).Error("Failed to get proposal history")
 			return
 		}
-		history = SetProposedForEpoch(history, epoch)
+		history = db.SetProposedForEpoch(history, epoch)
 		if err := v.db.SaveProposalHistory(ctx, pubKey[:], history); err != nil {
 			log.WithError(err).Error("Failed to save updated proposal history")
 			return
 		}
 	}
 
+	// Propose and broadcast block via beacon node
+	blkResp, err := v.validatorClient.ProposeBlock(ctx, blk)
+	if err != nil {
+		log.WithError(err).Error("Failed to propose block")
+		return
+	}
+
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
 		trace.Int64Attribute("numDeposits", int64(len(b.Body.Deposits))),
@@ -111,9 +117,52 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	}).Info("Submitted new block")
 }
 
-// ProposeExit --
-func (v *validator) ProposeExit(ctx context.Context, exit *ethpb.VoluntaryExit) error {
-	return errors.New("unimplemented")
+// ProposeExit signs a voluntary exit at the current epoch for the given key and submits it
+// to the beacon node.
+func (v *validator) ProposeExit(ctx context.Context, pubKey [48]byte) error {
+	ctx, span := trace.StartSpan(ctx, "validator.ProposeExit")
+	defer span.End()
+	genesisTime := v.chainGenesisTime()
+	if genesisTime == 0 {
+		return errors.New("beacon chain has not started")
+	}
+
+	indexResp, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		return errors.Wrap(err, "could not get validator index")
+	}
+	exit := &ethpb.VoluntaryExit{
+		Epoch:          slotutil.EpochsSinceGenesis(time.Unix(int64(genesisTime), 0)),
+		ValidatorIndex: indexResp.Index,
+	}
+	domain, err := v.validatorClient.DomainData(ctx, &ethpb.DomainRequest{
+		Epoch:  exit.Epoch,
+		Domain: params.BeaconConfig().DomainVoluntaryExit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not get domain data")
+	}
+	root, err := ssz.HashTreeRoot(exit)
+	if err != nil {
+		return errors.Wrap(err, "could not get signing root")
+	}
+	sig, err := v.keyManager.Sign(pubKey, root, domain.SignatureDomain)
+	if err != nil {
+		return errors.Wrap(err, "could not sign voluntary exit")
+	}
+	if _, err := v.validatorClient.ProposeExit(ctx, &ethpb.SignedVoluntaryExit{
+		Exit:      exit,
+		Signature: sig.Marshal(),
+	}); err != nil {
+		return errors.Wrap(err, "could not submit voluntary exit")
+	}
+
+	log.WithFields(logrus.Fields{
+		"pubKey":         fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		"validatorIndex": exit.ValidatorIndex,
+		"epoch":          exit.Epoch,
+	}).Info("Submitted voluntary exit")
+	return nil
 }
 
 // Sign randao reveal with randao domain and private key.
@@ -143,55 +192,31 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch uint64
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get domain data")
 	}
-	root, err := ssz.HashTreeRoot(b)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get signing root")
-	}
-	sig, err := v.keyManager.Sign(pubKey, root, domain.SignatureDomain)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get signing root")
-	}
-	return sig.Marshal(), nil
-}
-
-// HasProposedForEpoch returns whether a validators proposal history has been marked for the entered epoch.
-// If the request is more in the future than what the history contains, it will return false.
-// If the request is from the past, and likely previously pruned it will return false.
-func HasProposedForEpoch(history *slashpb.ProposalHistory, epoch uint64) bool {
-	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
-	// Previously pruned, we should return false.
-	if int(epoch) <= int(history.LatestEpochWritten)-int(wsPeriod) {
-		return false
-	}
-	// Accessing future proposals that haven't been marked yet. Needs to return false.
-	if epoch > history.LatestEpochWritten {
-		return false
-	}
-	return history.EpochBits.BitAt(epoch % wsPeriod)
-}
-
-// SetProposedForEpoch updates the proposal history to mark the indicated epoch in the bitlist
-// and updates the last epoch written if needed.
-// Returns the modified proposal history.
-func SetProposedForEpoch(history *slashpb.ProposalHistory, epoch uint64) *slashpb.ProposalHistory {
-	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
-
-	if epoch > history.LatestEpochWritten {
-		// If the history is empty, just update the latest written and mark the epoch.
-		// This is for the first run of a validator.
-		if history.EpochBits.Count() < 1 {
-			history.LatestEpochWritten = epoch
-			history.EpochBits.SetBitAt(epoch%wsPeriod, true)
-			return history
+	var sig *bls.Signature
+	if protectingKeymanager, supported := v.keyManager.(keymanager.ProtectingKeyManager); supported {
+		bodyRoot, err := ssz.HashTreeRoot(b.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get body signing root")
 		}
-		// If the epoch to mark is ahead of latest written epoch, override the old votes and mark the requested epoch.
-		// Limit the overwriting to one weak subjectivity period as further is not needed.
-		maxToWrite := history.LatestEpochWritten + wsPeriod
-		for i := history.LatestEpochWritten + 1; i < epoch && i <= maxToWrite; i++ {
-			history.EpochBits.SetBitAt(i%wsPeriod, false)
+		blockHeader := &ethpb.BeaconBlockHeader{
+			Slot:       b.Slot,
+			StateRoot:  b.StateRoot,
+			ParentRoot: b.ParentRoot,
+			BodyRoot:   bodyRoot[:],
+		}
+		sig, err = protectingKeymanager.SignProposal(pubKey, domain.SignatureDomain, blockHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not sign block proposal")
+		}
+	} else {
+		root, err := ssz.HashTreeRoot(b)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get signing root")
+		}
+		sig, err = v.keyManager.Sign(pubKey, root, domain.SignatureDomain)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get signing root")
 		}
-		history.LatestEpochWritten = epoch
 	}
-	history.EpochBits.SetBitAt(epoch%wsPeriod, true)
-	return history
+	return sig.Marshal(), nil
 }
@@ -1,14 +1,14 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/go-bitfield"
-	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -291,117 +291,38 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	}
 }
 
-func TestSetProposedForEpoch_SetsBit(t *testing.T) {
-	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
-	proposals := &slashpb.ProposalHistory{
-		EpochBits:          bitfield.NewBitlist(wsPeriod),
-		LatestEpochWritten: 0,
-	}
-	epoch := uint64(4)
-	proposals = SetProposedForEpoch(proposals, epoch)
-	proposed := HasProposedForEpoch(proposals, epoch)
-	if !proposed {
-		t.Fatal("Expected epoch 4 to be marked as proposed")
-	}
-	// Make sure no other bits are changed.
-	for i := uint64(1); i <= wsPeriod; i++ {
-		if i == epoch {
-			continue
-		}
-		if HasProposedForEpoch(proposals, i) {
-			t.Fatalf("Expected epoch %d to not be marked as proposed", i)
-		}
-	}
-}
-
-func TestSetProposedForEpoch_PrunesOverWSPeriod(t *testing.T) {
-	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
-	proposals := &slashpb.ProposalHistory{
-		EpochBits:          bitfield.NewBitlist(wsPeriod),
-		LatestEpochWritten: 0,
-	}
-	prunedEpoch := uint64(3)
-	proposals = SetProposedForEpoch(proposals, prunedEpoch)
-
-	if proposals.LatestEpochWritten != prunedEpoch {
-		t.Fatalf("Expected latest epoch written to be %d, received %d", prunedEpoch, proposals.LatestEpochWritten)
-	}
-
-	epoch := wsPeriod + 4
-	proposals = SetProposedForEpoch(proposals, epoch)
-	if !HasProposedForEpoch(proposals, epoch) {
-		t.Fatalf("Expected to be marked as proposed for epoch %d", epoch)
-	}
-	if proposals.LatestEpochWritten != epoch {
-		t.Fatalf("Expected latest written epoch to be %d, received %d", epoch, proposals.LatestEpochWritten)
-	}
-
-	if HasProposedForEpoch(proposals, epoch-wsPeriod+prunedEpoch) {
-		t.Fatalf("Expected the bit of pruned epoch %d to not be marked as proposed", epoch)
-	}
-	// Make sure no other bits are changed.
-	for i := epoch - wsPeriod + 1; i <= epoch; i++ {
-		if i == epoch {
-			continue
-		}
-		if HasProposedForEpoch(proposals, i) {
-			t.Fatalf("Expected epoch %d to not be marked as proposed", i)
-		}
-	}
-}
-
-func TestSetProposedForEpoch_KeepsHistory(t *testing.T) {
-	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
-	proposals := &slashpb.ProposalHistory{
-		EpochBits:          bitfield.NewBitlist(wsPeriod),
-		LatestEpochWritten: 0,
-	}
-	randomIndexes := []uint64{23, 423, 8900, 11347, 25033, 52225, 53999}
-	for i := 0; i < len(randomIndexes); i++ {
-		proposals = SetProposedForEpoch(proposals, randomIndexes[i])
-	}
-	if proposals.LatestEpochWritten != 53999 {
-		t.Fatalf("Expected latest epoch written to be %d, received %d", 53999, proposals.LatestEpochWritten)
-	}
+func TestSignBlock_UsesProtectingKeyManager(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+	km := &protectingKeyManager{KeyManager: testKeyManager}
+	validator.keyManager = km
 
-	// Make sure no other bits are changed.
-	for i := uint64(0); i < wsPeriod; i++ {
-		setIndex := false
-		for r := 0; r < len(randomIndexes); r++ {
-			if i == randomIndexes[r] {
-				setIndex = true
-				break
-			}
-		}
-
-		if setIndex != HasProposedForEpoch(proposals, i) {
-			t.Fatalf("Expected epoch %d to be marked as %t", i, setIndex)
-		}
-	}
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
 
-	// Set a past epoch as proposed, and make sure the recent data isn't changed.
-	proposals = SetProposedForEpoch(proposals, randomIndexes[1]+5)
-	if proposals.LatestEpochWritten != 53999 {
-		t.Fatalf("Expected last epoch written to not change after writing a past epoch, received %d", proposals.LatestEpochWritten)
+	blk := &ethpb.BeaconBlock{
+		Slot:       5,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		Body:       &ethpb.BeaconBlockBody{},
 	}
-	// Proposal just marked should be true.
-	if !HasProposedForEpoch(proposals, randomIndexes[1]+5) {
-		t.Fatal("Expected marked past epoch to be true, received false")
+	sig, err := validator.signBlock(context.Background(), validatorPubKey, 0, blk)
+	if err != nil {
+		t.Fatal(err)
 	}
-	// Previously marked proposal should stay true.
-	if !HasProposedForEpoch(proposals, randomIndexes[1]) {
-		t.Fatal("Expected marked past epoch to be true, received false")
+	if !km.signedProposal {
+		t.Error("Expected block to be signed through the protecting key manager")
 	}
-}
 
-func TestSetProposedForEpoch_PreventsProposingFutureEpochs(t *testing.T) {
-	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
-	proposals := &slashpb.ProposalHistory{
-		EpochBits:          bitfield.NewBitlist(wsPeriod),
-		LatestEpochWritten: 0,
+	// The header sent to the key manager must have the same signing root as the block.
+	root, err := ssz.HashTreeRoot(blk)
+	if err != nil {
+		t.Fatal(err)
 	}
-	proposals = SetProposedForEpoch(proposals, 200)
-	if HasProposedForEpoch(proposals, wsPeriod+200) {
-		t.Fatalf("Expected epoch %d to not be marked as proposed", wsPeriod+200)
+	wanted := validatorKey.SecretKey.Sign(root[:], 0).Marshal()
+	if !bytes.Equal(sig, wanted) {
+		t.Errorf("Incorrect signature; wanted %#x, received %#x", wanted, sig)
 	}
 }
@@ -563,6 +563,59 @@ func TestUpdateDuties_OK(t *testing.T) {
 	}
 }
 
+func TestUpdateDuties_SubscribesToSubnets(t *testing.T) {
+	v, m, finish := setup(t)
+	defer finish()
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	resp := &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot:   slot + 3,
+				ValidatorIndex: 200,
+				CommitteeIndex: 5,
+				Committee:      []uint64{0, 1, 2, 3},
+				PublicKey:      validatorPubKey[:],
+				Status:         ethpb.ValidatorStatus_ACTIVE,
+			},
+			{
+				AttesterSlot:   slot + 4,
+				ValidatorIndex: 201,
+				CommitteeIndex: 6,
+				PublicKey:      []byte("pending"),
+				Status:         ethpb.ValidatorStatus_DEPOSITED,
+			},
+		},
+	}
+	m.validatorClient.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{}, nil /*err*/)
+	m.aggregatorClient.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		&pb.CommitteeSubnetsSubscribeRequest{
+			Subscriptions: []*pb.CommitteeSubnetSubscription{
+				{
+					PublicKey:      validatorPubKey[:],
+					Slot:           slot + 3,
+					CommitteeIndex: 5,
+					// Committees smaller than the target number of aggregators are
+					// aggregated by all of their members.
+					IsAggregator: true,
+				},
+			},
+		},
+	).Return(&ptypes.Empty{}, nil)
+
+	if err := v.UpdateDuties(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+}
+
 func TestRolesAt_OK(t *testing.T) {
 	v, m, finish := setup(t)
 	defer finish()
@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
@@ -69,3 +70,68 @@ func (db *Store) DeleteAttestationHistory(ctx context.Context, pubkey []byte) er
 		return nil
 	})
 }
+
+// IsNewAttSlashable uses the attestation history to determine if an attestation of sourceEpoch
+// and targetEpoch would be slashable. It can detect double, surrounding, and surrounded votes.
+func IsNewAttSlashable(history *slashpb.AttestationHistory, sourceEpoch uint64, targetEpoch uint64) bool {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+
+	// Previously pruned, we should return false.
+	if int(targetEpoch) <= int(history.LatestEpochWritten)-int(wsPeriod) {
+		return false
+	}
+
+	// Check if there has already been a vote for this target epoch.
+	if safeTargetToSource(history, targetEpoch) != farFuture {
+		return true
+	}
+
+	// Check if the new attestation would be surrounding another attestation.
+	for i := sourceEpoch; i <= targetEpoch; i++ {
+		// Unattested for epochs are marked as FAR_FUTURE_EPOCH.
+		if safeTargetToSource(history, i) == farFuture {
+			continue
+		}
+		if history.TargetToSource[i%wsPeriod] > sourceEpoch {
+			return true
+		}
+	}
+
+	// Check if the new attestation is being surrounded.
+	for i := targetEpoch; i <= history.LatestEpochWritten; i++ {
+		if safeTargetToSource(history, i) < sourceEpoch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarkAttestationForTargetEpoch returns the modified attestation history with the passed-in epochs marked
+// as attested for. This is done to prevent the validator client from signing any slashable attestations.
+func MarkAttestationForTargetEpoch(history *slashpb.AttestationHistory, sourceEpoch uint64, targetEpoch uint64) *slashpb.AttestationHistory {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+
+	if targetEpoch > history.LatestEpochWritten {
+		// If the target epoch to mark is ahead of latest written epoch, override the old targets and mark the requested epoch.
+		// Limit the overwriting to one weak subjectivity period as further is not needed.
+		maxToWrite := history.LatestEpochWritten + wsPeriod
+		for i := history.LatestEpochWritten + 1; i < targetEpoch && i <= maxToWrite; i++ {
+			history.TargetToSource[i%wsPeriod] = params.BeaconConfig().FarFutureEpoch
+		}
+		history.LatestEpochWritten = targetEpoch
+	}
+	history.TargetToSource[targetEpoch%wsPeriod] = sourceEpoch
+	return history
+}
+
+// safeTargetToSource makes sure the epoch accessed is within bounds, and if it's not it at
+// returns the "default" FAR_FUTURE_EPOCH value.
+func safeTargetToSource(history *slashpb.AttestationHistory, targetEpoch uint64) uint64 {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if targetEpoch > history.LatestEpochWritten || int(targetEpoch) < int(history.LatestEpochWritten)-int(wsPeriod) {
+		return params.BeaconConfig().FarFutureEpoch
+	}
+	return history.TargetToSource[targetEpoch%wsPeriod]
+}
@@ -191,3 +191,133 @@ func TestDeleteAttestationHistory_OK(t *testing.T) {
 		t.Fatalf("Expected attestation history to be nil, received %v", savedHistory)
 	}
 }
+
+func TestAttestationHistory_BlocksDoubleAttestation(t *testing.T) {
+	newMap := make(map[uint64]uint64)
+	newMap[0] = params.BeaconConfig().FarFutureEpoch
+	attestations := &slashpb.AttestationHistory{
+		TargetToSource:     newMap,
+		LatestEpochWritten: 0,
+	}
+
+	// Mark an attestation spanning epochs 0 to 3.
+	newAttSource := uint64(0)
+	newAttTarget := uint64(3)
+	attestations = MarkAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
+	if attestations.LatestEpochWritten != newAttTarget {
+		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
+	}
+
+	// Try an attestation that should be slashable (double att) spanning epochs 1 to 3.
+	newAttSource = uint64(1)
+	newAttTarget = uint64(3)
+	if !IsNewAttSlashable(attestations, newAttSource, newAttTarget) {
+		t.Fatalf("Expected attestation of source %d and target %d to be considered slashable", newAttSource, newAttTarget)
+	}
+}
+
+func TestAttestationHistory_Prunes(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	newMap := make(map[uint64]uint64)
+	newMap[0] = params.BeaconConfig().FarFutureEpoch
+	attestations := &slashpb.AttestationHistory{
+		TargetToSource:     newMap,
+		LatestEpochWritten: 0,
+	}
+
+	// Try an attestation on totally unmarked history, should not be slashable.
+	if IsNewAttSlashable(attestations, 0, wsPeriod+5) {
+		t.Fatalf("Expected attestation of source 0, target %d to be considered slashable", wsPeriod+5)
+	}
+
+	// Mark attestations spanning epochs 0 to 3 and 6 to 9.
+	prunedNewAttSource := uint64(0)
+	prunedNewAttTarget := uint64(3)
+	attestations = MarkAttestationForTargetEpoch(attestations, prunedNewAttSource, prunedNewAttTarget)
+	newAttSource := prunedNewAttSource + 6
+	newAttTarget := prunedNewAttTarget + 6
+	attestations = MarkAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
+	if attestations.LatestEpochWritten != newAttTarget {
+		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
+	}
+
+	// Mark an attestation spanning epochs 54000 to 54003.
+	farNewAttSource := newAttSource + wsPeriod
+	farNewAttTarget := newAttTarget + wsPeriod
+	attestations = MarkAttestationForTargetEpoch(attestations, farNewAttSource, farNewAttTarget)
+	if attestations.LatestEpochWritten != farNewAttTarget {
+		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
+	}
+
+	if safeTargetToSource(attestations, prunedNewAttTarget) != params.BeaconConfig().FarFutureEpoch {
+		t.Fatalf("Expected attestation at target epoch %d to not be marked", prunedNewAttTarget)
+	}
+
+	if safeTargetToSource(attestations, farNewAttTarget) != farNewAttSource {
+		t.Fatalf("Expected attestation at target epoch %d to not be marked", farNewAttSource)
+	}
+
+	// Try an attestation from existing source to outside prune, should slash.
+	if !IsNewAttSlashable(attestations, newAttSource, farNewAttTarget) {
+		t.Fatalf("Expected attestation of source %d, target %d to be considered slashable", newAttSource, farNewAttTarget)
+	}
+	// Try an attestation from before existing target to outside prune, should slash.
+	if !IsNewAttSlashable(attestations, newAttTarget-1, farNewAttTarget) {
+		t.Fatalf("Expected attestation of source %d, target %d to be considered slashable", newAttTarget-1, farNewAttTarget)
+	}
+	// Try an attestation larger than pruning amount, should slash.
+	if !IsNewAttSlashable(attestations, 0, farNewAttTarget+5) {
+		t.Fatalf("Expected attestation of source 0, target %d to be considered slashable", farNewAttTarget+5)
+	}
+}
+
+func TestAttestationHistory_BlocksSurroundedAttestation(t *testing.T) {
+	newMap := make(map[uint64]uint64)
+	newMap[0] = params.BeaconConfig().FarFutureEpoch
+	attestations := &slashpb.AttestationHistory{
+		TargetToSource:     newMap,
+		LatestEpochWritten: 0,
+	}
+
+	// Mark an attestation spanning epochs 0 to 3.
+	newAttSource := uint64(0)
+	newAttTarget := uint64(3)
+	attestations = MarkAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
+	if attestations.LatestEpochWritten != newAttTarget {
+		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
+	}
+
+	// Try an attestation that should be slashable (being surrounded) spanning epochs 1 to 2.
+	newAttSource = uint64(1)
+	newAttTarget = uint64(2)
+	if !IsNewAttSlashable(attestations, newAttSource, newAttTarget) {
+		t.Fatalf("Expected attestation of source %d and target %d to be considered slashable", newAttSource, newAttTarget)
+	}
+}
+
+func TestAttestationHistory_BlocksSurroundingAttestation(t *testing.T) {
+	newMap := make(map[uint64]uint64)
+	newMap[0] = params.BeaconConfig().FarFutureEpoch
+	attestations := &slashpb.AttestationHistory{
+		TargetToSource:     newMap,
+		LatestEpochWritten: 0,
+	}
+
+	// Mark an attestation spanning epochs 1 to 2.
+	newAttSource := uint64(1)
+	newAttTarget := uint64(2)
+	attestations = MarkAttestationForTargetEpoch(attestations, newAttSource, newAttTarget)
+	if attestations.LatestEpochWritten != newAttTarget {
+		t.Fatalf("Expected latest epoch written to be %d, received %d", newAttTarget, attestations.LatestEpochWritten)
+	}
+	if attestations.TargetToSource[newAttTarget] != newAttSource {
+		t.Fatalf("Expected source epoch to be %d, received %d", newAttSource, attestations.TargetToSource[newAttTarget])
+	}
+
+	// Try an attestation that should be slashable (surrounding) spanning epochs 0 to 3.
+	newAttSource = uint64(0)
+	newAttTarget = uint64(3)
+	if !IsNewAttSlashable(attestations, newAttSource, newAttTarget) {
+		t.Fatalf("Expected attestation of source %d and target %d to be considered slashable", newAttSource, newAttTarget)
+	}
+}
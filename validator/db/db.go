@@ -94,23 +94,34 @@ func NewKVStore(dirPath string, pubkeys [][48]byte) (*Store, error) {
 	}
 
 	// Initialize the required pubkeys into the DB to ensure they're not empty.
+	if err := kv.InitializeHistory(context.Background(), pubkeys); err != nil {
+		return nil, err
+	}
+
+	return kv, err
+}
+
+// InitializeHistory stores a clean proposal and attestation history for the given
+// pubkeys which do not have one yet, so that keys added while the validator client
+// runs are protected like the keys it started with.
+func (db *Store) InitializeHistory(ctx context.Context, pubkeys [][48]byte) error {
 	for _, pubkey := range pubkeys {
-		proHistory, err := kv.ProposalHistory(context.Background(), pubkey[:])
+		proHistory, err := db.ProposalHistory(ctx, pubkey[:])
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if proHistory == nil {
 			cleanHistory := &slashpb.ProposalHistory{
 				EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
 			}
-			if err := kv.SaveProposalHistory(context.Background(), pubkey[:], cleanHistory); err != nil {
-				return nil, err
+			if err := db.SaveProposalHistory(ctx, pubkey[:], cleanHistory); err != nil {
+				return err
 			}
 		}
 
-		attHistory, err := kv.AttestationHistory(context.Background(), pubkey[:])
+		attHistory, err := db.AttestationHistory(ctx, pubkey[:])
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if attHistory == nil {
 			newMap := make(map[uint64]uint64)
@@ -118,14 +129,12 @@ func NewKVStore(dirPath string, pubkeys [][48]byte) (*Store, error) {
 			cleanHistory := &slashpb.AttestationHistory{
 				TargetToSource: newMap,
 			}
-			if err := kv.SaveAttestationHistory(context.Background(), pubkey[:], cleanHistory); err != nil {
-				return nil, err
+			if err := db.SaveAttestationHistory(ctx, pubkey[:], cleanHistory); err != nil {
+				return err
 			}
 		}
-
 	}
-
-	return kv, err
+	return nil
 }
 
 // Size returns the db size in bytes.
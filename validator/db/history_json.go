@@ -0,0 +1,295 @@
+package db
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// HistoryFormatVersion is the version of the JSON slashing protection history format
+// written by ExportHistory. ImportHistory refuses files of any other version.
+const HistoryFormatVersion = 1
+
+// ProtectionHistory is the portable JSON representation of the slashing protection
+// history of a set of validators. Epochs are recorded explicitly rather than in the
+// rolling layout used by the database, so files do not depend on the weak subjectivity
+// period of the exporting client.
+type ProtectionHistory struct {
+	Version    uint64                        `json:"version"`
+	Validators []*ValidatorProtectionHistory `json:"validators"`
+}
+
+// ValidatorProtectionHistory holds the block proposals and attestations made by a single validator.
+type ValidatorProtectionHistory struct {
+	PublicKey      string               `json:"pubkey"`
+	ProposedEpochs []uint64             `json:"proposed_epochs"`
+	Attestations   []*AttestationRecord `json:"attestations"`
+}
+
+// AttestationRecord is the source and target epoch of an attestation made by a validator.
+type AttestationRecord struct {
+	SourceEpoch uint64 `json:"source_epoch"`
+	TargetEpoch uint64 `json:"target_epoch"`
+}
+
+// ExportHistory writes the proposal and attestation history of every validator in the
+// database to w as JSON.
+func (db *Store) ExportHistory(ctx context.Context, w io.Writer) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.ExportHistory")
+	defer span.End()
+
+	pubKeys, err := db.historyPublicKeys()
+	if err != nil {
+		return err
+	}
+	history := &ProtectionHistory{
+		Version:    HistoryFormatVersion,
+		Validators: make([]*ValidatorProtectionHistory, 0, len(pubKeys)),
+	}
+	for _, pubKey := range pubKeys {
+		validatorHistory, err := db.ValidatorHistory(ctx, pubKey)
+		if err != nil {
+			return err
+		}
+		history.Validators = append(history.Validators, validatorHistory)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(history)
+}
+
+// ValidatorHistory returns the proposal and attestation history of a single validator,
+// in the representation used by ExportHistory.
+func (db *Store) ValidatorHistory(ctx context.Context, pubKey []byte) (*ValidatorProtectionHistory, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ValidatorHistory")
+	defer span.End()
+
+	var proposals map[uint64]bool
+	var attestations map[uint64]uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		var err error
+		proposals, attestations, err = validatorHistory(tx, pubKey)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ValidatorProtectionHistory{
+		PublicKey:      fmt.Sprintf("%#x", pubKey),
+		ProposedEpochs: sortedEpochs(proposals),
+		Attestations:   sortedAttestations(attestations),
+	}, nil
+}
+
+// ImportHistory reads JSON proposal and attestation history from r and merges it in to
+// the database. Where both the database and the imported history hold an attestation
+// for the same target epoch the higher source epoch is kept, and the latest epoch
+// written for each validator becomes the highest of the two.
+func (db *Store) ImportHistory(ctx context.Context, r io.Reader) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.ImportHistory")
+	defer span.End()
+
+	history := &ProtectionHistory{}
+	if err := json.NewDecoder(r).Decode(history); err != nil {
+		return errors.Wrap(err, "failed to decode slashing protection history")
+	}
+	if history.Version != HistoryFormatVersion {
+		return fmt.Errorf("unsupported slashing protection history version %d, expected %d", history.Version, HistoryFormatVersion)
+	}
+
+	// Validate the whole file before writing anything, so a bad entry cannot leave a partial import.
+	pubKeys := make([][]byte, len(history.Validators))
+	for i, validator := range history.Validators {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(validator.PublicKey, "0x"))
+		if err != nil {
+			return errors.Wrapf(err, "invalid public key %q", validator.PublicKey)
+		}
+		if len(pubKey) != 48 {
+			return fmt.Errorf("invalid public key %q: expected 48 bytes, received %d", validator.PublicKey, len(pubKey))
+		}
+		for _, att := range validator.Attestations {
+			if att.SourceEpoch > att.TargetEpoch {
+				return fmt.Errorf("invalid attestation for %s: source epoch %d is after target epoch %d", validator.PublicKey, att.SourceEpoch, att.TargetEpoch)
+			}
+		}
+		pubKeys[i] = pubKey
+	}
+
+	// All validators are written in a single transaction, so the import is applied entirely or not at all.
+	return db.update(func(tx *bolt.Tx) error {
+		for i, validator := range history.Validators {
+			proposals, attestations, err := validatorHistory(tx, pubKeys[i])
+			if err != nil {
+				return err
+			}
+			for _, epoch := range validator.ProposedEpochs {
+				proposals[epoch] = true
+			}
+			for _, att := range validator.Attestations {
+				if source, ok := attestations[att.TargetEpoch]; !ok || att.SourceEpoch > source {
+					attestations[att.TargetEpoch] = att.SourceEpoch
+				}
+			}
+			enc, err := proto.Marshal(buildProposalHistory(proposals))
+			if err != nil {
+				return errors.Wrap(err, "failed to encode proposal history")
+			}
+			if err := tx.Bucket(historicProposalsBucket).Put(pubKeys[i], enc); err != nil {
+				return err
+			}
+			enc, err = proto.Marshal(buildAttestationHistory(attestations))
+			if err != nil {
+				return errors.Wrap(err, "failed to encode attestation history")
+			}
+			if err := tx.Bucket(historicAttestationsBucket).Put(pubKeys[i], enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// historyPublicKeys returns the public keys of all validators with a proposal or attestation history.
+func (db *Store) historyPublicKeys() ([][]byte, error) {
+	seen := make(map[string]bool)
+	var pubKeys [][]byte
+	err := db.view(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{historicProposalsBucket, historicAttestationsBucket} {
+			if err := tx.Bucket(bucket).ForEach(func(k, _ []byte) error {
+				if !seen[string(k)] {
+					seen[string(k)] = true
+					pubKeys = append(pubKeys, append([]byte{}, k...))
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return pubKeys, err
+}
+
+// validatorHistory returns the epochs in which the validator proposed blocks, and a map
+// of target to source epochs of its attestations, as held in the database.
+func validatorHistory(tx *bolt.Tx, pubKey []byte) (map[uint64]bool, map[uint64]uint64, error) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	farFuture := params.BeaconConfig().FarFutureEpoch
+
+	proposals := make(map[uint64]bool)
+	if enc := tx.Bucket(historicProposalsBucket).Get(pubKey); enc != nil {
+		proposalHistory, err := unmarshalProposalHistory(enc)
+		if err != nil {
+			return nil, nil, err
+		}
+		if proposalHistory.EpochBits.Len() == wsPeriod {
+			for epoch := historyWindowStart(proposalHistory.LatestEpochWritten); epoch <= proposalHistory.LatestEpochWritten; epoch++ {
+				if proposalHistory.EpochBits.BitAt(epoch % wsPeriod) {
+					proposals[epoch] = true
+				}
+			}
+		}
+	}
+
+	attestations := make(map[uint64]uint64)
+	if enc := tx.Bucket(historicAttestationsBucket).Get(pubKey); enc != nil {
+		attestationHistory, err := unmarshalAttestationHistory(enc)
+		if err != nil {
+			return nil, nil, err
+		}
+		for epoch := historyWindowStart(attestationHistory.LatestEpochWritten); epoch <= attestationHistory.LatestEpochWritten; epoch++ {
+			source, ok := attestationHistory.TargetToSource[epoch%wsPeriod]
+			if ok && source != farFuture {
+				attestations[epoch] = source
+			}
+		}
+	}
+	return proposals, attestations, nil
+}
+
+// buildProposalHistory creates a proposal history marking the given epochs. Epochs older
+// than one weak subjectivity period before the latest epoch are pruned.
+func buildProposalHistory(proposals map[uint64]bool) *slashpb.ProposalHistory {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	history := &slashpb.ProposalHistory{
+		EpochBits: bitfield.NewBitlist(wsPeriod),
+	}
+	for epoch := range proposals {
+		if epoch > history.LatestEpochWritten {
+			history.LatestEpochWritten = epoch
+		}
+	}
+	windowStart := historyWindowStart(history.LatestEpochWritten)
+	for epoch := range proposals {
+		if epoch >= windowStart {
+			history.EpochBits.SetBitAt(epoch%wsPeriod, true)
+		}
+	}
+	return history
+}
+
+// buildAttestationHistory creates an attestation history from a map of target to source
+// epochs. Targets older than one weak subjectivity period before the latest target are pruned.
+func buildAttestationHistory(attestations map[uint64]uint64) *slashpb.AttestationHistory {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	history := &slashpb.AttestationHistory{
+		TargetToSource: make(map[uint64]uint64),
+	}
+	for target := range attestations {
+		if target > history.LatestEpochWritten {
+			history.LatestEpochWritten = target
+		}
+	}
+	// Unattested epochs within the window are marked as FAR_FUTURE_EPOCH.
+	windowStart := historyWindowStart(history.LatestEpochWritten)
+	for epoch := windowStart; epoch <= history.LatestEpochWritten; epoch++ {
+		history.TargetToSource[epoch%wsPeriod] = params.BeaconConfig().FarFutureEpoch
+	}
+	for target, source := range attestations {
+		if target >= windowStart {
+			history.TargetToSource[target%wsPeriod] = source
+		}
+	}
+	return history
+}
+
+// historyWindowStart returns the first epoch which is still held by a history whose latest
+// written epoch is the one given.
+func historyWindowStart(latestEpoch uint64) uint64 {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if latestEpoch < wsPeriod {
+		return 0
+	}
+	return latestEpoch - wsPeriod + 1
+}
+
+func sortedEpochs(epochs map[uint64]bool) []uint64 {
+	res := make([]uint64, 0, len(epochs))
+	for epoch := range epochs {
+		res = append(res, epoch)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+func sortedAttestations(attestations map[uint64]uint64) []*AttestationRecord {
+	res := make([]*AttestationRecord, 0, len(attestations))
+	for target, source := range attestations {
+		res = append(res, &AttestationRecord{SourceEpoch: source, TargetEpoch: target})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].TargetEpoch < res[j].TargetEpoch })
+	return res
+}
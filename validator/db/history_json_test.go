@@ -0,0 +1,149 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestExportImportHistory_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	source := SetupDB(t, [][48]byte{pubKey})
+	defer TeardownDB(t, source)
+
+	proposals := map[uint64]bool{3: true, 7: true}
+	attestations := map[uint64]uint64{2: 1, 3: 2, 6: 3}
+	if err := source.SaveProposalHistory(ctx, pubKey[:], buildProposalHistory(proposals)); err != nil {
+		t.Fatal(err)
+	}
+	if err := source.SaveAttestationHistory(ctx, pubKey[:], buildAttestationHistory(attestations)); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := source.ExportHistory(ctx, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	target := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, target)
+	if err := target.ImportHistory(ctx, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var receivedProposals map[uint64]bool
+	var receivedAttestations map[uint64]uint64
+	if err := target.view(func(tx *bolt.Tx) error {
+		var err error
+		receivedProposals, receivedAttestations, err = validatorHistory(tx, pubKey[:])
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(receivedProposals, proposals) {
+		t.Errorf("Wanted proposals %v, received %v", proposals, receivedProposals)
+	}
+	if !reflect.DeepEqual(receivedAttestations, attestations) {
+		t.Errorf("Wanted attestations %v, received %v", attestations, receivedAttestations)
+	}
+}
+
+func TestImportHistory_MergesHighestEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := SetupDB(t, [][48]byte{pubKey})
+	defer TeardownDB(t, db)
+
+	if err := db.SaveProposalHistory(ctx, pubKey[:], buildProposalHistory(map[uint64]bool{4: true})); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveAttestationHistory(ctx, pubKey[:], buildAttestationHistory(map[uint64]uint64{4: 3, 5: 1})); err != nil {
+		t.Fatal(err)
+	}
+
+	input := fmt.Sprintf(`{
+  "version": 1,
+  "validators": [{
+    "pubkey": "%#x",
+    "proposed_epochs": [2, 9],
+    "attestations": [{"source_epoch": 2, "target_epoch": 4}, {"source_epoch": 4, "target_epoch": 5}, {"source_epoch": 8, "target_epoch": 9}]
+  }]
+}`, pubKey)
+	if err := db.ImportHistory(ctx, strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := db.ValidatorHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedProposals := []uint64{2, 4, 9}
+	if !reflect.DeepEqual(imported.ProposedEpochs, wantedProposals) {
+		t.Errorf("Wanted proposals %v, received %v", wantedProposals, imported.ProposedEpochs)
+	}
+	wantedAttestations := []*AttestationRecord{
+		{SourceEpoch: 3, TargetEpoch: 4},
+		{SourceEpoch: 4, TargetEpoch: 5},
+		{SourceEpoch: 8, TargetEpoch: 9},
+	}
+	if !reflect.DeepEqual(imported.Attestations, wantedAttestations) {
+		t.Errorf("Wanted attestations %v, received %v", wantedAttestations, imported.Attestations)
+	}
+
+	history, err := db.AttestationHistory(ctx, pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history.LatestEpochWritten != 9 {
+		t.Errorf("Wanted latest epoch written 9, received %d", history.LatestEpochWritten)
+	}
+	if history.TargetToSource[7] != params.BeaconConfig().FarFutureEpoch {
+		t.Errorf("Expected unattested epoch to be marked as far future, received %d", history.TargetToSource[7])
+	}
+}
+
+func TestImportHistory_BadInput(t *testing.T) {
+	pubKey := fmt.Sprintf("%#x", [48]byte{1})
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "UnknownVersion",
+			input: `{"version":2,"validators":[]}`,
+			err:   "unsupported slashing protection history version 2",
+		},
+		{
+			name:  "ShortPublicKey",
+			input: `{"version":1,"validators":[{"pubkey":"0x0102"}]}`,
+			err:   "expected 48 bytes, received 2",
+		},
+		{
+			name:  "SourceAfterTarget",
+			input: `{"version":1,"validators":[{"pubkey":"` + pubKey + `","attestations":[{"source_epoch":3,"target_epoch":2}]}]}`,
+			err:   "source epoch 3 is after target epoch 2",
+		},
+	}
+
+	db := SetupDB(t, [][48]byte{})
+	defer TeardownDB(t, db)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := db.ImportHistory(context.Background(), strings.NewReader(test.input))
+			if err == nil {
+				t.Fatalf("Missing expected error: %v", test.err)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("Unexpected error value: expected %v, received %v", test.err, err)
+			}
+		})
+	}
+}
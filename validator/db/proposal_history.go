@@ -7,6 +7,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
@@ -69,3 +70,45 @@ func (db *Store) DeleteProposalHistory(ctx context.Context, pubkey []byte) error
 		return nil
 	})
 }
+
+// HasProposedForEpoch returns whether a validators proposal history has been marked for the entered epoch.
+// If the request is more in the future than what the history contains, it will return false.
+// If the request is from the past, and likely previously pruned it will return false.
+func HasProposedForEpoch(history *slashpb.ProposalHistory, epoch uint64) bool {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	// Previously pruned, we should return false.
+	if int(epoch) <= int(history.LatestEpochWritten)-int(wsPeriod) {
+		return false
+	}
+	// Accessing future proposals that haven't been marked yet. Needs to return false.
+	if epoch > history.LatestEpochWritten {
+		return false
+	}
+	return history.EpochBits.BitAt(epoch % wsPeriod)
+}
+
+// SetProposedForEpoch updates the proposal history to mark the indicated epoch in the bitlist
+// and updates the last epoch written if needed.
+// Returns the modified proposal history.
+func SetProposedForEpoch(history *slashpb.ProposalHistory, epoch uint64) *slashpb.ProposalHistory {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+
+	if epoch > history.LatestEpochWritten {
+		// If the history is empty, just update the latest written and mark the epoch.
+		// This is for the first run of a validator.
+		if history.EpochBits.Count() < 1 {
+			history.LatestEpochWritten = epoch
+			history.EpochBits.SetBitAt(epoch%wsPeriod, true)
+			return history
+		}
+		// If the epoch to mark is ahead of latest written epoch, override the old votes and mark the requested epoch.
+		// Limit the overwriting to one weak subjectivity period as further is not needed.
+		maxToWrite := history.LatestEpochWritten + wsPeriod
+		for i := history.LatestEpochWritten + 1; i < epoch && i <= maxToWrite; i++ {
+			history.EpochBits.SetBitAt(i%wsPeriod, false)
+		}
+		history.LatestEpochWritten = epoch
+	}
+	history.EpochBits.SetBitAt(epoch%wsPeriod, true)
+	return history
+}
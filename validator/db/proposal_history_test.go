@@ -30,6 +30,42 @@ func TestProposalHistory_InitializesNewPubKeys(t *testing.T) {
 	}
 }
 
+func TestProposalHistory_InitializeHistoryKeepsExisting(t *testing.T) {
+	existing, added := [48]byte{30}, [48]byte{25}
+	db := SetupDB(t, [][48]byte{existing})
+	defer TeardownDB(t, db)
+
+	history := &slashpb.ProposalHistory{
+		EpochBits:          bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+		LatestEpochWritten: 2,
+	}
+	history.EpochBits.SetBitAt(2, true)
+	if err := db.SaveProposalHistory(context.Background(), existing[:], history); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.InitializeHistory(context.Background(), [][48]byte{existing, added}); err != nil {
+		t.Fatal(err)
+	}
+	savedHistory, err := db.ProposalHistory(context.Background(), existing[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(savedHistory, history) {
+		t.Fatalf("Expected existing proposal history to be kept, received %v", savedHistory)
+	}
+	addedHistory, err := db.ProposalHistory(context.Background(), added[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	clean := &slashpb.ProposalHistory{
+		EpochBits: bitfield.NewBitlist(params.BeaconConfig().WeakSubjectivityPeriod),
+	}
+	if !reflect.DeepEqual(addedHistory, clean) {
+		t.Fatalf("Expected proposal history of added key to be empty, received %v", addedHistory)
+	}
+}
+
 func TestProposalHistory_NilDB(t *testing.T) {
 	db := SetupDB(t, [][48]byte{})
 	defer TeardownDB(t, db)
@@ -171,3 +207,118 @@ func TestDeleteProposalHistory_OK(t *testing.T) {
 		t.Fatalf("Expected proposal history to be nil, received %v", savedHistory)
 	}
 }
+
+func TestSetProposedForEpoch_SetsBit(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	proposals := &slashpb.ProposalHistory{
+		EpochBits:          bitfield.NewBitlist(wsPeriod),
+		LatestEpochWritten: 0,
+	}
+	epoch := uint64(4)
+	proposals = SetProposedForEpoch(proposals, epoch)
+	proposed := HasProposedForEpoch(proposals, epoch)
+	if !proposed {
+		t.Fatal("Expected epoch 4 to be marked as proposed")
+	}
+	// Make sure no other bits are changed.
+	for i := uint64(1); i <= wsPeriod; i++ {
+		if i == epoch {
+			continue
+		}
+		if HasProposedForEpoch(proposals, i) {
+			t.Fatalf("Expected epoch %d to not be marked as proposed", i)
+		}
+	}
+}
+
+func TestSetProposedForEpoch_PrunesOverWSPeriod(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	proposals := &slashpb.ProposalHistory{
+		EpochBits:          bitfield.NewBitlist(wsPeriod),
+		LatestEpochWritten: 0,
+	}
+	prunedEpoch := uint64(3)
+	proposals = SetProposedForEpoch(proposals, prunedEpoch)
+
+	if proposals.LatestEpochWritten != prunedEpoch {
+		t.Fatalf("Expected latest epoch written to be %d, received %d", prunedEpoch, proposals.LatestEpochWritten)
+	}
+
+	epoch := wsPeriod + 4
+	proposals = SetProposedForEpoch(proposals, epoch)
+	if !HasProposedForEpoch(proposals, epoch) {
+		t.Fatalf("Expected to be marked as proposed for epoch %d", epoch)
+	}
+	if proposals.LatestEpochWritten != epoch {
+		t.Fatalf("Expected latest written epoch to be %d, received %d", epoch, proposals.LatestEpochWritten)
+	}
+
+	if HasProposedForEpoch(proposals, epoch-wsPeriod+prunedEpoch) {
+		t.Fatalf("Expected the bit of pruned epoch %d to not be marked as proposed", epoch)
+	}
+	// Make sure no other bits are changed.
+	for i := epoch - wsPeriod + 1; i <= epoch; i++ {
+		if i == epoch {
+			continue
+		}
+		if HasProposedForEpoch(proposals, i) {
+			t.Fatalf("Expected epoch %d to not be marked as proposed", i)
+		}
+	}
+}
+
+func TestSetProposedForEpoch_KeepsHistory(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	proposals := &slashpb.ProposalHistory{
+		EpochBits:          bitfield.NewBitlist(wsPeriod),
+		LatestEpochWritten: 0,
+	}
+	randomIndexes := []uint64{23, 423, 8900, 11347, 25033, 52225, 53999}
+	for i := 0; i < len(randomIndexes); i++ {
+		proposals = SetProposedForEpoch(proposals, randomIndexes[i])
+	}
+	if proposals.LatestEpochWritten != 53999 {
+		t.Fatalf("Expected latest epoch written to be %d, received %d", 53999, proposals.LatestEpochWritten)
+	}
+
+	// Make sure no other bits are changed.
+	for i := uint64(0); i < wsPeriod; i++ {
+		setIndex := false
+		for r := 0; r < len(randomIndexes); r++ {
+			if i == randomIndexes[r] {
+				setIndex = true
+				break
+			}
+		}
+
+		if setIndex != HasProposedForEpoch(proposals, i) {
+			t.Fatalf("Expected epoch %d to be marked as %t", i, setIndex)
+		}
+	}
+
+	// Set a past epoch as proposed, and make sure the recent data isn't changed.
+	proposals = SetProposedForEpoch(proposals, randomIndexes[1]+5)
+	if proposals.LatestEpochWritten != 53999 {
+		t.Fatalf("Expected last epoch written to not change after writing a past epoch, received %d", proposals.LatestEpochWritten)
+	}
+	// Proposal just marked should be true.
+	if !HasProposedForEpoch(proposals, randomIndexes[1]+5) {
+		t.Fatal("Expected marked past epoch to be true, received false")
+	}
+	// Previously marked proposal should stay true.
+	if !HasProposedForEpoch(proposals, randomIndexes[1]) {
+		t.Fatal("Expected marked past epoch to be true, received false")
+	}
+}
+
+func TestSetProposedForEpoch_PreventsProposingFutureEpochs(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	proposals := &slashpb.ProposalHistory{
+		EpochBits:          bitfield.NewBitlist(wsPeriod),
+		LatestEpochWritten: 0,
+	}
+	proposals = SetProposedForEpoch(proposals, 200)
+	if HasProposedForEpoch(proposals, wsPeriod+200) {
+		t.Fatalf("Expected epoch %d to not be marked as proposed", wsPeriod+200)
+	}
+}
@@ -11,10 +11,10 @@ var (
 		Name:  "no-custom-config",
 		Usage: "Run the beacon chain with the real parameters from phase 0.",
 	}
-	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
+	// BeaconRPCProviderFlag defines the beacon node RPC endpoints.
 	BeaconRPCProviderFlag = cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Comma separated beacon node RPC provider endpoints, the validator fails over between them",
 		Value: "localhost:4000",
 	}
 	// CertFlag defines a flag for the node's TLS certificate.
@@ -28,6 +28,18 @@ var (
 		Usage: "Path to the desired keystore directory",
 		Value: cmd.DirectoryString{Value: ""},
 	}
+	// EIP2335PathFlag defines the location of the directory of EIP-2335 keystores to import keys to.
+	EIP2335PathFlag = cmd.DirectoryFlag{
+		Name:  "eip2335-path",
+		Usage: "Path to the directory of EIP-2335 keystores",
+		Value: cmd.DirectoryString{Value: ""},
+	}
+	// NumAccountsFlag defines the number of validator accounts to derive from a new mnemonic.
+	NumAccountsFlag = cli.Uint64Flag{
+		Name:  "num-accounts",
+		Usage: "Number of validator accounts to derive from the mnemonic",
+		Value: 1,
+	}
 	// UnencryptedKeysFlag specifies a file path of a JSON file of unencrypted validator keys as an
 	// alternative from launching the validator client from decrypting a keystore directory.
 	UnencryptedKeysFlag = cli.StringFlag{
@@ -38,7 +50,7 @@ var (
 	// KeyManager specifies the key manager to use.
 	KeyManager = cli.StringFlag{
 		Name:  "keymanager",
-		Usage: "The keymanger to use (unencrypted, interop, keystore, wallet)",
+		Usage: "The keymanger to use (unencrypted, interop, keystore, eip2335, mnemonic, wallet, remote)",
 		Value: "",
 	}
 	// KeyManagerOpts specifies the key manager options.
@@ -62,6 +74,34 @@ var (
 		Name:  "graffiti",
 		Usage: "String to include in proposed blocks",
 	}
+	// SlashingProtectionFileFlag defines the path of a JSON file to import slashing protection history from or export it to.
+	SlashingProtectionFileFlag = cli.StringFlag{
+		Name:  "slashing-protection-file",
+		Usage: "Path to a JSON file of validator slashing protection history",
+	}
+	// ManagementAPIFlag enables the management API of the validator client.
+	ManagementAPIFlag = cli.BoolFlag{
+		Name:  "management-api",
+		Usage: "Serve the management API, authenticated with the token in the management-token file of the data directory",
+	}
+	// ManagementHostFlag defines the host the management API listens on.
+	ManagementHostFlag = cli.StringFlag{
+		Name:  "management-host",
+		Usage: "Host on which the management API listens",
+		Value: "127.0.0.1",
+	}
+	// ManagementPortFlag defines the port of the gRPC management API.
+	ManagementPortFlag = cli.IntFlag{
+		Name:  "management-port",
+		Usage: "Port on which the gRPC management API listens",
+		Value: 7500,
+	}
+	// ManagementGatewayPortFlag defines the port of the JSON gateway to the management API.
+	ManagementGatewayPortFlag = cli.IntFlag{
+		Name:  "management-gateway-port",
+		Usage: "Port on which the JSON gateway to the management API listens, 0 to disable it",
+		Value: 7501,
+	}
 	// GrpcMaxCallRecvMsgSizeFlag defines the max call message size for GRPC
 	GrpcMaxCallRecvMsgSizeFlag = cli.IntFlag{
 		Name:  "grpc-max-msg-size",
@@ -1,16 +1,22 @@
 package keymanager
 
 import (
+	"context"
+	"sync"
+
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 )
 
 // Direct is a key manager that holds all secret keys directly.
 type Direct struct {
+	lock sync.RWMutex
 	// Key to the map is the bytes of the public key.
 	publicKeys map[[48]byte]*bls.PublicKey
 	// Key to the map is the bytes of the public key.
 	secretKeys map[[48]byte]*bls.SecretKey
+	keysFeed   event.Feed
 }
 
 // NewDirect creates a new direct key manager from the secret keys provided to it.
@@ -30,6 +36,8 @@ func NewDirect(sks []*bls.SecretKey) *Direct {
 
 // FetchValidatingKeys fetches the list of public keys that should be used to validate with.
 func (km *Direct) FetchValidatingKeys() ([][48]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
 	keys := make([][48]byte, 0, len(km.publicKeys))
 	for key := range km.publicKeys {
 		keys = append(keys, key)
@@ -39,8 +47,45 @@ func (km *Direct) FetchValidatingKeys() ([][48]byte, error) {
 
 // Sign signs a message for the validator to broadcast.
 func (km *Direct) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
-	if secretKey, exists := km.secretKeys[pubKey]; exists {
+	km.lock.RLock()
+	secretKey, exists := km.secretKeys[pubKey]
+	km.lock.RUnlock()
+	if exists {
 		return secretKey.Sign(root[:], domain), nil
 	}
 	return nil, ErrNoSuchKey
 }
+
+// SetKeys replaces the secret keys held by the key manager, notifying the subscribers to key changes
+// if the resulting set of keys differs from the previous one.
+func (km *Direct) SetKeys(sks []*bls.SecretKey) {
+	publicKeys := make(map[[48]byte]*bls.PublicKey, len(sks))
+	secretKeys := make(map[[48]byte]*bls.SecretKey, len(sks))
+	for _, sk := range sks {
+		publicKey := sk.PublicKey()
+		pubKey := bytesutil.ToBytes48(publicKey.Marshal())
+		publicKeys[pubKey] = publicKey
+		secretKeys[pubKey] = sk
+	}
+
+	km.lock.Lock()
+	changed := len(publicKeys) != len(km.publicKeys)
+	for pubKey := range publicKeys {
+		if _, exists := km.publicKeys[pubKey]; !exists {
+			changed = true
+		}
+	}
+	km.publicKeys = publicKeys
+	km.secretKeys = secretKeys
+	km.lock.Unlock()
+
+	if changed {
+		keys, _ := km.FetchValidatingKeys()
+		km.keysFeed.Send(keys)
+	}
+}
+
+// SubscribeKeyChanges sends the full list of validating keys to the channel each time it changes.
+func (km *Direct) SubscribeKeyChanges(_ context.Context, ch chan<- [][48]byte) event.Subscription {
+	return km.keysFeed.Subscribe(ch)
+}
@@ -0,0 +1,93 @@
+package keymanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// EIP2335 is a key manager that loads keys from a directory of EIP-2335 keystores.
+type EIP2335 struct {
+	*Direct
+	path       string
+	passphrase string
+	watchOnce  sync.Once
+}
+
+type eip2335Opts struct {
+	Path       string `json:"path"`
+	Passphrase string `json:"passphrase"`
+}
+
+var eip2335OptsHelp = `The eip2335 key manager loads keys from a directory of EIP-2335 keystores, such as the
+keystores generated by other Ethereum 2 tooling or imported with 'validator accounts import'.  The options are:
+  - path This is the filesystem path to the directory holding the keystores
+  - passphrase This is the passphrase used to decrypt the keystores.  Will be asked for if not supplied
+A sample set of options are:
+  {
+    "path":       "/home/me/keystores", // Load the keystores in '/home/me/keystores'
+    "passphrase": "secret"              // Use the passphrase 'secret' to decrypt the keystores
+  }`
+
+// NewEIP2335 creates a key manager populated with the keys from the EIP-2335 keystores at the given path.
+func NewEIP2335(input string) (KeyManager, string, error) {
+	opts := &eip2335Opts{}
+	err := json.Unmarshal([]byte(input), opts)
+	if err != nil {
+		return nil, eip2335OptsHelp, err
+	}
+
+	if opts.Path == "" {
+		return nil, eip2335OptsHelp, errors.New("a path to the keystores is required")
+	}
+	if opts.Passphrase == "" {
+		log.Info("Enter the password of your keystores:")
+		bytePassword, err := terminal.ReadPassword(syscall.Stdin)
+		if err != nil {
+			return nil, eip2335OptsHelp, err
+		}
+		opts.Passphrase = strings.Replace(string(bytePassword), "\n", "", -1)
+	}
+
+	km := &EIP2335{
+		Direct:     NewDirect(nil),
+		path:       opts.Path,
+		passphrase: opts.Passphrase,
+	}
+	sks, err := km.loadKeys()
+	if err != nil {
+		return nil, eip2335OptsHelp, err
+	}
+	km.SetKeys(sks)
+	return km, "", nil
+}
+
+// SubscribeKeyChanges sends the full list of validating keys to the channel each time it changes.
+// The keystore directory is watched for changes once there are subscribers, until the context is done.
+func (km *EIP2335) SubscribeKeyChanges(ctx context.Context, ch chan<- [][48]byte) event.Subscription {
+	km.watchOnce.Do(func() {
+		go watchKeysDirectory(ctx, km.Direct, km.path, km.loadKeys)
+	})
+	return km.Direct.SubscribeKeyChanges(ctx, ch)
+}
+
+// loadKeys decrypts the keys of the keystores in the directory of the key manager.
+func (km *EIP2335) loadKeys() ([]*bls.SecretKey, error) {
+	keyMap, err := keystore.NewKeystore(km.path).GetKeysEIP2335(km.path, km.passphrase)
+	if err != nil {
+		return nil, err
+	}
+	sks := make([]*bls.SecretKey, 0, len(keyMap))
+	for _, key := range keyMap {
+		sks = append(sks, key.SecretKey)
+	}
+	return sks, nil
+}
@@ -0,0 +1,62 @@
+package keymanager_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+func TestEIP2335MissingPath(t *testing.T) {
+	_, _, err := keymanager.NewEIP2335(`{"passphrase":"password"}`)
+	if err == nil {
+		t.Fatal("Missing expected error")
+	}
+	expectedErr := "a path to the keystores is required"
+	if !strings.Contains(err.Error(), expectedErr) {
+		t.Errorf("Incorrect value for error; expected %q, received %v", expectedErr, err)
+	}
+}
+
+func TestEIP2335LoadsKeystores(t *testing.T) {
+	dir := filepath.Join(testutil.TempDir(), "eip2335-keymanager")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	key, err := keystore.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyjson, err := keystore.EncryptKeyEIP2335(key, "password", "", keystore.PBKDF2KDF, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "keystore-0.json"), keyjson, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	km, _, err := keymanager.NewEIP2335(fmt.Sprintf(`{"path":%q,"passphrase":"password"}`, dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := bytesutil.ToBytes48(key.PublicKey.Marshal())
+	if len(keys) != 1 || keys[0] != pubKey {
+		t.Fatalf("Incorrect keys returned; expected %#x, received %#x", pubKey, keys)
+	}
+	if _, err := km.Sign(pubKey, [32]byte{}, 0); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -1,16 +1,22 @@
 package keymanager
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"os/user"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -18,6 +24,9 @@ import (
 // Keystore is a key manager that loads keys from a standard keystore.
 type Keystore struct {
 	*Direct
+	path       string
+	passphrase string
+	watchOnce  sync.Once
 }
 
 type keystoreOpts struct {
@@ -77,11 +86,13 @@ func NewKeystore(input string) (KeyManager, string, error) {
 		return nil, keystoreOptsHelp, err
 	}
 
-	km := &Unencrypted{
+	km := &Keystore{
 		Direct: &Direct{
 			publicKeys: make(map[[48]byte]*bls.PublicKey),
 			secretKeys: make(map[[48]byte]*bls.SecretKey),
 		},
+		path:       opts.Path,
+		passphrase: opts.Passphrase,
 	}
 	for _, key := range keyMap {
 		pubKey := bytesutil.ToBytes48(key.PublicKey.Marshal())
@@ -91,6 +102,77 @@ func NewKeystore(input string) (KeyManager, string, error) {
 	return km, "", nil
 }
 
+// SubscribeKeyChanges sends the full list of validating keys to the channel each time it changes.
+// The keystore directory is watched for changes once there are subscribers, until the context is done.
+func (km *Keystore) SubscribeKeyChanges(ctx context.Context, ch chan<- [][48]byte) event.Subscription {
+	km.watchOnce.Do(func() {
+		go km.watch(ctx)
+	})
+	return km.Direct.SubscribeKeyChanges(ctx, ch)
+}
+
+// watch reloads the keys of the keystore whenever the files in its directory change.
+func (km *Keystore) watch(ctx context.Context) {
+	watchKeysDirectory(ctx, km.Direct, km.path, func() ([]*bls.SecretKey, error) {
+		keyMap, err := accounts.DecryptKeysFromKeystore(km.path, km.passphrase)
+		if err != nil {
+			return nil, err
+		}
+		sks := make([]*bls.SecretKey, 0, len(keyMap))
+		for _, key := range keyMap {
+			sks = append(sks, key.SecretKey)
+		}
+		return sks, nil
+	})
+}
+
+// watchKeysDirectory sets the keys of a direct key manager to the keys loaded from a directory
+// whenever the files in the directory change. It returns once the context is done.
+func watchKeysDirectory(ctx context.Context, km *Direct, path string, load func() ([]*bls.SecretKey, error)) {
+	ticker := time.NewTicker(keysPollInterval)
+	defer ticker.Stop()
+	fingerprint, err := directoryFingerprint(path)
+	if err != nil {
+		log.WithError(err).Warn("Could not read keys directory")
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		latest, err := directoryFingerprint(path)
+		if err != nil {
+			log.WithError(err).Warn("Could not read keys directory")
+			continue
+		}
+		if latest == fingerprint {
+			continue
+		}
+		sks, err := load()
+		if err != nil {
+			log.WithError(err).Warn("Could not reload keys")
+			continue
+		}
+		fingerprint = latest
+		km.SetKeys(sks)
+	}
+}
+
+// directoryFingerprint summarizes the names, sizes and modification times of the files in
+// a directory, so that changes to its contents can be detected cheaply.
+func directoryFingerprint(path string) (string, error) {
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, file := range files {
+		fmt.Fprintf(&b, "%s:%d:%d\n", file.Name(), file.Size(), file.ModTime().UnixNano())
+	}
+	return b.String(), nil
+}
+
 func homeDir() string {
 	if home := os.Getenv("HOME"); home != "" {
 		return home
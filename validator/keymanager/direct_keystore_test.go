@@ -0,0 +1,28 @@
+package keymanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestWatchKeysDirectory_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		watchKeysDirectory(ctx, NewDirect(nil), testutil.TempDir(), func() ([]*bls.SecretKey, error) {
+			return nil, nil
+		})
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the keys directory watch to stop once the context is done")
+	}
+}
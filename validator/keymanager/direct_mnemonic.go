@@ -0,0 +1,70 @@
+package keymanager
+
+import (
+	"encoding/json"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/hdkey"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Mnemonic is a key manager that derives keys from a BIP-39 mnemonic along the paths of EIP-2334.
+type Mnemonic struct {
+	*Direct
+}
+
+type mnemonicOpts struct {
+	Mnemonic   string `json:"mnemonic"`
+	Passphrase string `json:"passphrase"`
+	Accounts   uint64 `json:"accounts"`
+	Offset     uint64 `json:"offset"`
+}
+
+var mnemonicOptsHelp = `The mnemonic key manager derives the signing keys of a number of accounts from a BIP-39 mnemonic,
+such as the one generated by 'validator accounts new-mnemonic'.  The signing key of account i is derived at the
+path m/12381/3600/i/0/0.  The options are:
+  - mnemonic This is the mnemonic the keys are derived from.  Will be asked for if not supplied
+  - passphrase This is the optional passphrase of the mnemonic
+  - accounts This is the number of accounts to derive keys for
+  - offset This is the number of accounts to skip before starting to derive keys
+A sample set of options are:
+  {
+    "mnemonic": "abandon ... about", // Derive the keys from the mnemonic 'abandon ... about'
+    "accounts": 100,                 // Derive the keys of 100 accounts
+    "offset":   0                    // Start with the first account
+  }`
+
+// NewMnemonic creates a key manager populated with the signing keys of a number of accounts
+// derived from a mnemonic.
+func NewMnemonic(input string) (KeyManager, string, error) {
+	opts := &mnemonicOpts{}
+	err := json.Unmarshal([]byte(input), opts)
+	if err != nil {
+		return nil, mnemonicOptsHelp, err
+	}
+
+	if opts.Accounts == 0 {
+		return nil, mnemonicOptsHelp, errors.New("the number of accounts must be greater than 0")
+	}
+	if opts.Mnemonic == "" {
+		log.Info("Enter the mnemonic of your accounts:")
+		byteMnemonic, err := terminal.ReadPassword(syscall.Stdin)
+		if err != nil {
+			return nil, mnemonicOptsHelp, err
+		}
+		opts.Mnemonic = strings.Replace(string(byteMnemonic), "\n", "", -1)
+	}
+
+	seed, err := hdkey.SeedFromMnemonic(opts.Mnemonic, opts.Passphrase)
+	if err != nil {
+		return nil, mnemonicOptsHelp, err
+	}
+	sks, _, err := hdkey.DeriveAccountKeys(seed, opts.Offset, opts.Accounts)
+	if err != nil {
+		return nil, mnemonicOptsHelp, errors.Wrap(err, "could not derive account keys")
+	}
+
+	return &Mnemonic{Direct: NewDirect(sks)}, "", nil
+}
@@ -0,0 +1,68 @@
+package keymanager_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/hdkey"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestMnemonicNoAccounts(t *testing.T) {
+	_, _, err := keymanager.NewMnemonic(`{"mnemonic":"` + testMnemonic + `"}`)
+	if err == nil {
+		t.Fatal("Missing expected error")
+	}
+	expectedErr := "the number of accounts must be greater than 0"
+	if !strings.Contains(err.Error(), expectedErr) {
+		t.Errorf("Incorrect value for error; expected %q, received %v", expectedErr, err)
+	}
+}
+
+func TestMnemonicInvalid(t *testing.T) {
+	_, _, err := keymanager.NewMnemonic(`{"mnemonic":"abandon abandon","accounts":1}`)
+	if err == nil {
+		t.Fatal("Missing expected error")
+	}
+	expectedErr := hdkey.ErrInvalidMnemonic.Error()
+	if !strings.Contains(err.Error(), expectedErr) {
+		t.Errorf("Incorrect value for error; expected %q, received %v", expectedErr, err)
+	}
+}
+
+func TestMnemonicDerivesAccountKeys(t *testing.T) {
+	km, _, err := keymanager.NewMnemonic(`{"mnemonic":"` + testMnemonic + `","passphrase":"TREZOR","accounts":2,"offset":3}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("Incorrect number of keys returned; expected 2, received %d", len(keys))
+	}
+
+	seed, err := hdkey.SeedFromMnemonic(testMnemonic, "TREZOR")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, account := range []uint32{3, 4} {
+		sk, err := hdkey.DeriveSecretKey(seed, hdkey.SigningKeyPath(account))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		found := false
+		for _, key := range keys {
+			if bytes.Equal(key[:], sk.PublicKey().Marshal()) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Missing signing key of account %d", account)
+		}
+	}
+}
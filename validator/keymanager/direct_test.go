@@ -1,8 +1,10 @@
 package keymanager_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -76,3 +78,37 @@ func TestSign(t *testing.T) {
 		t.Fatal("Failed to verify generated signature")
 	}
 }
+
+func TestSetKeysNotifiesChanges(t *testing.T) {
+	sks := []*bls.SecretKey{bls.RandKey()}
+	direct := keymanager.NewDirect(sks)
+	ch := make(chan [][48]byte, 1)
+	sub := direct.SubscribeKeyChanges(context.Background(), ch)
+	defer sub.Unsubscribe()
+
+	// Setting the same keys is not a change.
+	direct.SetKeys(sks)
+	select {
+	case keys := <-ch:
+		t.Fatalf("Unexpected notification of keys %v", keys)
+	default:
+	}
+
+	sks = append(sks, bls.RandKey())
+	direct.SetKeys(sks)
+	select {
+	case keys := <-ch:
+		if len(keys) != 2 {
+			t.Errorf("Incorrect number of keys notified; expected 2, received %d", len(keys))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected notification of key change")
+	}
+
+	pubKey := bytesutil.ToBytes48(sks[0].PublicKey().Marshal())
+	direct.SetKeys(sks[1:])
+	<-ch
+	if _, err := direct.Sign(pubKey, [32]byte{}, 0); err != keymanager.ErrNoSuchKey {
+		t.Errorf("Incorrect error signing with removed key: expected %v, received %v", keymanager.ErrNoSuchKey, err)
+	}
+}
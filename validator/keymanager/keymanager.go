@@ -1,9 +1,13 @@
 package keymanager
 
 import (
+	"context"
 	"errors"
+	"time"
 
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/event"
 )
 
 // ErrNoSuchKey is returned whenever a request is made for a key of which a key manager is unaware.
@@ -12,6 +16,9 @@ var ErrNoSuchKey = errors.New("no such key")
 // ErrCannotSign is returned whenever a signing attempt fails.
 var ErrCannotSign = errors.New("cannot sign")
 
+// ErrDenied is returned whenever a signing attempt is refused by the signer.
+var ErrDenied = errors.New("signing request denied")
+
 // KeyManager controls access to private keys by the validator.
 type KeyManager interface {
 	// FetchValidatingKeys fetches the list of public keys that should be used to validate with.
@@ -19,3 +26,25 @@ type KeyManager interface {
 	// Sign signs a message for the validator to broadcast.
 	Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error)
 }
+
+// ProtectingKeyManager provides access to a key manager that protects its clients from slashing events.
+// Signing requests carry the full data to be signed, allowing the key manager to check them against
+// its own signing history before signing.
+type ProtectingKeyManager interface {
+	// SignAttestation signs an attestation for the validator to broadcast.
+	SignAttestation(pubKey [48]byte, domain uint64, data *ethpb.AttestationData) (*bls.Signature, error)
+	// SignProposal signs a block proposal for the validator to broadcast.
+	SignProposal(pubKey [48]byte, domain uint64, data *ethpb.BeaconBlockHeader) (*bls.Signature, error)
+}
+
+// keysPollInterval is the interval at which key managers backed by a local store check it for
+// added or removed keys.
+const keysPollInterval = 12 * time.Second
+
+// KeyChangeNotifier provides access to a key manager whose validating keys can change while the validator
+// client runs, for example when keys are added to or removed from its store.
+type KeyChangeNotifier interface {
+	// SubscribeKeyChanges sends the full list of validating keys to the channel each time it changes.
+	// Key managers which poll their store for changes stop doing so once the context is done.
+	SubscribeKeyChanges(ctx context.Context, ch chan<- [][48]byte) event.Subscription
+}
@@ -0,0 +1,196 @@
+package keymanager
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var _ = ProtectingKeyManager(&Remote{})
+
+// remoteTimeout is the maximum time to wait for a response from the remote signer.
+const remoteTimeout = 10 * time.Second
+
+// Remote is a key manager that accesses a remote signer to obtain public keys and signatures.
+// Attestations and block proposals are sent to the signer in full, allowing it to refuse
+// to sign slashable requests.
+type Remote struct {
+	paths  []string
+	signer pb.RemoteSignerClient
+}
+
+type remoteOpts struct {
+	Location     string                  `json:"location"`
+	Accounts     []string                `json:"accounts"`
+	Certificates *remoteCertificatesOpts `json:"certificates"`
+}
+
+type remoteCertificatesOpts struct {
+	CACert     string `json:"ca_cert"`
+	ClientCert string `json:"client_cert"`
+	ClientKey  string `json:"client_key"`
+}
+
+var remoteOptsHelp = `The remote key manager connects to a remote signer over mutually-authenticated gRPC.  The options are:
+  - location This is the host and port of the remote signer
+  - accounts This is a list of account specifiers.  An account specifier is of
+    the form <wallet name>/[account name],  where the account name can be a
+    regular expression.  If the account specifier is just <wallet name> all
+    accounts in that wallet will be used.  Multiple account specifiers can be
+    supplied if required.
+  - certificates This provides paths to the TLS certificates used to connect
+    to the signer.  All of ca_cert, client_cert and client_key are required.
+A sample set of options are:
+  {
+    "location":    "signer.example.com:12345",    // Connect to the remote signer at signer.example.com port 12345
+    "accounts":    ["Validators/Account.*"],       // Use all accounts in the 'Validators' wallet starting with 'Account'
+    "certificates": {
+      "ca_cert":     "/home/eth2/certs/ca.crt",     // Certificate of the authority which signed the signer's certificate
+      "client_cert": "/home/eth2/certs/client.crt", // Certificate presented by this client to the signer
+      "client_key":  "/home/eth2/certs/client.key"  // Secret key for the client certificate
+    }
+  }`
+
+// NewRemote creates a key manager populated with the keys from a remote signer.
+func NewRemote(input string) (KeyManager, string, error) {
+	opts := &remoteOpts{}
+	if err := json.Unmarshal([]byte(input), opts); err != nil {
+		return nil, remoteOptsHelp, err
+	}
+
+	if opts.Location == "" {
+		return nil, remoteOptsHelp, errors.New("signer location is required")
+	}
+	if len(opts.Accounts) == 0 {
+		return nil, remoteOptsHelp, errors.New("at least one account specifier is required")
+	}
+	if opts.Certificates == nil {
+		return nil, remoteOptsHelp, errors.New("certificates are required")
+	}
+	if opts.Certificates.CACert == "" || opts.Certificates.ClientCert == "" || opts.Certificates.ClientKey == "" {
+		return nil, remoteOptsHelp, errors.New("ca_cert, client_cert and client_key certificates are all required")
+	}
+
+	creds, err := remoteCredentials(opts.Certificates)
+	if err != nil {
+		return nil, remoteOptsHelp, err
+	}
+	conn, err := grpc.Dial(opts.Location, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, remoteOptsHelp, errors.Wrap(err, "failed to connect to remote signer")
+	}
+
+	return &Remote{
+		paths:  opts.Accounts,
+		signer: pb.NewRemoteSignerClient(conn),
+	}, "", nil
+}
+
+// remoteCredentials builds the mutually-authenticated TLS credentials used to connect to the signer.
+func remoteCredentials(certs *remoteCertificatesOpts) (credentials.TransportCredentials, error) {
+	clientPair, err := tls.LoadX509KeyPair(certs.ClientCert, certs.ClientKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+	}
+	caCert, err := ioutil.ReadFile(certs.CACert)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("failed to add server's CA certificate to pool")
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{clientPair},
+		RootCAs:      certPool,
+	}), nil
+}
+
+// FetchValidatingKeys fetches the list of public keys that should be used to validate with.
+func (km *Remote) FetchValidatingKeys() ([][48]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+	defer cancel()
+	resp, err := km.signer.ListAccounts(ctx, &pb.ListAccountsRequest{Paths: km.paths})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list accounts from remote signer")
+	}
+	res := make([][48]byte, 0, len(resp.Accounts))
+	for _, account := range resp.Accounts {
+		if len(account.PublicKey) != 48 {
+			log.WithField("account", account.Name).Warn("Remote signer returned an invalid public key; cannot validate")
+			continue
+		}
+		res = append(res, bytesutil.ToBytes48(account.PublicKey))
+	}
+	return res, nil
+}
+
+// Sign signs a message for the validator to broadcast.
+func (km *Remote) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+	defer cancel()
+	resp, err := km.signer.Sign(ctx, &pb.SignRequest{
+		PublicKey:   pubKey[:],
+		SigningRoot: root[:],
+		Domain:      domain,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign with remote signer")
+	}
+	return signatureFromResponse(pubKey, resp)
+}
+
+// SignAttestation signs an attestation for the validator to broadcast.
+func (km *Remote) SignAttestation(pubKey [48]byte, domain uint64, data *ethpb.AttestationData) (*bls.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+	defer cancel()
+	resp, err := km.signer.SignAttestation(ctx, &pb.SignAttestationRequest{
+		PublicKey: pubKey[:],
+		Domain:    domain,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign attestation with remote signer")
+	}
+	return signatureFromResponse(pubKey, resp)
+}
+
+// SignProposal signs a block proposal for the validator to broadcast.
+func (km *Remote) SignProposal(pubKey [48]byte, domain uint64, data *ethpb.BeaconBlockHeader) (*bls.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteTimeout)
+	defer cancel()
+	resp, err := km.signer.SignProposal(ctx, &pb.SignProposalRequest{
+		PublicKey: pubKey[:],
+		Domain:    domain,
+		Header:    data,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign proposal with remote signer")
+	}
+	return signatureFromResponse(pubKey, resp)
+}
+
+// signatureFromResponse converts a response from the remote signer in to a signature.
+func signatureFromResponse(pubKey [48]byte, resp *pb.SignResponse) (*bls.Signature, error) {
+	switch resp.State {
+	case pb.SignResponse_SUCCEEDED:
+		return bls.SignatureFromBytes(resp.Signature)
+	case pb.SignResponse_DENIED:
+		log.WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Warn("Remote signer denied signing request")
+		return nil, ErrDenied
+	default:
+		return nil, ErrCannotSign
+	}
+}
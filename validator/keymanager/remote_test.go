@@ -0,0 +1,197 @@
+package keymanager
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/signer"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc"
+)
+
+// mockRemoteSigner signs with a local set of secret keys, denying requests for any key in denied.
+type mockRemoteSigner struct {
+	keys   map[[48]byte]*bls.SecretKey
+	denied map[[48]byte]bool
+}
+
+func (m *mockRemoteSigner) ListAccounts(_ context.Context, _ *pb.ListAccountsRequest, _ ...grpc.CallOption) (*pb.ListAccountsResponse, error) {
+	resp := &pb.ListAccountsResponse{}
+	for pubKey := range m.keys {
+		key := pubKey
+		resp.Accounts = append(resp.Accounts, &pb.Account{Name: "Test/Account", PublicKey: key[:]})
+	}
+	return resp, nil
+}
+
+func (m *mockRemoteSigner) Sign(_ context.Context, req *pb.SignRequest, _ ...grpc.CallOption) (*pb.SignResponse, error) {
+	return m.sign(req.PublicKey, req.SigningRoot, req.Domain), nil
+}
+
+func (m *mockRemoteSigner) SignAttestation(_ context.Context, req *pb.SignAttestationRequest, _ ...grpc.CallOption) (*pb.SignResponse, error) {
+	root, err := ssz.HashTreeRoot(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return m.sign(req.PublicKey, root[:], req.Domain), nil
+}
+
+func (m *mockRemoteSigner) SignProposal(_ context.Context, req *pb.SignProposalRequest, _ ...grpc.CallOption) (*pb.SignResponse, error) {
+	root, err := ssz.HashTreeRoot(req.Header)
+	if err != nil {
+		return nil, err
+	}
+	return m.sign(req.PublicKey, root[:], req.Domain), nil
+}
+
+func (m *mockRemoteSigner) sign(publicKey []byte, root []byte, domain uint64) *pb.SignResponse {
+	pubKey := bytesutil.ToBytes48(publicKey)
+	if m.denied[pubKey] {
+		return &pb.SignResponse{State: pb.SignResponse_DENIED}
+	}
+	sk, ok := m.keys[pubKey]
+	if !ok {
+		return &pb.SignResponse{State: pb.SignResponse_FAILED}
+	}
+	return &pb.SignResponse{
+		State:     pb.SignResponse_SUCCEEDED,
+		Signature: sk.Sign(root, domain).Marshal(),
+	}
+}
+
+func TestNewRemote_BadOpts(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "NoLocation",
+			input: `{"accounts":["foo"]}`,
+			err:   "signer location is required",
+		},
+		{
+			name:  "NoAccounts",
+			input: `{"location":"localhost:4000"}`,
+			err:   "at least one account specifier is required",
+		},
+		{
+			name:  "NoCertificates",
+			input: `{"location":"localhost:4000","accounts":["foo"]}`,
+			err:   "certificates are required",
+		},
+		{
+			name:  "MissingClientKey",
+			input: `{"location":"localhost:4000","accounts":["foo"],"certificates":{"ca_cert":"ca.crt","client_cert":"client.crt"}}`,
+			err:   "ca_cert, client_cert and client_key certificates are all required",
+		},
+		{
+			name:  "BadCertificatePaths",
+			input: `{"location":"localhost:4000","accounts":["foo"],"certificates":{"ca_cert":"ca.crt","client_cert":"client.crt","client_key":"client.key"}}`,
+			err:   "failed to obtain client's certificate and/or key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, help, err := NewRemote(test.input)
+			if err == nil {
+				t.Fatalf("Missing expected error: %v", test.err)
+			}
+			if !strings.Contains(err.Error(), test.err) {
+				t.Errorf("Unexpected error value: expected %v, received %v", test.err, err)
+			}
+			if help != remoteOptsHelp {
+				t.Error("Expected remote options help to be returned")
+			}
+		})
+	}
+}
+
+func TestRemote_FetchValidatingKeys(t *testing.T) {
+	sk := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	km := &Remote{
+		signer: &mockRemoteSigner{keys: map[[48]byte]*bls.SecretKey{pubKey: sk}},
+	}
+
+	keys, err := km.FetchValidatingKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 {
+		t.Fatalf("Incorrect number of keys returned; expected 1, received %d", len(keys))
+	}
+	if keys[0] != pubKey {
+		t.Errorf("Wanted public key %#x, received %#x", pubKey, keys[0])
+	}
+}
+
+func TestRemote_Sign(t *testing.T) {
+	sk := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	km := &Remote{
+		signer: &mockRemoteSigner{keys: map[[48]byte]*bls.SecretKey{pubKey: sk}},
+	}
+
+	root := [32]byte{'a'}
+	domain := uint64(2)
+	sig, err := km.Sign(pubKey, root, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root[:], sk.PublicKey(), domain) {
+		t.Error("Signature from remote signer did not verify")
+	}
+
+	if _, err := km.Sign([48]byte{}, root, domain); err != ErrCannotSign {
+		t.Errorf("Incorrect error: expected %v, received %v", ErrCannotSign, err)
+	}
+}
+
+func TestRemote_SignDenied(t *testing.T) {
+	sk := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	km := &Remote{
+		signer: &mockRemoteSigner{
+			keys:   map[[48]byte]*bls.SecretKey{pubKey: sk},
+			denied: map[[48]byte]bool{pubKey: true},
+		},
+	}
+
+	_, err := km.Sign(pubKey, [32]byte{}, 0)
+	if err != ErrDenied {
+		t.Errorf("Incorrect error: expected %v, received %v", ErrDenied, err)
+	}
+}
+
+func TestRemote_SignAttestation(t *testing.T) {
+	sk := bls.RandKey()
+	pubKey := bytesutil.ToBytes48(sk.PublicKey().Marshal())
+	km := &Remote{
+		signer: &mockRemoteSigner{keys: map[[48]byte]*bls.SecretKey{pubKey: sk}},
+	}
+
+	data := &ethpb.AttestationData{
+		Slot:            5,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+	}
+	domain := uint64(1)
+	sig, err := km.SignAttestation(pubKey, domain, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root[:], sk.PublicKey(), domain) {
+		t.Error("Attestation signature from remote signer did not verify")
+	}
+}
@@ -1,14 +1,18 @@
 package keymanager
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	e2wallet "github.com/wealdtech/go-eth2-wallet"
 	e2wtypes "github.com/wealdtech/go-eth2-wallet-types"
 )
@@ -47,18 +51,33 @@ func NewWallet(input string) (KeyManager, string, error) {
 		return nil, walletOptsHelp, errors.New("at least one passphrase is required to decrypt accounts")
 	}
 
+	locked := make(map[[48]byte]bool)
+	accounts, err := openAccounts(opts, nil, locked)
+	if err != nil {
+		return nil, walletOptsHelp, err
+	}
 	km := &Wallet{
-		accounts: make(map[[48]byte]e2wtypes.Account),
+		opts:     opts,
+		accounts: accounts,
+		locked:   locked,
 	}
+	return km, walletOptsHelp, nil
+}
 
+// openAccounts opens the accounts matching the account specifiers, unlocking them with the
+// supplied passphrases. Accounts already unlocked in known are reused as they are. Accounts which
+// none of the passphrases unlock are added to locked and skipped from then on, as the passphrases
+// do not change.
+func openAccounts(opts *walletOpts, known map[[48]byte]e2wtypes.Account, locked map[[48]byte]bool) (map[[48]byte]e2wtypes.Account, error) {
+	accounts := make(map[[48]byte]e2wtypes.Account)
 	for _, path := range opts.Accounts {
 		parts := strings.Split(path, "/")
 		if len(parts[0]) == 0 {
-			return nil, walletOptsHelp, fmt.Errorf("did not understand account specifier %q", path)
+			return nil, fmt.Errorf("did not understand account specifier %q", path)
 		}
 		wallet, err := e2wallet.OpenWallet(parts[0])
 		if err != nil {
-			return nil, walletOptsHelp, err
+			return nil, err
 		}
 		accountSpecifier := "^.*$"
 		if len(parts) > 1 && len(parts[1]) > 0 {
@@ -68,27 +87,45 @@ func NewWallet(input string) (KeyManager, string, error) {
 		for account := range wallet.Accounts() {
 			if re.Match([]byte(account.Name())) {
 				pubKey := bytesutil.ToBytes48(account.PublicKey().Marshal())
+				if knownAccount, exists := known[pubKey]; exists {
+					accounts[pubKey] = knownAccount
+					continue
+				}
+				if locked[pubKey] {
+					continue
+				}
+				var err error
 				for _, passphrase := range opts.Passphrases {
-					if err := account.Unlock([]byte(passphrase)); err != nil {
-						log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Warn("Failed to unlock account with supplied passphrases; cannot validate")
-					} else {
-						km.accounts[pubKey] = account
+					if err = account.Unlock([]byte(passphrase)); err == nil {
+						break
 					}
 				}
+				if err != nil {
+					log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", pubKey)).Warn("Failed to unlock account with supplied passphrases; cannot validate")
+					locked[pubKey] = true
+					continue
+				}
+				accounts[pubKey] = account
 			}
 		}
 	}
-
-	return km, walletOptsHelp, nil
+	return accounts, nil
 }
 
 // Wallet is a key manager that loads keys from a local Ethereum 2 wallet.
 type Wallet struct {
-	accounts map[[48]byte]e2wtypes.Account
+	opts      *walletOpts
+	lock      sync.RWMutex
+	accounts  map[[48]byte]e2wtypes.Account
+	locked    map[[48]byte]bool
+	keysFeed  event.Feed
+	watchOnce sync.Once
 }
 
 // FetchValidatingKeys fetches the list of public keys that should be used to validate with.
 func (km *Wallet) FetchValidatingKeys() ([][48]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
 	res := make([][48]byte, 0, len(km.accounts))
 	for pubKey := range km.accounts {
 		res = append(res, pubKey)
@@ -98,7 +135,9 @@ func (km *Wallet) FetchValidatingKeys() ([][48]byte, error) {
 
 // Sign signs a message for the validator to broadcast.
 func (km *Wallet) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Signature, error) {
+	km.lock.RLock()
 	account, exists := km.accounts[pubKey]
+	km.lock.RUnlock()
 	if !exists {
 		return nil, ErrNoSuchKey
 	}
@@ -108,3 +147,48 @@ func (km *Wallet) Sign(pubKey [48]byte, root [32]byte, domain uint64) (*bls.Sign
 	}
 	return bls.SignatureFromBytes(sig.Marshal())
 }
+
+// SubscribeKeyChanges sends the full list of validating keys to the channel each time it changes.
+// The wallets are reloaded periodically once there are subscribers, until the context is done,
+// picking up accounts added to or removed from them.
+func (km *Wallet) SubscribeKeyChanges(ctx context.Context, ch chan<- [][48]byte) event.Subscription {
+	km.watchOnce.Do(func() {
+		go km.watch(ctx)
+	})
+	return km.keysFeed.Subscribe(ch)
+}
+
+// watch reloads the accounts of the wallets, notifying subscribers when the set of keys changes.
+func (km *Wallet) watch(ctx context.Context) {
+	ticker := time.NewTicker(keysPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		km.lock.RLock()
+		known := km.accounts
+		km.lock.RUnlock()
+		accounts, err := openAccounts(km.opts, known, km.locked)
+		if err != nil {
+			log.WithError(err).Warn("Could not reload wallet accounts")
+			continue
+		}
+		changed := len(accounts) != len(known)
+		for pubKey := range accounts {
+			if _, exists := known[pubKey]; !exists {
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		km.lock.Lock()
+		km.accounts = accounts
+		km.lock.Unlock()
+		keys, _ := km.FetchValidatingKeys()
+		km.keysFeed.Send(keys)
+	}
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"runtime"
 	runtimeDebug "runtime/debug"
 
 	joonix "github.com/joonix/log"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -14,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/node"
 	"github.com/sirupsen/logrus"
@@ -33,6 +36,83 @@ func startNode(ctx *cli.Context) error {
 	return nil
 }
 
+// configureParams applies the feature flags and selects the beacon chain parameters
+// for commands which run without starting the validator client.
+func configureParams(ctx *cli.Context) {
+	featureconfig.ConfigureValidator(ctx)
+	// Use custom config values if the --no-custom-config flag is set.
+	if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
+		log.Info("Using custom parameter configuration")
+		if featureconfig.Get().MinimalConfig {
+			log.Warn("Using Minimal Config")
+			params.UseMinimalConfig()
+		} else {
+			log.Warn("Using Demo Config")
+			params.UseDemoBeaconConfig()
+		}
+	}
+	if ctx.GlobalIsSet(cmd.ChainConfigFileFlag.Name) {
+		if err := params.LoadChainConfigFile(ctx.GlobalString(cmd.ChainConfigFileFlag.Name)); err != nil {
+			log.WithError(err).Fatal("Could not load chain config file")
+		}
+	}
+}
+
+func exportSlashingProtection(dataDir string, path string) error {
+	if path == "" {
+		return errors.New("slashing protection file is required")
+	}
+	valDB, err := db.NewKVStore(dataDir, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not open validator database in dir %s", dataDir)
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Failed to close validator database")
+		}
+	}()
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if err := valDB.ExportHistory(context.Background(), f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	log.WithField("path", path).Info("Exported slashing protection history")
+	return nil
+}
+
+func importSlashingProtection(dataDir string, path string) error {
+	if path == "" {
+		return errors.New("slashing protection file is required")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	valDB, err := db.NewKVStore(dataDir, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not open validator database in dir %s", dataDir)
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Error("Failed to close validator database")
+		}
+	}()
+
+	if err := valDB.ImportHistory(context.Background(), f); err != nil {
+		return err
+	}
+	log.WithField("path", path).Info("Imported slashing protection history")
+	return nil
+}
+
 var appFlags = []cli.Flag{
 	flags.NoCustomConfigFlag,
 	flags.BeaconRPCProviderFlag,
@@ -45,11 +125,16 @@ var appFlags = []cli.Flag{
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
 	flags.GrpcMaxCallRecvMsgSizeFlag,
+	flags.ManagementAPIFlag,
+	flags.ManagementHostFlag,
+	flags.ManagementPortFlag,
+	flags.ManagementGatewayPortFlag,
 	flags.KeyManager,
 	flags.KeyManagerOpts,
 	cmd.VerbosityFlag,
 	cmd.DataDirFlag,
 	cmd.ClearDB,
+	cmd.ChainConfigFileFlag,
 	cmd.ForceClearDB,
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
@@ -95,24 +180,93 @@ contract in order to activate the validator client`,
 						flags.PasswordFlag,
 					},
 					Action: func(ctx *cli.Context) {
-						featureconfig.ConfigureValidator(ctx)
-						// Use custom config values if the --no-custom-config flag is set.
-						if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
-							log.Info("Using custom parameter configuration")
-							if featureconfig.Get().MinimalConfig {
-								log.Warn("Using Minimal Config")
-								params.UseMinimalConfig()
-							} else {
-								log.Warn("Using Demo Config")
-								params.UseDemoBeaconConfig()
-							}
-						}
-
+						configureParams(ctx)
 						if keystoreDir, _, err := accounts.CreateValidatorAccount(ctx.String(flags.KeystorePathFlag.Name), ctx.String(flags.PasswordFlag.Name)); err != nil {
 							log.WithError(err).Fatalf("Could not create validator at path: %s", keystoreDir)
 						}
 					},
 				},
+				cli.Command{
+					Name: "import",
+					Description: `converts the validator keys of a keystore directory to EIP-2335 keystores, encrypted with
+the same password, which can be used with --keymanager=eip2335 and by other Ethereum 2 tooling`,
+					Flags: []cli.Flag{
+						flags.KeystorePathFlag,
+						flags.PasswordFlag,
+						flags.EIP2335PathFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						configureParams(ctx)
+						keystorePath := ctx.String(flags.KeystorePathFlag.Name)
+						destPath := ctx.String(flags.EIP2335PathFlag.Name)
+						if keystorePath == "" || destPath == "" {
+							log.Fatalf("Both --%s and --%s are required", flags.KeystorePathFlag.Name, flags.EIP2335PathFlag.Name)
+						}
+						paths, err := accounts.ImportToEIP2335(keystorePath, ctx.String(flags.PasswordFlag.Name), destPath)
+						if err != nil {
+							log.WithError(err).Fatalf("Could not import keystore at path: %s", keystorePath)
+						}
+						for _, path := range paths {
+							log.WithField("path", path).Info("Imported validator key to EIP-2335 keystore")
+						}
+					},
+				},
+				cli.Command{
+					Name: "new-mnemonic",
+					Description: `generates a new mnemonic and derives a number of validator accounts from it along the
+EIP-2334 paths - this command outputs the mnemonic, which backs up all of the accounts, and a deposit data
+string for each account, whose signing keys can be used with --keymanager=mnemonic`,
+					Flags: []cli.Flag{
+						flags.NumAccountsFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						configureParams(ctx)
+						numAccounts := ctx.Uint64(flags.NumAccountsFlag.Name)
+						if numAccounts == 0 {
+							log.Fatalf("--%s must be greater than 0", flags.NumAccountsFlag.Name)
+						}
+						if _, err := accounts.NewMnemonicAccounts(numAccounts); err != nil {
+							log.WithError(err).Fatal("Could not create mnemonic validator accounts")
+						}
+					},
+				},
+			},
+		},
+		{
+			Name:     "slashing-protection",
+			Category: "slashing-protection",
+			Usage:    "defines commands for moving the validator client's slashing protection history between hosts",
+			Subcommands: cli.Commands{
+				cli.Command{
+					Name: "export",
+					Description: `exports the block proposal and attestation history held in the validator database
+to a JSON file which can be imported on another host`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.SlashingProtectionFileFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						configureParams(ctx)
+						if err := exportSlashingProtection(ctx.String(cmd.DataDirFlag.Name), ctx.String(flags.SlashingProtectionFileFlag.Name)); err != nil {
+							log.WithError(err).Fatal("Could not export slashing protection history")
+						}
+					},
+				},
+				cli.Command{
+					Name: "import",
+					Description: `imports block proposal and attestation history from a JSON file in to the validator
+database, merging it with any existing history by keeping the highest epochs`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						flags.SlashingProtectionFileFlag,
+					},
+					Action: func(ctx *cli.Context) {
+						configureParams(ctx)
+						if err := importSlashingProtection(ctx.String(cmd.DataDirFlag.Name), ctx.String(flags.SlashingProtectionFileFlag.Name)); err != nil {
+							log.WithError(err).Fatal("Could not import slashing protection history")
+						}
+					},
+				},
 			},
 		},
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -79,6 +80,14 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 			params.UseDemoBeaconConfig()
 		}
 	}
+	// Values from a chain config file take precedence over the selected preset.
+	if ctx.GlobalIsSet(cmd.ChainConfigFileFlag.Name) {
+		chainConfigFile := ctx.GlobalString(cmd.ChainConfigFileFlag.Name)
+		if err := params.LoadChainConfigFile(chainConfigFile); err != nil {
+			return nil, err
+		}
+		log.WithField("path", chainConfigFile).Info("Using chain config file")
+	}
 
 	keyManager, err := selectKeyManager(ctx)
 	if err != nil {
@@ -120,6 +129,12 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalBool(flags.ManagementAPIFlag.Name) {
+		if err := ValidatorClient.registerManagementService(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	return ValidatorClient, nil
 }
 
@@ -178,14 +193,19 @@ func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 }
 
 func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager keymanager.KeyManager) error {
-	endpoint := ctx.GlobalString(flags.BeaconRPCProviderFlag.Name)
+	var endpoints []string
+	for _, endpoint := range strings.Split(ctx.GlobalString(flags.BeaconRPCProviderFlag.Name), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
 	dataDir := ctx.GlobalString(cmd.DataDirFlag.Name)
 	logValidatorBalances := !ctx.GlobalBool(flags.DisablePenaltyRewardLogFlag.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
 	graffiti := ctx.GlobalString(flags.GraffitiFlag.Name)
 	maxCallRecvMsgSize := ctx.GlobalInt(flags.GrpcMaxCallRecvMsgSizeFlag.Name)
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:                   endpoint,
+		Endpoints:                  endpoints,
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		LogValidatorBalances:       logValidatorBalances,
@@ -199,6 +219,20 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, keyManager key
 	return s.services.RegisterService(v)
 }
 
+func (s *ValidatorClient) registerManagementService(ctx *cli.Context) error {
+	var validatorService *client.ValidatorService
+	if err := s.services.FetchService(&validatorService); err != nil {
+		return err
+	}
+	return s.services.RegisterService(rpc.NewService(context.Background(), &rpc.Config{
+		Server:      validatorService,
+		Host:        ctx.GlobalString(flags.ManagementHostFlag.Name),
+		Port:        ctx.GlobalInt(flags.ManagementPortFlag.Name),
+		GatewayPort: ctx.GlobalInt(flags.ManagementGatewayPortFlag.Name),
+		DataDir:     ctx.GlobalString(cmd.DataDirFlag.Name),
+	}))
+}
+
 // selectKeyManager selects the key manager depending on the options provided by the user.
 func selectKeyManager(ctx *cli.Context) (keymanager.KeyManager, error) {
 	manager := strings.ToLower(ctx.String(flags.KeyManager.Name))
@@ -250,8 +284,14 @@ func selectKeyManager(ctx *cli.Context) (keymanager.KeyManager, error) {
 		km, help, err = keymanager.NewUnencrypted(opts)
 	case "keystore":
 		km, help, err = keymanager.NewKeystore(opts)
+	case "eip2335":
+		km, help, err = keymanager.NewEIP2335(opts)
+	case "mnemonic":
+		km, help, err = keymanager.NewMnemonic(opts)
 	case "wallet":
 		km, help, err = keymanager.NewWallet(opts)
+	case "remote":
+		km, help, err = keymanager.NewRemote(opts)
 	default:
 		return nil, fmt.Errorf("unknown keymanager %q", manager)
 	}
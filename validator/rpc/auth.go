@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authorizationScheme is the scheme of the authorization header, followed by the token.
+const authorizationScheme = "Bearer "
+
+// loadOrCreateToken reads the token at the given path, generating a random one if the
+// file does not exist yet. The file is only readable by its owner.
+func loadOrCreateToken(path string) (string, error) {
+	enc, err := ioutil.ReadFile(path)
+	if err == nil {
+		token := strings.TrimSpace(string(enc))
+		if token == "" {
+			return "", errors.Errorf("empty token in %s", path)
+		}
+		return token, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", errors.Wrap(err, "could not read token")
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "could not generate token")
+	}
+	token := hex.EncodeToString(b)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", errors.Wrap(err, "could not create token directory")
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", errors.Wrap(err, "could not create token file")
+	}
+	if _, err := f.WriteString(token + "\n"); err != nil {
+		f.Close()
+		return "", errors.Wrap(err, "could not write token")
+	}
+	return token, f.Close()
+}
+
+// authenticate checks that the request carries the token in its authorization metadata,
+// which the gateway fills in from the authorization header of HTTP requests.
+func (s *Service) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "Missing authorization token")
+	}
+	for _, value := range md.Get("authorization") {
+		if !strings.HasPrefix(value, authorizationScheme) {
+			continue
+		}
+		token := strings.TrimPrefix(value, authorizationScheme)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "Invalid authorization token")
+}
+
+func (s *Service) authenticateUnary(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Service) authenticateStream(
+	srv interface{},
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := s.authenticate(stream.Context()); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
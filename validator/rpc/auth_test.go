@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadOrCreateToken(t *testing.T) {
+	dir := filepath.Join(testutil.TempDir(), "management")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, TokenFileName)
+
+	token, err := loadOrCreateToken(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(token) != 64 {
+		t.Errorf("Wanted token of length %d, got %d", 64, len(token))
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Wanted token file mode %v, got %v", os.FileMode(0600), info.Mode().Perm())
+	}
+
+	reloaded, err := loadOrCreateToken(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded != token {
+		t.Errorf("Wanted token %s to be reloaded, got %s", token, reloaded)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	s := &Service{token: "secret"}
+	tests := []struct {
+		name string
+		md   metadata.MD
+		ok   bool
+	}{
+		{name: "valid token", md: metadata.Pairs("authorization", "Bearer secret"), ok: true},
+		{name: "invalid token", md: metadata.Pairs("authorization", "Bearer guess")},
+		{name: "missing scheme", md: metadata.Pairs("authorization", "secret")},
+		{name: "missing token", md: metadata.MD{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.authenticate(metadata.NewIncomingContext(context.Background(), tt.md))
+			if tt.ok && err != nil {
+				t.Errorf("Expected request to be authenticated, got %v", err)
+			}
+			if !tt.ok && status.Code(err) != codes.Unauthenticated {
+				t.Errorf("Wanted error code %v, got %v", codes.Unauthenticated, err)
+			}
+		})
+	}
+}
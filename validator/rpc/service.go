@@ -0,0 +1,171 @@
+// Package rpc serves the management API of the validator client over gRPC, along with
+// a JSON gateway to it, for local tooling to inspect and operate the validator.
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/rpc/v1"
+	gwpb "github.com/prysmaticlabs/prysm/proto/validator/rpc/v1_gateway"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "rpc")
+
+// TokenFileName is the name of the file in the data directory holding the token
+// authenticating requests to the management API.
+const TokenFileName = "management-token"
+
+// Service serves the management API of the validator client.
+type Service struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	server       pb.ManagementServer
+	host         string
+	port         int
+	gatewayPort  int
+	tokenPath    string
+	token        string
+	listener     net.Listener
+	grpcServer   *grpc.Server
+	conn         *grpc.ClientConn
+	gateway      *http.Server
+	startFailure error
+}
+
+// Config options for the management API.
+type Config struct {
+	Server      pb.ManagementServer
+	Host        string
+	Port        int
+	GatewayPort int
+	DataDir     string
+}
+
+// NewService creates the management API service. The gateway is not served if its port
+// is zero.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:         ctx,
+		cancel:      cancel,
+		server:      cfg.Server,
+		host:        cfg.Host,
+		port:        cfg.Port,
+		gatewayPort: cfg.GatewayPort,
+		tokenPath:   filepath.Join(cfg.DataDir, TokenFileName),
+	}
+}
+
+// Start serving the management API.
+func (s *Service) Start() {
+	token, err := loadOrCreateToken(s.tokenPath)
+	if err != nil {
+		log.WithError(err).Error("Could not load management API token")
+		s.startFailure = err
+		return
+	}
+	s.token = token
+	log.WithField("path", s.tokenPath).Info("Management API requests must be authenticated with the token in file")
+
+	address := fmt.Sprintf("%s:%d", s.host, s.port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.WithError(err).Errorf("Could not listen to port %s", address)
+		s.startFailure = err
+		return
+	}
+	s.listener = lis
+	s.grpcServer = grpc.NewServer(
+		grpc.StreamInterceptor(middleware.ChainStreamServer(
+			recovery.StreamServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			s.authenticateStream,
+		)),
+		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
+			recovery.UnaryServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			s.authenticateUnary,
+		)),
+	)
+	pb.RegisterManagementServer(s.grpcServer, s.server)
+	go func() {
+		if err := s.grpcServer.Serve(lis); err != nil {
+			log.WithError(err).Error("Could not serve gRPC")
+		}
+	}()
+	log.WithField("address", address).Info("Management API listening on port")
+
+	if s.gatewayPort != 0 {
+		if err := s.startGateway(address); err != nil {
+			log.WithError(err).Error("Could not start management API gateway")
+			s.startFailure = err
+		}
+	}
+}
+
+// startGateway serves the JSON gateway, which forwards the requests it receives,
+// including their authorization header, to the gRPC server at the given address.
+func (s *Service) startGateway(address string) error {
+	conn, err := grpc.DialContext(s.ctx, address, grpc.WithInsecure())
+	if err != nil {
+		return errors.Wrap(err, "could not dial management API")
+	}
+	s.conn = conn
+
+	marshaler := &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}
+	gwmux := gwruntime.NewServeMux(gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, marshaler))
+	if err := gwpb.RegisterManagementHandler(s.ctx, gwmux, conn); err != nil {
+		return errors.Wrap(err, "could not register management API handler")
+	}
+
+	gatewayAddress := fmt.Sprintf("%s:%d", s.host, s.gatewayPort)
+	s.gateway = &http.Server{
+		Addr:    gatewayAddress,
+		Handler: gwmux,
+	}
+	go func() {
+		if err := s.gateway.ListenAndServe(); err != http.ErrServerClosed {
+			log.WithError(err).Error("Could not serve management API gateway")
+			s.startFailure = err
+		}
+	}()
+	log.WithField("address", gatewayAddress).Info("Management API gateway listening on port")
+	return nil
+}
+
+// Stop the management API.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.gateway != nil {
+		if err := s.gateway.Shutdown(context.Background()); err != nil {
+			log.WithError(err).Error("Could not shut down management API gateway")
+		}
+	}
+	if s.conn != nil {
+		if err := s.conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to management API")
+		}
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+	}
+	return nil
+}
+
+// Status returns an error if the management API failed to start.
+func (s *Service) Status() error {
+	return s.startFailure
+}
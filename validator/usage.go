@@ -47,6 +47,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.VerbosityFlag,
 			cmd.DataDirFlag,
 			cmd.ClearDB,
+			cmd.ChainConfigFileFlag,
 			cmd.ForceClearDB,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
@@ -84,6 +85,10 @@ var appHelpFlagGroups = []flagGroup{
 			flags.UnencryptedKeysFlag,
 			flags.GraffitiFlag,
 			flags.GrpcMaxCallRecvMsgSizeFlag,
+			flags.ManagementAPIFlag,
+			flags.ManagementHostFlag,
+			flags.ManagementPortFlag,
+			flags.ManagementGatewayPortFlag,
 		},
 	},
 	{